@@ -0,0 +1,135 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/spf13/afero"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/zperf/tcpmon/tcpmon/gproto"
+	"github.com/zperf/tcpmon/tcpmon/parsing"
+	"github.com/zperf/tcpmon/tcpmon/storage"
+	"github.com/zperf/tcpmon/tcpmon/tutils"
+)
+
+var (
+	columnarOnce sync.Once
+	columnarFs   afero.Fs
+	columnarFile string
+	columnarErr  error
+)
+
+// writeColumnar seals 60 snapshots of the recorded socket table with a columnar segment
+func writeColumnar() (afero.Fs, string, error) {
+	columnarOnce.Do(func() {
+		buf, err := os.ReadFile("../test/parsing/ss.txt")
+		if err != nil {
+			columnarErr = err
+			return
+		}
+		var base gproto.TcpMetric
+		base.Type = gproto.MetricType_TCP
+		err = parsing.ParseSS(&base, strings.FieldsFunc(string(buf), tutils.SplitNewline))
+		if err != nil {
+			columnarErr = err
+			return
+		}
+		if len(base.Sockets) > 1000 {
+			base.Sockets = base.Sockets[:1000]
+		}
+
+		fs := afero.NewMemMapFs()
+		ds, err := storage.NewDataStore(storage.NewConfig("/db").WithFs(fs).WithMaxEntriesPerFile(60).
+			WithDictSize(0).WithColumnar(true))
+		if err != nil {
+			columnarErr = err
+			return
+		}
+		for i := 0; i < 60; i++ {
+			base.Timestamp = int64(1700000000 + i)
+			for j, s := range base.Sockets {
+				s.Rtt = float64((i+j)%100) / 10
+				s.BytesAcked += uint64(j)
+			}
+			buf, err := proto.Marshal(&gproto.Metric{Body: &gproto.Metric_Tcp{Tcp: &base}})
+			if err == nil {
+				err = ds.Put(buf)
+			}
+			if err != nil {
+				columnarErr = err
+				return
+			}
+		}
+		err = ds.NextFile()
+		if err == nil {
+			err = ds.Close()
+		}
+		columnarFs = fs
+		columnarFile = filepath.Join("/db", storage.DataFileName(storage.StreamTcp, 2)+storage.SealFileSuffix)
+		columnarErr = err
+	})
+	return columnarFs, columnarFile, columnarErr
+}
+
+// BenchmarkScanProtobuf reads the rtt of all sockets by unmarshalling the snapshots, as the exporter does
+func BenchmarkScanProtobuf(b *testing.B) {
+	fs, _, err := writeColumnar()
+	if err != nil {
+		b.Fatal(err)
+	}
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		r, err := storage.NewDataStoreReader(storage.NewReaderConfig("/db").WithFs(fs))
+		if err != nil {
+			b.Fatal(err)
+		}
+		sum := 0.0
+		err = r.Range(time.Time{}, time.Time{}, func(buf []byte) error {
+			var m gproto.Metric
+			err := proto.Unmarshal(buf, &m)
+			for _, s := range m.GetTcp().GetSockets() {
+				sum += s.GetRtt()
+			}
+			return err
+		}, gproto.MetricType_TCP)
+		if err != nil {
+			b.Fatal(err)
+		}
+		r.Close()
+	}
+}
+
+// BenchmarkScanColumnar reads the rtt of all sockets from the columnar segment
+func BenchmarkScanColumnar(b *testing.B) {
+	fs, file, err := writeColumnar()
+	if err != nil {
+		b.Fatal(err)
+	}
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		segment, err := storage.OpenSegment(fs, file)
+		if err != nil {
+			b.Fatal(err)
+		}
+		timestamps, err := segment.Timestamps()
+		if err != nil {
+			b.Fatal(err)
+		}
+		rtt, err := segment.Float64s("rtt")
+		if err != nil {
+			b.Fatal(err)
+		}
+		sum := 0.0
+		for j := range timestamps {
+			sum += rtt[j]
+		}
+		segment.Close()
+	}
+}
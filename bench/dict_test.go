@@ -0,0 +1,113 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/klauspost/compress/zstd"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/zperf/tcpmon/tcpmon/gproto"
+	"github.com/zperf/tcpmon/tcpmon/parsing"
+	"github.com/zperf/tcpmon/tcpmon/storage"
+	"github.com/zperf/tcpmon/tcpmon/tutils"
+)
+
+// tcpRecords returns n snapshots of the recorded socket table as they are stored, keyframes and deltas
+func tcpRecords(n int) ([][]byte, error) {
+	buf, err := os.ReadFile("../test/parsing/ss.txt")
+	if err != nil {
+		return nil, err
+	}
+	var base gproto.TcpMetric
+	base.Type = gproto.MetricType_TCP
+	err = parsing.ParseSS(&base, strings.FieldsFunc(string(buf), tutils.SplitNewline))
+	if err != nil {
+		return nil, err
+	}
+
+	encoder := storage.NewDeltaEncoder(60)
+	records := make([][]byte, 0, n)
+	for i := 0; i < n; i++ {
+		base.Timestamp = int64(1700000000 + i)
+		for j, s := range base.Sockets {
+			s.Lastsnd += 1000
+			if (i+j)%5 == 0 {
+				s.BytesAcked += uint64(1000 + j)
+				s.SegsOut += 3
+				s.Rtt = float64(j%100) / 10
+			}
+		}
+		buf, err := proto.Marshal(&gproto.Metric{Body: &gproto.Metric_Tcp{Tcp: &base}})
+		if err != nil {
+			return nil, err
+		}
+		buf, err = encoder.Encode(buf)
+		if err != nil {
+			return nil, err
+		}
+		records = append(records, buf)
+	}
+	return records, nil
+}
+
+// BenchmarkDict compares the trained dictionary with the zstd defaults, on socket tables compressed as a frame per
+// record and as 256KiB frames. Run with -benchtime 3x, the ratio is reported as a metric.
+func BenchmarkDict(b *testing.B) {
+	records, err := tcpRecords(80)
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	d, err := storage.TrainDict(records[:40], 64<<10, 3)
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	frames := map[string][][]byte{"record": records[40:]}
+	var frame []byte
+	for _, r := range records[40:] {
+		frame = append(frame, r...)
+		if len(frame) >= 256<<10 {
+			frames["256KiB"] = append(frames["256KiB"], frame)
+			frame = nil
+		}
+	}
+	frames["256KiB"] = append(frames["256KiB"], frame)
+
+	encoders := map[string][]zstd.EOption{
+		"default": nil,
+		"dict":    {zstd.WithEncoderDict(d)},
+	}
+
+	for _, frameName := range []string{"record", "256KiB"} {
+		for _, encoderName := range []string{"default", "dict"} {
+			b.Run(fmt.Sprintf("frame=%s/%s", frameName, encoderName), func(b *testing.B) {
+				encoder, err := zstd.NewWriter(nil, encoders[encoderName]...)
+				if err != nil {
+					b.Fatal(err)
+				}
+				defer encoder.Close()
+
+				var size, compressed int
+				for _, f := range frames[frameName] {
+					size += len(f)
+				}
+				b.SetBytes(int64(size))
+				b.ResetTimer()
+
+				var buf []byte
+				for i := 0; i < b.N; i++ {
+					compressed = 0
+					for _, f := range frames[frameName] {
+						buf = encoder.EncodeAll(f, buf[:0])
+						compressed += len(buf)
+					}
+				}
+				b.ReportMetric(float64(size)/float64(compressed), "ratio")
+			})
+		}
+	}
+}
@@ -0,0 +1,20 @@
+package main
+
+import (
+	"os"
+	"testing"
+
+	"github.com/rs/zerolog"
+
+	"github.com/zperf/tcpmon/logging"
+)
+
+func TestMain(m *testing.M) {
+	// the datastore logs every file, it would be mixed with the results
+	logging.InitLogger(&logging.LogConfig{
+		ConsoleLoggingEnabled: true,
+		FileLoggingEnabled:    false,
+		Level:                 zerolog.WarnLevel,
+	})
+	os.Exit(m.Run())
+}
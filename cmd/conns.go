@@ -0,0 +1,70 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/rs/zerolog/log"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/zperf/tcpmon/tcpmon/conntrack"
+	"github.com/zperf/tcpmon/tcpmon/gproto"
+	"github.com/zperf/tcpmon/tcpmon/storage"
+	"github.com/zperf/tcpmon/tcpmon/tutils"
+)
+
+var connsCmd = &cobra.Command{
+	Use:   "conns [--addr ADDR] DATA_DIR_OR_BACKUP",
+	Short: "list connections with the first seen and last seen time",
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		addr := viper.GetString("conns-addr")
+
+		r, err := storage.NewDataStoreReader(storage.NewReaderConfig(args[0]).WithFs(dataFs()))
+		if err != nil {
+			log.Fatal().Err(err).Msg("Open data dir failed")
+		}
+		defer r.Close()
+
+		tracker := conntrack.NewTracker()
+		err = r.Iterate(func(buf []byte) error {
+			var m gproto.Metric
+			err := proto.Unmarshal(buf, &m)
+			if err != nil {
+				return err
+			}
+			if m.GetTcp() != nil {
+				tracker.Observe(m.GetTcp())
+			}
+			return nil
+		})
+		if err != nil {
+			log.Fatal().Err(err).Msg("Read data dir failed")
+		}
+
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		_, _ = fmt.Fprintln(w, "KEY\tLOCAL\tPEER\tPROCESS\tSTATE\tFIRST SEEN\tLAST SEEN\tDURATION\tSAMPLES")
+		for _, c := range tracker.Connections() {
+			if addr != "" && !strings.Contains(c.LocalAddr, addr) && !strings.Contains(c.PeerAddr, addr) {
+				continue
+			}
+			_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\t%d\n",
+				c.Key, c.LocalAddr, c.PeerAddr, c.Process, c.State,
+				c.FirstSeen.Format(tutils.TimeFormat), c.LastSeen.Format(tutils.TimeFormat),
+				c.Duration().Truncate(time.Second), c.Samples)
+		}
+		tutils.FatalIf(w.Flush())
+	},
+}
+
+func init() {
+	connsCmd.Flags().String("addr", "", "Only list connections whose local or peer address contains ADDR")
+	tutils.FatalIf(viper.BindPFlag("conns-addr", connsCmd.Flags().Lookup("addr")))
+
+	rootCmd.AddCommand(connsCmd)
+}
@@ -0,0 +1,33 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/rs/zerolog/log"
+	"github.com/spf13/cobra"
+
+	"github.com/zperf/tcpmon/tcpmon/storage"
+)
+
+var countCmd = &cobra.Command{
+	Use:   "count DATA_DIR_OR_BACKUP",
+	Short: "count the records in the data dir or backup archive (.tar, .tar.zst or .tar.enc)",
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		r, err := storage.NewDataStoreReader(storage.NewReaderConfig(args[0]).WithFs(dataFs()))
+		if err != nil {
+			log.Fatal().Err(err).Msg("Open data dir failed")
+		}
+		defer r.Close()
+
+		count, err := r.Count()
+		if err != nil {
+			log.Fatal().Err(err).Msg("Read data dir failed")
+		}
+		fmt.Println(count)
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(countCmd)
+}
@@ -0,0 +1,152 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"sort"
+	"text/tabwriter"
+	"time"
+
+	"github.com/rs/zerolog/log"
+	"github.com/samber/lo"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/zperf/tcpmon/tcpmon/storage"
+	"github.com/zperf/tcpmon/tcpmon/tutils"
+)
+
+var dbCmd = &cobra.Command{
+	Use:   "db",
+	Short: "Inspect the datastore",
+}
+
+var dbStatsCmd = &cobra.Command{
+	Use:   "stats [DATA_DIR_OR_BACKUP]",
+	Short: "Show the storage usage of the running tcpmon, or of a data dir or backup",
+	Long: "show the storage usage by stream and by metric type, the time range, the compression ratio, " +
+		"the reclaim history and the write errors. Without DATA_DIR_OR_BACKUP, it's requested from the running " +
+		"tcpmon, the reclaim history and the write errors are only available from it.",
+	Args: cobra.MaximumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		var stats *storage.StorageStats
+		if len(args) == 0 {
+			stats = &storage.StorageStats{}
+			tutils.FatalIf(apiRequest(viper.GetString("db-stats-addr"), http.MethodGet, "/storage", stats))
+		} else {
+			var err error
+			dir := args[0]
+			fs := dataFs()
+			if storage.IsArchive(dir) {
+				fs, err = storage.OpenArchive(dir, fs)
+				if err != nil {
+					log.Fatal().Err(err).Str("path", dir).Msg("Open backup failed")
+				}
+				dir = storage.ArchiveRoot
+			}
+			stats, err = storage.DirStats(fs, dir)
+			if err != nil {
+				log.Fatal().Err(err).Msg("Read data dir failed")
+			}
+		}
+
+		if viper.GetBool("db-stats-json") {
+			encoder := json.NewEncoder(os.Stdout)
+			encoder.SetIndent("", "  ")
+			tutils.FatalIf(encoder.Encode(stats))
+			return
+		}
+		printStats(stats)
+	},
+}
+
+// formatBytes formats the size in binary units, e.g. 1.5 MiB
+func formatBytes(n int64) string {
+	const unit = 1024
+	if n < unit {
+		return fmt.Sprintf("%d B", n)
+	}
+	div, exp := int64(unit), 0
+	for m := n / unit; m >= unit; m /= unit {
+		div *= unit
+		exp++
+	}
+	return fmt.Sprintf("%.1f %ciB", float64(n)/float64(div), "KMGTPE"[exp])
+}
+
+func formatTime(t time.Time) string {
+	if t.IsZero() {
+		return "-"
+	}
+	return t.Local().Format(tutils.TimeFormat)
+}
+
+func printStats(stats *storage.StorageStats) {
+	size := formatBytes(stats.Size)
+	if stats.MaxSize > 0 {
+		size += fmt.Sprintf(" of %s (%.1f%%)", formatBytes(stats.MaxSize),
+			float64(stats.Size)*100/float64(stats.MaxSize))
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintf(w, "Base dir:\t%s\n", stats.BaseDir)
+	_, _ = fmt.Fprintf(w, "Size:\t%s\n", size)
+	_, _ = fmt.Fprintf(w, "Raw size:\t%s\n", formatBytes(stats.RawSize))
+	_, _ = fmt.Fprintf(w, "Compression ratio:\t%.2f\n", stats.CompressionRatio)
+	_, _ = fmt.Fprintf(w, "Files:\t%d (%d sealed, %d unsealed, %d pinned)\n", stats.Files, stats.SealedFiles,
+		stats.UnsealedFiles, stats.PinnedFiles)
+	_, _ = fmt.Fprintf(w, "Time range:\t%s - %s\n", formatTime(stats.Oldest), formatTime(stats.Newest))
+	if stats.Write != nil {
+		_, _ = fmt.Fprintf(w, "Records written:\t%d\n", stats.Write.Records)
+		errs := fmt.Sprint(stats.Write.Errors)
+		if stats.Write.Errors != 0 {
+			errs += fmt.Sprintf(", last at %s: %s", formatTime(stats.Write.LastErrorTime), stats.Write.LastError)
+		}
+		_, _ = fmt.Fprintf(w, "Write errors:\t%s\n", errs)
+	}
+	tutils.FatalIf(w.Flush())
+
+	printUsage("STREAM", stats.Streams)
+	printUsage("TYPE", stats.Types)
+
+	if len(stats.Reclaims) != 0 {
+		fmt.Println()
+		w = tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		_, _ = fmt.Fprintln(w, "RECLAIMED AT\tDURATION\tFILES\tDELETED\tFREED")
+		for _, r := range stats.Reclaims {
+			_, _ = fmt.Fprintf(w, "%s\t%s\t%d\t%d\t%s\n", formatTime(r.Time), r.Duration, r.Files, r.Deleted,
+				formatBytes(r.Freed))
+		}
+		tutils.FatalIf(w.Flush())
+	}
+}
+
+func printUsage(title string, usages map[string]*storage.UsageStats) {
+	fmt.Println()
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintf(w, "%s\tFILES\tRECORDS\tSIZE\tRAW SIZE\n", title)
+	names := lo.Keys(usages)
+	sort.Strings(names)
+	for _, name := range names {
+		u := usages[name]
+		files := "-"
+		if u.Files != 0 {
+			files = fmt.Sprint(u.Files)
+		}
+		_, _ = fmt.Fprintf(w, "%s\t%s\t%d\t%s\t%s\n", name, files, u.Records, formatBytes(u.Size),
+			formatBytes(u.RawSize))
+	}
+	tutils.FatalIf(w.Flush())
+}
+
+func init() {
+	dbStatsCmd.Flags().String("addr", "127.0.0.1:6789", "The HTTP address of tcpmon")
+	tutils.FatalIf(viper.BindPFlag("db-stats-addr", dbStatsCmd.Flags().Lookup("addr")))
+	dbStatsCmd.Flags().Bool("json", false, "Print in JSON")
+	tutils.FatalIf(viper.BindPFlag("db-stats-json", dbStatsCmd.Flags().Lookup("json")))
+
+	dbCmd.AddCommand(dbStatsCmd)
+	rootCmd.AddCommand(dbCmd)
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/cockroachdb/errors"
 	"github.com/rs/zerolog/log"
 	"github.com/schollz/progressbar/v3"
+	"github.com/spf13/afero"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 
@@ -20,18 +21,36 @@ import (
 )
 
 var exportCmd = &cobra.Command{
-	Use:   "export [-o output] HOSTNAME DATA_FILE_OR_DIR",
+	Use:   "export [-o output] [HOSTNAME] DATA_FILE_OR_DIR_OR_BACKUP",
 	Short: "export a backup to influxdb line protocol file",
-	Args:  cobra.ExactArgs(2),
+	Long: "export a backup to influxdb line protocol file. " +
+		"HOSTNAME defaults to the hostname recorded in the data files. " +
+		"Backup archives (.tar, .tar.zst or .tar.enc) are read in place. " +
+		"Encrypted files are decrypted with --key-file or --passphrase-file.",
+	Args: cobra.RangeArgs(1, 2),
 	Run: func(cmd *cobra.Command, args []string) {
 		var err error
 
-		hostname := args[0]
-		path := args[1]
+		var hostname, path string
+		if len(args) == 2 {
+			hostname = args[0]
+			path = args[1]
+		} else {
+			path = args[0]
+		}
 		output := viper.GetString("export-output")
 		showOnly := viper.GetBool("export-show")
 
-		s, err := os.Stat(path)
+		fs := dataFs()
+		if storage.IsArchive(path) {
+			fs, err = storage.OpenArchive(path, fs)
+			if err != nil {
+				log.Fatal().Err(err).Str("path", path).Msg("Open backup failed")
+			}
+			path = storage.ArchiveRoot
+		}
+
+		s, err := fs.Stat(path)
 		if err != nil {
 			log.Fatal().Err(err).Str("path", path).Msg("Stat failed")
 		}
@@ -71,7 +90,7 @@ var exportCmd = &cobra.Command{
 		}
 
 		if s.IsDir() {
-			files, err := os.ReadDir(path)
+			files, err := afero.ReadDir(fs, path)
 			if err != nil {
 				log.Fatal().Err(err).Msg("read dir files failed")
 			}
@@ -86,7 +105,16 @@ var exportCmd = &cobra.Command{
 					continue
 				}
 
-				err = exportFile(filepath.Join(path, f.Name()), writer, &exportOption)
+				filePath := filepath.Join(path, f.Name())
+				if !target.IsZero() && !indexIncludes(fs, filePath, target) {
+					err = influxdb.ErrTimePointNotIncluded
+				} else {
+					err = exportFile(fs, filePath, writer, &exportOption)
+				}
+				if errors.Is(err, influxdb.ErrNoMetric) {
+					log.Info().Str("file", f.Name()).Msg("File ignored since there is no metric in it")
+					continue
+				}
 				if err != nil {
 					if errors.Is(err, influxdb.ErrTimePointNotIncluded) {
 						if exportOption.Bar != nil {
@@ -108,7 +136,7 @@ var exportCmd = &cobra.Command{
 				}
 			}
 		} else {
-			err = exportFile(path, writer, &exportOption)
+			err = exportFile(fs, path, writer, &exportOption)
 			if err != nil {
 				log.Fatal().Err(err).Msg("Export single data file failed")
 			}
@@ -116,12 +144,26 @@ var exportCmd = &cobra.Command{
 	},
 }
 
-func exportFile(path string, w io.Writer, options *influxdb.ExportOptions) error {
+// indexIncludes checks the time point with the index of the data file without opening it. Returns true if there is
+// no index.
+func indexIncludes(fs afero.Fs, path string, t time.Time) bool {
+	index, err := storage.LoadIndex(fs, path)
+	if err != nil {
+		if !errors.Is(err, storage.ErrIndexNotFound) {
+			log.Warn().Err(err).Str("file", path).Msg("Load index failed")
+		}
+		return true
+	}
+	from, to, ok := storage.MetricRange(index)
+	return ok && from <= t.Unix() && t.Unix() <= to
+}
+
+func exportFile(fs afero.Fs, path string, w io.Writer, options *influxdb.ExportOptions) error {
 	if options.Bar != nil {
 		options.Bar.Describe(path)
 	}
 
-	exporter, err := influxdb.NewFastExporter(path, nil)
+	exporter, err := influxdb.NewFastExporter(path, fs)
 	if err != nil {
 		return err
 	}
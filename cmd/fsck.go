@@ -0,0 +1,122 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/rs/zerolog/log"
+	"github.com/samber/lo"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/zperf/tcpmon/tcpmon/gproto"
+	"github.com/zperf/tcpmon/tcpmon/storage"
+	"github.com/zperf/tcpmon/tcpmon/tutils"
+)
+
+var fsckCmd = &cobra.Command{
+	Use:   "fsck [--repair] DATA_DIR_OR_BACKUP",
+	Short: "check data files, report records and corrupted ranges",
+	Long: "check the headers, lengths, checksums and protobuf of all records in the data files. " +
+		"Exits with 1 if there are corrupted records, unless they are removed by --repair. " +
+		"Stop tcpmon before repairing its data dir.",
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		repair := viper.GetBool("fsck-repair")
+
+		dir := args[0]
+		fs := dataFs()
+		if storage.IsArchive(dir) {
+			if repair {
+				log.Fatal().Msg("Backup archives can't be repaired, extract it first")
+			}
+			var err error
+			fs, err = storage.OpenArchive(dir, fs)
+			if err != nil {
+				log.Fatal().Err(err).Str("path", dir).Msg("Open backup failed")
+			}
+			dir = storage.ArchiveRoot
+		}
+
+		files, err := storage.DataFiles(fs, dir)
+		if err != nil {
+			log.Fatal().Err(err).Msg("List data files failed")
+		}
+
+		corrupted := false
+		details := make([]string, 0)
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		_, _ = fmt.Fprintln(w, "FILE\tRECORDS\tTYPES\tFROM\tTO\tSTATUS")
+		for _, f := range files {
+			report, err := storage.CheckFile(fs, f, repair)
+			if report == nil {
+				_, _ = fmt.Fprintf(w, "%s\t-\t-\t-\t-\t%s\n", f, err)
+				corrupted = true
+				continue
+			}
+
+			status := "ok"
+			if !report.Ok() {
+				status = fmt.Sprintf("%d corrupted ranges", len(report.Corrupted))
+				if report.Repaired {
+					status += ", repaired"
+					if report.Dropped != 0 {
+						status += fmt.Sprintf(", %d deltas dropped", report.Dropped)
+					}
+				} else {
+					corrupted = true
+				}
+			}
+			if err != nil {
+				status += ", " + err.Error()
+				corrupted = true
+			}
+
+			from, to := "-", "-"
+			if len(report.Counts) != 0 {
+				from = time.Unix(report.MinTimestamp, 0).Format(tutils.TimeFormat)
+				to = time.Unix(report.MaxTimestamp, 0).Format(tutils.TimeFormat)
+			}
+			_, _ = fmt.Fprintf(w, "%s\t%d\t%s\t%s\t%s\t%s\n", f, report.Records, formatCounts(report.Counts),
+				from, to, status)
+			for _, c := range report.Corrupted {
+				details = append(details, fmt.Sprintf("%s bytes %d-%d: %s", f, c.Offset, c.End, c.Err))
+			}
+		}
+		tutils.FatalIf(w.Flush())
+
+		if len(details) != 0 {
+			fmt.Println("\nCorrupted ranges (offsets in the uncompressed data):")
+			for _, d := range details {
+				fmt.Println(d)
+			}
+		}
+
+		if corrupted {
+			os.Exit(1)
+		}
+	},
+}
+
+// formatCounts formats record counts by type, e.g. nic=10,tcp=1,tcp_delta=9
+func formatCounts(counts map[gproto.MetricType]int) string {
+	if len(counts) == 0 {
+		return "-"
+	}
+	types := lo.Keys(counts)
+	sort.Slice(types, func(i, j int) bool { return types[i] < types[j] })
+	return strings.Join(lo.Map(types, func(t gproto.MetricType, _ int) string {
+		return fmt.Sprintf("%s=%d", strings.ToLower(t.String()), counts[t])
+	}), ",")
+}
+
+func init() {
+	fsckCmd.Flags().Bool("repair", false, "Rewrite files with corrupted records, skipping them")
+	tutils.FatalIf(viper.BindPFlag("fsck-repair", fsckCmd.Flags().Lookup("repair")))
+
+	rootCmd.AddCommand(fsckCmd)
+}
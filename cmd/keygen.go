@@ -0,0 +1,74 @@
+package cmd
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/rs/zerolog/log"
+	"github.com/spf13/afero"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/zperf/tcpmon/tcpmon/storage"
+)
+
+var keygenCmd = &cobra.Command{
+	Use:   "keygen KEY_ID",
+	Short: "generate a random key for the key file",
+	Long: "generate a random key and print it as a line of the key file. " +
+		"Append the line to the key file to rotate keys, new files are encrypted with the last key.",
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		line, err := storage.GenerateKey(args[0])
+		if err != nil {
+			log.Fatal().Err(err).Msg("Generate key failed")
+		}
+		fmt.Println(line)
+	},
+}
+
+// loadKeyring returns the keyring of --key-file or --passphrase-file, nil if neither is set
+func loadKeyring() *storage.Keyring {
+	keyFile := viper.GetString("key-file")
+	passphraseFile := viper.GetString("passphrase-file")
+	if keyFile == "" && passphraseFile == "" {
+		return nil
+	}
+	if keyFile != "" && passphraseFile != "" {
+		log.Fatal().Msg("Only one of key-file and passphrase-file can be set")
+	}
+
+	fs := afero.NewOsFs()
+	keyring := storage.NewKeyring()
+	if keyFile != "" {
+		err := keyring.LoadKeyFile(fs, keyFile)
+		if err != nil {
+			log.Fatal().Err(err).Str("file", keyFile).Msg("Load key file failed")
+		}
+		return keyring
+	}
+
+	passphrase, err := afero.ReadFile(fs, passphraseFile)
+	if err != nil {
+		log.Fatal().Err(err).Str("file", passphraseFile).Msg("Read passphrase file failed")
+	}
+	err = keyring.SetPassphrase(bytes.TrimRight(passphrase, "\r\n"))
+	if err != nil {
+		log.Fatal().Err(err).Str("file", passphraseFile).Msg("Invalid passphrase")
+	}
+	return keyring
+}
+
+// dataFs returns the fs to read data files, encrypted files are decrypted if a key is configured
+func dataFs() afero.Fs {
+	fs := afero.NewOsFs()
+	keyring := loadKeyring()
+	if keyring == nil {
+		return fs
+	}
+	return storage.NewDecryptFs(fs, keyring)
+}
+
+func init() {
+	rootCmd.AddCommand(keygenCmd)
+}
@@ -0,0 +1,167 @@
+package cmd
+
+import (
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/rs/zerolog/log"
+	"github.com/spf13/afero"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/zperf/tcpmon/tcpmon/server"
+	"github.com/zperf/tcpmon/tcpmon/storage"
+	"github.com/zperf/tcpmon/tcpmon/tutils"
+)
+
+var pinCmd = &cobra.Command{
+	Use:   "pin",
+	Short: "Protect the data of an incident from reclaim",
+}
+
+var pinAddCmd = &cobra.Command{
+	Use:     "add --from TIME --to TIME [--label LABEL] [--ttl TTL]",
+	Short:   "Pin a time range, data files covering it are kept until the pin expires",
+	Example: `  tcpmon pin add --from 2024-01-02T15:00:00 --to 2024-01-02T15:30:00 --label "packet loss"`,
+	Args:    cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		query := url.Values{}
+		query.Set("from", viper.GetString("pin-from"))
+		query.Set("to", viper.GetString("pin-to"))
+		query.Set("label", viper.GetString("pin-label"))
+		query.Set("ttl", viper.GetDuration("pin-ttl").String())
+
+		var pin storage.Pin
+		tutils.FatalIf(apiRequest(viper.GetString("pin-addr"), http.MethodPost, "/pins?"+query.Encode(), &pin))
+		fmt.Println(pin.Id)
+	},
+}
+
+var pinListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List pins",
+	Args:  cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		var r struct {
+			Pins []storage.Pin `json:"pins"`
+		}
+		tutils.FatalIf(apiRequest(viper.GetString("pin-addr"), http.MethodGet, "/pins", &r))
+
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		_, _ = fmt.Fprintln(w, "ID\tLABEL\tFROM\tTO\tEXPIRES\tFILES")
+		for _, p := range r.Pins {
+			expires := "never"
+			if !p.Expires.IsZero() {
+				expires = p.Expires.Local().Format(tutils.TimeFormat)
+			}
+			_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\n", p.Id, p.Label,
+				p.From.Local().Format(tutils.TimeFormat), p.To.Local().Format(tutils.TimeFormat), expires,
+				strings.Join(p.Files, ","))
+		}
+		tutils.FatalIf(w.Flush())
+	},
+}
+
+var pinRemoveCmd = &cobra.Command{
+	Use:   "rm ID",
+	Short: "Delete a pin, files not covered by other pins are reclaimed as usual",
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		tutils.FatalIf(apiRequest(viper.GetString("pin-addr"), http.MethodDelete, "/pins/"+url.PathEscape(args[0]), nil))
+	},
+}
+
+// apiRequest sends the request to the running tcpmon at addr, the response is decoded into r if it's not nil. HTTPS
+// is used if addr starts with https:// or a CA or a client certificate is set.
+func apiRequest(addr string, method string, path string, r any) error {
+	client, scheme, err := apiClient()
+	if err != nil {
+		return err
+	}
+	if !strings.Contains(addr, "://") {
+		addr = scheme + "://" + addr
+	}
+
+	req, err := http.NewRequest(method, addr+path, nil)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	if token := viper.GetString("api-token"); token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return errors.Wrap(err, "request failed")
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return errors.Wrap(err, "read response failed")
+	}
+	if resp.StatusCode != http.StatusOK {
+		log.Error().Int("status", resp.StatusCode).Str("response", string(body)).Msg("Request failed")
+		return errors.Newf("request failed with status %d", resp.StatusCode)
+	}
+
+	if r == nil {
+		return nil
+	}
+	return errors.Wrap(json.Unmarshal(body, r), "parse response failed")
+}
+
+// apiClient returns the HTTP client by the api flags, and the default scheme
+func apiClient() (*http.Client, string, error) {
+	caFile := viper.GetString("api-ca")
+	certFile := viper.GetString("api-cert")
+	if caFile == "" && certFile == "" {
+		return http.DefaultClient, "http", nil
+	}
+
+	config := &tls.Config{MinVersion: tls.VersionTLS12}
+	fs := afero.NewOsFs()
+	if caFile != "" {
+		pool, err := server.LoadCertPool(fs, caFile)
+		if err != nil {
+			return nil, "", err
+		}
+		config.RootCAs = pool
+	}
+	if certFile != "" {
+		cert, err := tls.LoadX509KeyPair(certFile, viper.GetString("api-key"))
+		if err != nil {
+			return nil, "", errors.Wrap(err, "load client certificate failed")
+		}
+		config.Certificates = []tls.Certificate{cert}
+	}
+	return &http.Client{Transport: &http.Transport{TLSClientConfig: config}}, "https", nil
+}
+
+func init() {
+	pinCmd.PersistentFlags().String("addr", "127.0.0.1:6789", "The HTTP address of tcpmon")
+	tutils.FatalIf(viper.BindPFlag("pin-addr", pinCmd.PersistentFlags().Lookup("addr")))
+
+	pinAddCmd.Flags().String("from", "", "Start of the time range, unix timestamp or local time like 2006-01-02T15:04:05")
+	tutils.FatalIf(viper.BindPFlag("pin-from", pinAddCmd.Flags().Lookup("from")))
+	pinAddCmd.Flags().String("to", "", "End of the time range, unix timestamp or local time like 2006-01-02T15:04:05")
+	tutils.FatalIf(viper.BindPFlag("pin-to", pinAddCmd.Flags().Lookup("to")))
+	pinAddCmd.Flags().String("label", "", "Describes the incident")
+	tutils.FatalIf(viper.BindPFlag("pin-label", pinAddCmd.Flags().Lookup("label")))
+	pinAddCmd.Flags().Duration("ttl", 7*24*time.Hour, "The pin expires after it, 0 for never")
+	tutils.FatalIf(viper.BindPFlag("pin-ttl", pinAddCmd.Flags().Lookup("ttl")))
+	tutils.FatalIf(pinAddCmd.MarkFlagRequired("from"))
+	tutils.FatalIf(pinAddCmd.MarkFlagRequired("to"))
+
+	pinCmd.AddCommand(pinAddCmd, pinListCmd, pinRemoveCmd)
+	rootCmd.AddCommand(pinCmd)
+}
@@ -16,8 +16,9 @@ import (
 )
 
 var rootCmd = &cobra.Command{
-	Use:   "tcpmon",
-	Short: "Tcpmon is a portable local network monitor for Linux",
+	Use:     "tcpmon",
+	Short:   "Tcpmon is a portable local network monitor for Linux",
+	Version: tutils.Version,
 }
 
 func Execute(cmdline string) {
@@ -87,6 +88,15 @@ func init() {
 	rootCmd.PersistentFlags().String("log-filename", "tcpmon.log", "The file name of logs")
 	rootCmd.PersistentFlags().Int("log-max-size", 10, "Maximum size of each log file")
 	rootCmd.PersistentFlags().Int("log-max-count", 5, "Maximum log files to keep")
+	rootCmd.PersistentFlags().String("key-file", "",
+		"The key file to encrypt sealed data files and backups, and decrypt them. "+
+			"One key per line as '<id> <base64 key>', the last key encrypts new files")
+	rootCmd.PersistentFlags().String("passphrase-file", "",
+		"The file containing the passphrase to derive keys from, instead of key-file")
+	rootCmd.PersistentFlags().String("api-token", "", "The bearer token sent to the HTTP server of tcpmon")
+	rootCmd.PersistentFlags().String("api-ca", "", "The PEM CA file to verify the certificate of the HTTP server")
+	rootCmd.PersistentFlags().String("api-cert", "", "The PEM client certificate file sent to the HTTP server")
+	rootCmd.PersistentFlags().String("api-key", "", "The PEM key file of api-cert")
 	tutils.FatalIf(viper.BindPFlags(rootCmd.PersistentFlags()))
 }
 
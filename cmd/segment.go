@@ -0,0 +1,144 @@
+package cmd
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/rs/zerolog/log"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/zperf/tcpmon/tcpmon/storage"
+	"github.com/zperf/tcpmon/tcpmon/tutils"
+)
+
+var segmentCmd = &cobra.Command{
+	Use:   "segment",
+	Short: "Columnar segments of socket tables",
+}
+
+var segmentBuildCmd = &cobra.Command{
+	Use:   "build DATA_DIR",
+	Short: "Write the columnar segments of sealed socket table files without one",
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		fs := dataFs()
+		files, err := storage.DataFiles(fs, args[0])
+		if err != nil {
+			log.Fatal().Err(err).Msg("List data files failed")
+		}
+
+		level := viper.GetInt("segment-level")
+		for _, f := range files {
+			if !isSealedTcp(f) {
+				continue
+			}
+			_, err = fs.Stat(storage.SegmentFilePath(f))
+			if err == nil {
+				continue
+			}
+
+			err = storage.WriteSegment(fs, f, level)
+			if err != nil {
+				log.Fatal().Err(err).Str("file", f).Msg("Write segment failed")
+			}
+			log.Info().Str("file", f).Msg("Segment written")
+		}
+	},
+}
+
+var segmentScanCmd = &cobra.Command{
+	Use:   "scan --field FIELD DATA_DIR",
+	Short: "Print a field of all sockets from the columnar segments",
+	Long: "print a field of all sockets from the columnar segments, one socket per line: " +
+		"time, local address, peer address and the value. Fields are named as in the protobuf, e.g. rtt, bytes_acked.",
+	Example: `  tcpmon segment scan --field rtt /tmp/tcpmon/db`,
+	Args:    cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		field := viper.GetString("segment-field")
+		fs := dataFs()
+		files, err := storage.DataFiles(fs, args[0])
+		if err != nil {
+			log.Fatal().Err(err).Msg("List data files failed")
+		}
+
+		w := bufio.NewWriter(os.Stdout)
+		defer w.Flush()
+		for _, f := range files {
+			if !isSealedTcp(f) {
+				continue
+			}
+
+			segment, err := storage.OpenSegment(fs, f)
+			if err != nil {
+				if errors.Is(err, storage.ErrSegmentNotFound) {
+					log.Warn().Str("file", f).Msg("No segment, run 'tcpmon segment build' first")
+					continue
+				}
+				log.Fatal().Err(err).Str("file", f).Msg("Open segment failed")
+			}
+			tutils.FatalIf(scanSegment(segment, field, w))
+			segment.Close()
+		}
+	},
+}
+
+func isSealedTcp(dataFile string) bool {
+	return strings.HasSuffix(dataFile, storage.SealFileSuffix) &&
+		storage.StreamOfFile(filepath.Base(dataFile)) == storage.StreamTcp
+}
+
+func scanSegment(segment *storage.Segment, field string, w *bufio.Writer) error {
+	timestamps, err := segment.Timestamps()
+	if err != nil {
+		return err
+	}
+	locals, err := segment.Strings("local_addr")
+	if err != nil {
+		return err
+	}
+	peers, err := segment.Strings("peer_addr")
+	if err != nil {
+		return err
+	}
+
+	var values []string
+	if segment.Kind(field) == storage.ColumnString {
+		values, err = segment.Strings(field)
+	} else {
+		var floats []float64
+		floats, err = segment.Float64s(field)
+		for _, v := range floats {
+			values = append(values, fmt.Sprint(v))
+		}
+	}
+	if err != nil {
+		return err
+	}
+
+	for i := range timestamps {
+		_, err = fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", time.Unix(timestamps[i], 0).Format(tutils.TimeFormat),
+			locals[i], peers[i], values[i])
+		if err != nil {
+			return errors.WithStack(err)
+		}
+	}
+	return nil
+}
+
+func init() {
+	segmentBuildCmd.Flags().Int("level", 3, "zstd compression level of the columns")
+	tutils.FatalIf(viper.BindPFlag("segment-level", segmentBuildCmd.Flags().Lookup("level")))
+
+	segmentScanCmd.Flags().String("field", "rtt", "The field to print")
+	tutils.FatalIf(viper.BindPFlag("segment-field", segmentScanCmd.Flags().Lookup("field")))
+
+	segmentCmd.AddCommand(segmentBuildCmd)
+	segmentCmd.AddCommand(segmentScanCmd)
+	rootCmd.AddCommand(segmentCmd)
+}
@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/rs/zerolog/log"
+	"github.com/spf13/afero"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 
@@ -20,19 +21,80 @@ var startCmd = &cobra.Command{
 	Use:   "start",
 	Short: "Start monitoring",
 	Run: func(cmd *cobra.Command, args []string) {
+		syncPolicy, err := storage.ParseSyncPolicy(viper.GetString("db-sync"))
+		if err != nil {
+			log.Fatal().Err(err).Msg("Invalid db-sync")
+		}
+
+		rollupLevels, err := storage.ParseRollupLevels(viper.GetString("db-rollup"))
+		if err != nil {
+			log.Fatal().Err(err).Msg("Invalid db-rollup")
+		}
+
 		dsConfig := storage.NewConfig(viper.GetString("db")).
 			WithMaxSize(viper.GetInt64("db-max-size")).
-			WithMaxEntriesPerFile(viper.GetUint32("db-entries-per-file"))
+			WithMaxAge(viper.GetDuration("db-max-age")).
+			WithMaxEntriesPerFile(viper.GetUint32("db-entries-per-file")).
+			WithKeyframeInterval(viper.GetUint32("db-keyframe-interval")).
+			WithWriteInterval(viper.GetDuration("db-write-interval")).
+			WithWriteBatchSize(viper.GetUint32("db-write-batch-size")).
+			WithSyncPolicy(syncPolicy).
+			WithRollupLevels(rollupLevels...).
+			WithRollupTopN(viper.GetInt("db-rollup-top-n")).
+			WithCompressionLevel(viper.GetInt("db-compression-level")).
+			WithDictSize(viper.GetInt("db-dict-size")).
+			WithDictRetrainInterval(viper.GetDuration("db-dict-retrain-interval")).
+			WithColumnar(viper.GetBool("db-columnar")).
+			WithKeyring(loadKeyring())
+		for _, stream := range storage.Streams {
+			if stream != storage.StreamDefault {
+				dsConfig.WithStreamMaxSize(stream, viper.GetInt64("db-max-size-"+stream))
+			}
+		}
 		log.Info().Int64("MaxSize", dsConfig.MaxSize).
+			Dur("MaxAge", dsConfig.MaxAge).
+			Interface("StreamMaxSize", dsConfig.StreamMaxSize).
 			Uint32("EntriesPerFile", dsConfig.MaxEntriesPerFile).
+			Dur("WriteInterval", dsConfig.WriteInterval).
+			Uint32("WriteBatchSize", dsConfig.WriteBatchSize).
+			Str("SyncPolicy", string(dsConfig.SyncPolicy)).
+			Interface("RollupLevels", dsConfig.RollupLevels).
 			Str("BaseDir", dsConfig.BaseDir).
+			Bool("Encrypted", dsConfig.Keyring != nil).
 			Msg("Datastore config loaded")
 
+		tlsConfig, err := server.NewTLSConfig(afero.NewOsFs(), viper.GetString("http-tls-cert"),
+			viper.GetString("http-tls-key"), viper.GetString("http-client-ca"))
+		if err != nil {
+			log.Fatal().Err(err).Msg("Load TLS config failed")
+		}
+
 		m, err := server.New(server.MonitorConfig{
 			CollectInterval: viper.GetDuration("collect-interval"),
 			HttpListen:      viper.GetString("listen"),
 			QuorumPort:      viper.GetInt("quorum-port"),
 			DataStoreConfig: *dsConfig,
+
+			TLSConfig: tlsConfig,
+			Auth:      loadAuth(),
+
+			EventStuckThreshold: viper.GetDuration("event-stuck-threshold"),
+			EventBufferSize:     viper.GetInt("event-buffer-size"),
+
+			MetricsTopN:      viper.GetInt("metrics-top-n"),
+			MetricsMaxSeries: viper.GetInt("metrics-max-series"),
+
+			QueryMaxResults: viper.GetInt("query-max-results"),
+
+			StreamBufferSize: viper.GetInt("stream-buffer-size"),
+			StreamMaxClients: viper.GetInt("stream-max-clients"),
+
+			HealthMaxFailures:   viper.GetInt("health-max-failures"),
+			HealthMaxAge:        viper.GetDuration("health-max-age"),
+			HealthMaxQueueDepth: viper.GetInt("health-max-queue-depth"),
+			SystemdNotify:       viper.GetBool("systemd-notify"),
+
+			SelfMetricsInterval: viper.GetDuration("self-metrics-interval"),
 		})
 		if err != nil {
 			log.Fatal().Err(err).Msg("Create tcpmon failed")
@@ -48,6 +110,21 @@ var startCmd = &cobra.Command{
 	},
 }
 
+// loadAuth loads the tokens of the HTTP server, returns nil if there is no token file
+func loadAuth() *server.Auth {
+	tokenFile := viper.GetString("http-token-file")
+	if tokenFile == "" {
+		return nil
+	}
+
+	auth := server.NewAuth()
+	err := auth.LoadTokenFile(afero.NewOsFs(), tokenFile)
+	if err != nil {
+		log.Fatal().Err(err).Str("file", tokenFile).Msg("Load token file failed")
+	}
+	return auth
+}
+
 var startTestCmd = &cobra.Command{
 	Use:     "test",
 	Short:   "Test this machines can run daemon",
@@ -70,13 +147,22 @@ func init() {
 	startCmd.PersistentFlags().StringP("listen", "l", "0.0.0.0:6789", "HTTP server listening at this address")
 	startCmd.PersistentFlags().IntP("quorum-port", "q", -1, "Quorum bind and advertised port")
 
+	// HTTP server security
+	startCmd.PersistentFlags().String("http-tls-cert", "", "The PEM certificate file to serve HTTPS, empty for HTTP")
+	startCmd.PersistentFlags().String("http-tls-key", "", "The PEM key file of http-tls-cert")
+	startCmd.PersistentFlags().String("http-client-ca", "",
+		"The PEM CA file to verify client certificates, clients without a certificate signed by it are rejected")
+	startCmd.PersistentFlags().String("http-token-file", "",
+		"The file of bearer tokens required by the HTTP server, one token per line as '<scope> <token>'. "+
+			"read tokens can read metrics and backups, admin tokens can change pins and members and run pprof")
+
 	// monitor command flags
 	startCmd.PersistentFlags().String("cmd-ifconfig", "/usr/bin/ifconfig", "The path of 'ifconfig'")
 	startCmd.PersistentFlags().String("cmd-ifconfig2", "/usr/sbin/ifconfig", "The path of 'ifconfig'")
 	startCmd.PersistentFlags().String("cmd-ifconfig-arg", "", "Parameters when executing 'ifconfig'")
 	startCmd.PersistentFlags().String("cmd-ss", "/usr/bin/ss", "The path of 'ss'")
 	startCmd.PersistentFlags().String("cmd-ss2", "/usr/sbin/ss", "The path of 'ss'")
-	startCmd.PersistentFlags().String("cmd-ss-arg", "-ntipmona", "Parameters when executing 'ss'")
+	startCmd.PersistentFlags().String("cmd-ss-arg", "-ntipmonae", "Parameters when executing 'ss'")
 	startCmd.PersistentFlags().String("cmd-netstat", "/usr/bin/netstat", "The path of 'netstat'")
 	startCmd.PersistentFlags().String("cmd-netstat-arg", "-s", "Parameters when executing 'netstat'")
 	startCmd.PersistentFlags().DurationP("cmd-timeout", "c", 3*time.Second, "Command execution timeout")
@@ -84,8 +170,69 @@ func init() {
 	// db flags
 	startCmd.PersistentFlags().String("db", "/tmp/tcpmon/db", "Database path")
 	startCmd.PersistentFlags().Uint32("db-max-size", 100*(1<<20), "Maximum number of records in the database")
+	startCmd.PersistentFlags().Duration("db-max-age", 0, "Delete data files older than this, 0 to keep them until the size limit")
+	startCmd.PersistentFlags().Int64("db-max-size-tcp", 0,
+		"Maximum size of socket tables in bytes, 0 to share db-max-size with other streams")
+	startCmd.PersistentFlags().Int64("db-max-size-nic", 10*(1<<20), "Maximum size of NIC metrics in bytes")
+	startCmd.PersistentFlags().Int64("db-max-size-net", 10*(1<<20), "Maximum size of netstat metrics in bytes")
+	startCmd.PersistentFlags().Int64("db-max-size-event", 10*(1<<20), "Maximum size of connection events in bytes")
 	startCmd.PersistentFlags().Uint32("db-entries-per-file", 1000, "Maximum number of records in the database")
-	startCmd.PersistentFlags().Duration("db-write-interval", 60*time.Second, "Write interval")
+	startCmd.PersistentFlags().Duration("db-write-interval", 60*time.Second,
+		"Buffer records and write them to data files at this interval, 0 writes every record at once")
+	startCmd.PersistentFlags().Uint32("db-write-batch-size", 256,
+		"Write buffered records once there are this many, 0 for no limit")
+	startCmd.PersistentFlags().String("db-sync", string(storage.SyncInterval),
+		"When to fsync data files: never (only on rotation), interval (once per db-write-interval) or every-batch")
+	startCmd.PersistentFlags().String("db-rollup", "",
+		"Roll up data files older than the age into the resolution, as resolution:age separated by commas, "+
+			"e.g. 1m:1h,10m:24h. Empty to disable it. Rollups are not deleted by db-max-age")
+	startCmd.PersistentFlags().Int("db-rollup-top-n", 10,
+		"Number of sockets kept in socket table rollups, by retransmissions and by bytes respectively")
+	startCmd.PersistentFlags().Int("db-compression-level", 3,
+		"zstd compression level of sealed data files, from 1 (fastest) to 22 (best compression)")
+	startCmd.PersistentFlags().Int("db-dict-size", 64<<10,
+		"Max size of the zstd dictionary trained for each stream to compress sealed files, in bytes. 0 disables it")
+	startCmd.PersistentFlags().Duration("db-dict-retrain-interval", 24*time.Hour,
+		"Retrain the dictionaries from recent records after the interval, they are also retrained if the "+
+			"compression ratio drops. 0 retrains them only if the ratio drops")
+	startCmd.PersistentFlags().Bool("db-columnar", false,
+		"Write a columnar segment for each sealed socket table file, to scan a single field fast")
+	startCmd.PersistentFlags().Uint32("db-keyframe-interval", 60,
+		"Store a full socket table every N records, others are stored as deltas. 0 or 1 disables deltas")
+
+	// connection events
+	startCmd.PersistentFlags().Duration("event-stuck-threshold", time.Minute,
+		"Report connections staying in a closing state (e.g. CLOSE-WAIT) longer than this, 0 to disable")
+	startCmd.PersistentFlags().Int("event-buffer-size", 10000, "Number of recent connection events kept for GET /events")
+
+	// GET /metrics
+	startCmd.PersistentFlags().Int("metrics-top-n", 0,
+		"Number of sockets exposed with their own series in /metrics, by retransmissions. 0 disables them")
+	startCmd.PersistentFlags().Int("metrics-max-series", 1000,
+		"Maximum label sets of listeners and sockets in /metrics, the rest are dropped. 0 for no limit")
+
+	// GET /api/v1/query
+	startCmd.PersistentFlags().Int("query-max-results", 10000,
+		"Maximum rows of a page returned by /api/v1/query, 0 for no limit")
+
+	// GET /stream
+	startCmd.PersistentFlags().Int("stream-buffer-size", 64,
+		"Metrics buffered for each /stream client, metrics are dropped for the client once it's full")
+	startCmd.PersistentFlags().Int("stream-max-clients", 16, "Maximum clients of /stream, 0 for no limit")
+
+	// GET /healthz and /readyz
+	startCmd.PersistentFlags().Int("health-max-failures", 3,
+		"A collector is unhealthy after failing this many times in a row, 0 to disable")
+	startCmd.PersistentFlags().Duration("health-max-age", time.Minute,
+		"A collector is unhealthy without success in this duration, and storage after a write error in it. 0 to disable")
+	startCmd.PersistentFlags().Int("health-max-queue-depth", 192,
+		"Unhealthy if this many collected records are waiting for the writer (of 256), 0 to disable")
+	startCmd.PersistentFlags().Bool("systemd-notify", true,
+		"Notify systemd when started, and ping the systemd watchdog while healthy. No-op outside of systemd")
+
+	// GET /self
+	startCmd.PersistentFlags().Duration("self-metrics-interval", time.Minute,
+		"Interval of storing the cost of tcpmon itself (collectors, CPU, memory and writes), 0 to disable")
 
 	tutils.FatalIf(viper.BindPFlags(startCmd.PersistentFlags()))
 	rootCmd.AddCommand(startCmd)
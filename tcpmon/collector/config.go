@@ -16,6 +16,8 @@ type Config struct {
 	ArgIfconfig  string
 
 	Timeout time.Duration
+
+	CollectInterval time.Duration
 }
 
 func NewConfig() *Config {
@@ -31,5 +33,7 @@ func NewConfig() *Config {
 		ArgIfconfig: viper.GetString("cmd-ifconfig-arg"),
 
 		Timeout: viper.GetDuration("cmd-timeout"),
+
+		CollectInterval: viper.GetDuration("collect-interval"),
 	}
 }
@@ -0,0 +1,147 @@
+package collector
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"net"
+	"os"
+	"runtime"
+	"strings"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/rs/zerolog/log"
+	"github.com/samber/lo"
+	"github.com/spf13/viper"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/zperf/tcpmon/tcpmon/gproto"
+	"github.com/zperf/tcpmon/tcpmon/tutils"
+)
+
+var machineIdFiles = []string{"/etc/machine-id", "/var/lib/dbus/machine-id"}
+
+const bootIdFile = "/proc/sys/kernel/random/boot_id"
+const osReleaseFile = "/proc/sys/kernel/osrelease"
+
+// configHashSettings are the settings hashed into the host info, the settings of what is collected and how it's
+// stored. Others are left out: tokens, keys and passphrases must not be guessed from the hash offline, and cluster
+// members are changed at runtime.
+var configHashSettings = []string{"collect-interval", "cmd", "db", "event", "self-metrics-interval"}
+
+// HostCollector collects the host identity, it's only reported when something changed
+type HostCollector struct {
+	config *Config
+	last   *gproto.HostInfo
+	static *gproto.HostInfo // fields not changed until restart, read once
+}
+
+func NewHost(config *Config) *HostCollector {
+	return &HostCollector{config: config}
+}
+
+// Collect returns the marshaled host info and whether it differs from the previous one
+func (m *HostCollector) Collect(now time.Time) ([]byte, bool, error) {
+	r, err := m.doCollect(now)
+	if err != nil {
+		return nil, false, err
+	}
+
+	changed := m.last == nil || !SameHost(m.last, r)
+	if changed {
+		m.last = r
+	}
+
+	buf, err := proto.Marshal(&gproto.Metric{Body: &gproto.Metric_Host{Host: m.last}})
+	if err != nil {
+		return nil, false, errors.WithStack(err)
+	}
+	return buf, changed, nil
+}
+
+func (m *HostCollector) doCollect(now time.Time) (*gproto.HostInfo, error) {
+	if m.static == nil {
+		static, err := m.collectStatic()
+		if err != nil {
+			return nil, err
+		}
+		m.static = static
+	}
+
+	info := proto.Clone(m.static).(*gproto.HostInfo)
+	info.Timestamp = now.Unix()
+	info.Type = gproto.MetricType_HOST
+
+	hostname, err := os.Hostname()
+	if err != nil {
+		return nil, errors.Wrap(err, "get hostname failed")
+	}
+	info.Hostname = hostname
+
+	ifaces, err := net.Interfaces()
+	if err != nil {
+		return nil, errors.Wrap(err, "list interfaces failed")
+	}
+	info.Interfaces = lo.Map(ifaces, func(iface net.Interface, _ int) string {
+		return iface.Name
+	})
+
+	return info, nil
+}
+
+// collectStatic returns the host info fields not changed while tcpmon is running, e.g. the boot id
+func (m *HostCollector) collectStatic() (*gproto.HostInfo, error) {
+	var info gproto.HostInfo
+	info.MachineId = readFirstLine(machineIdFiles...)
+	info.BootId = readFirstLine(bootIdFile)
+	info.KernelRelease = readFirstLine(osReleaseFile)
+	info.Version = tutils.Version
+	info.CpuCount = uint32(runtime.NumCPU())
+	info.CollectIntervalMs = m.config.CollectInterval.Milliseconds()
+
+	var err error
+	info.ConfigHash, err = ConfigHash(viper.AllSettings())
+	if err != nil {
+		return nil, err
+	}
+	return &info, nil
+}
+
+// SameHost compares two host info, ignoring the timestamp
+func SameHost(a *gproto.HostInfo, b *gproto.HostInfo) bool {
+	x := proto.Clone(a).(*gproto.HostInfo)
+	x.Timestamp = b.GetTimestamp()
+	return proto.Equal(x, b)
+}
+
+// ConfigHash returns the sha256 of the settings in configHashSettings, e.g. db-max-age
+func ConfigHash(settings map[string]any) (string, error) {
+	settings = lo.PickBy(settings, func(key string, _ any) bool {
+		return lo.SomeBy(configHashSettings, func(s string) bool {
+			return key == s || strings.HasPrefix(key, s+"-")
+		})
+	})
+
+	// map keys are sorted by encoding/json
+	buf, err := json.Marshal(settings)
+	if err != nil {
+		return "", errors.Wrap(err, "marshal settings failed")
+	}
+
+	sum := sha256.Sum256(buf)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+func readFirstLine(paths ...string) string {
+	for _, p := range paths {
+		buf, err := os.ReadFile(p)
+		if err != nil {
+			log.Debug().Err(err).Str("path", p).Msg("Read failed")
+			continue
+		}
+		line, _, _ := strings.Cut(string(buf), "\n")
+		return strings.TrimSpace(line)
+	}
+	return ""
+}
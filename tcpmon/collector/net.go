@@ -19,17 +19,18 @@ func NewNetstat(config *Config) *NetstatCollector {
 	return &NetstatCollector{config: config}
 }
 
-func (m *NetstatCollector) Collect(now time.Time) ([]byte, error) {
+// Collect returns the marshaled metric and the netstat metric
+func (m *NetstatCollector) Collect(now time.Time) ([]byte, *gproto.NetstatMetric, error) {
 	r, err := m.doCollect(now)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	buf, err := proto.Marshal(&gproto.Metric{Body: &gproto.Metric_Net{Net: r}})
 	if err != nil {
-		return nil, errors.WithStack(err)
+		return nil, nil, errors.WithStack(err)
 	}
-	return buf, nil
+	return buf, r, nil
 }
 
 func (m *NetstatCollector) doCollect(now time.Time) (*gproto.NetstatMetric, error) {
@@ -12,25 +12,34 @@ import (
 	"github.com/zperf/tcpmon/tcpmon/parsing"
 )
 
-type NicCollector struct{ config *Config }
+type NicCollector struct {
+	config  *Config
+	wallTime time.Duration // of the last ifconfig process
+}
 
 func NewNic(config *Config) *NicCollector {
 	return &NicCollector{config: config}
 }
 
-func (m *NicCollector) Collect(now time.Time) ([]byte, error) {
+// ChildWallTime returns the wall time of the ifconfig process in the last collection
+func (m *NicCollector) ChildWallTime() time.Duration {
+	return m.wallTime
+}
+
+// Collect returns the marshaled metric and the NIC metric
+func (m *NicCollector) Collect(now time.Time) ([]byte, *gproto.NicMetric, error) {
 	r, err := m.doCollect(now)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	metric := &gproto.Metric{Body: &gproto.Metric_Nic{Nic: r}}
 	val, err := proto.Marshal(metric)
 	if err != nil {
-		return nil, errors.WithStack(err)
+		return nil, nil, errors.WithStack(err)
 	}
 
-	return val, nil
+	return val, r, nil
 }
 
 func (m *NicCollector) doCollect(now time.Time) (*gproto.NicMetric, error) {
@@ -41,13 +50,18 @@ func (m *NicCollector) doCollect(now time.Time) (*gproto.NicMetric, error) {
 	select {
 	case <-ctx.Done():
 		err := c.Stop()
+		m.wallTime = time.Duration(c.Status().Runtime * float64(time.Second))
 		return nil, errors.Wrap(errors.CombineErrors(ctx.Err(), err), "ifconfig timeout")
 	case st := <-c.Start():
+		m.wallTime = time.Duration(st.Runtime * float64(time.Second))
 		var nics gproto.NicMetric
 		nics.Type = gproto.MetricType_NIC
 		nics.Timestamp = now.Unix()
 
-		parsing.ParseIfconfigOutput(&nics, st.Stdout)
+		err := parsing.ParseIfconfigOutput(&nics, st.Stdout)
+		if err != nil {
+			return nil, err
+		}
 		return &nics, nil
 	}
 }
@@ -14,26 +14,33 @@ import (
 
 // SocketCollector collect sockets statistics
 type SocketCollector struct {
-	config *Config
+	config  *Config
+	wallTime time.Duration // of the last ss process
 }
 
 func NewSocket(config *Config) *SocketCollector {
 	return &SocketCollector{config: config}
 }
 
-func (m *SocketCollector) Collect(now time.Time) ([]byte, error) {
+// ChildWallTime returns the wall time of the ss process in the last collection
+func (m *SocketCollector) ChildWallTime() time.Duration {
+	return m.wallTime
+}
+
+// Collect returns the marshaled metric and the socket table
+func (m *SocketCollector) Collect(now time.Time) ([]byte, *gproto.TcpMetric, error) {
 	r, err := m.doCollect(now)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	metric := &gproto.Metric{Body: &gproto.Metric_Tcp{Tcp: r}}
 	val, err := proto.Marshal(metric)
 	if err != nil {
-		return nil, errors.WithStack(err)
+		return nil, nil, errors.WithStack(err)
 	}
 
-	return val, nil
+	return val, r, nil
 }
 
 func (m *SocketCollector) doCollect(now time.Time) (*gproto.TcpMetric, error) {
@@ -44,14 +51,19 @@ func (m *SocketCollector) doCollect(now time.Time) (*gproto.TcpMetric, error) {
 	select {
 	case <-ctx.Done():
 		err := c.Stop()
+		m.wallTime = time.Duration(c.Status().Runtime * float64(time.Second))
 		return nil, errors.Wrap(errors.CombineErrors(ctx.Err(), err), "ss timeout")
 
 	case st := <-c.Start():
+		m.wallTime = time.Duration(st.Runtime * float64(time.Second))
 		var t gproto.TcpMetric
 		t.Timestamp = now.Unix()
 		t.Type = gproto.MetricType_TCP
 
-		parsing.ParseSS(&t, st.Stdout)
+		err := parsing.ParseSS(&t, st.Stdout)
+		if err != nil {
+			return nil, err
+		}
 		return &t, nil
 	}
 }
@@ -0,0 +1,112 @@
+package conntrack
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/zperf/tcpmon/tcpmon/gproto"
+)
+
+// Key identifies a connection across snapshots. The socket cookie is preferred, the address pair is used when the
+// cookie is not available (ss without -e), in that case a reused address pair can't be told apart.
+func Key(s *gproto.SocketMetric) string {
+	if s.GetCookie() != 0 {
+		return fmt.Sprintf("sk:%x", s.GetCookie())
+	}
+	return s.GetLocalAddr() + "|" + s.GetPeerAddr()
+}
+
+// Connection is the history of a socket stitched from snapshots
+type Connection struct {
+	Key       string
+	Cookie    uint64
+	Inode     uint64
+	LocalAddr string
+	PeerAddr  string
+	Process   string
+	State     gproto.SocketState // the last seen state
+
+	StateSince    time.Time // when the connection entered State, maintained by Differ
+	stuckReported bool
+
+	FirstSeen time.Time
+	LastSeen  time.Time
+	Samples   int
+
+	BytesAcked    uint64 // the last seen counters
+	BytesReceived uint64
+}
+
+func (c *Connection) Duration() time.Duration {
+	return c.LastSeen.Sub(c.FirstSeen)
+}
+
+func (c *Connection) update(ts time.Time, s *gproto.SocketMetric) {
+	if c.Samples == 0 || ts.Before(c.FirstSeen) {
+		c.FirstSeen = ts
+	}
+	if ts.After(c.LastSeen) {
+		c.LastSeen = ts
+	}
+	c.Samples++
+
+	c.State = s.GetState()
+	c.BytesAcked = s.GetBytesAcked()
+	c.BytesReceived = s.GetBytesReceived()
+	if c.Inode == 0 {
+		c.Inode = s.GetInode()
+	}
+	if c.Process == "" && len(s.GetProcesses()) > 0 {
+		c.Process = s.GetProcesses()[0].GetName()
+	}
+}
+
+// Tracker stitches socket snapshots into connections
+type Tracker struct {
+	conns map[string]*Connection
+}
+
+func NewTracker() *Tracker {
+	return &Tracker{conns: make(map[string]*Connection)}
+}
+
+// Observe adds a socket table snapshot
+func (t *Tracker) Observe(m *gproto.TcpMetric) {
+	ts := time.Unix(m.GetTimestamp(), 0)
+	for _, s := range m.GetSockets() {
+		key := Key(s)
+		c, ok := t.conns[key]
+		if !ok {
+			c = &Connection{
+				Key:       key,
+				Cookie:    s.GetCookie(),
+				LocalAddr: s.GetLocalAddr(),
+				PeerAddr:  s.GetPeerAddr(),
+			}
+			t.conns[key] = c
+		}
+		c.update(ts, s)
+	}
+}
+
+// Get returns the connection with the key, or nil if it has never been seen
+func (t *Tracker) Get(key string) *Connection {
+	return t.conns[key]
+}
+
+// Connections returns all seen connections, ordered by the first seen time
+func (t *Tracker) Connections() []*Connection {
+	conns := make([]*Connection, 0, len(t.conns))
+	for _, c := range t.conns {
+		conns = append(conns, c)
+	}
+
+	sort.Slice(conns, func(i, j int) bool {
+		if conns[i].FirstSeen.Equal(conns[j].FirstSeen) {
+			return conns[i].Key < conns[j].Key
+		}
+		return conns[i].FirstSeen.Before(conns[j].FirstSeen)
+	})
+	return conns
+}
@@ -0,0 +1,131 @@
+package conntrack
+
+import (
+	"sort"
+	"time"
+
+	"github.com/zperf/tcpmon/tcpmon/gproto"
+)
+
+// closing states that a connection should not stay in for long
+var stuckStates = map[gproto.SocketState]bool{
+	gproto.SocketState_TCP_CLOSE_WAIT: true,
+	gproto.SocketState_TCP_FIN_WAIT1:  true,
+	gproto.SocketState_TCP_FIN_WAIT2:  true,
+	gproto.SocketState_TCP_LAST_ACK:   true,
+	gproto.SocketState_TCP_CLOSING:    true,
+}
+
+// a connection vanished from these states is not closed by the close handshake
+var abortedStates = map[gproto.SocketState]bool{
+	gproto.SocketState_TCP_ESTABLISHED: true,
+	gproto.SocketState_TCP_SYN_SENT:    true,
+	gproto.SocketState_TCP_SYN_RECV:    true,
+}
+
+// Differ derives connection events from consecutive socket tables. Only living connections are kept.
+type Differ struct {
+	live           map[string]*Connection
+	initialized    bool
+	stuckThreshold time.Duration
+}
+
+// NewDiffer creates a differ, connections staying in a closing state longer than stuckThreshold are reported once.
+// Pass 0 to disable STUCK events.
+func NewDiffer(stuckThreshold time.Duration) *Differ {
+	return &Differ{
+		live:           make(map[string]*Connection),
+		stuckThreshold: stuckThreshold,
+	}
+}
+
+// Diff compares the socket table with the previous one. The first table is the baseline, no events for it.
+func (d *Differ) Diff(m *gproto.TcpMetric) []*gproto.ConnectionEvent {
+	ts := time.Unix(m.GetTimestamp(), 0)
+	events := make([]*gproto.ConnectionEvent, 0)
+	seen := make(map[string]*Connection, len(m.GetSockets()))
+
+	for _, s := range m.GetSockets() {
+		key := Key(s)
+		if _, ok := seen[key]; ok {
+			// duplicated key without cookies, e.g. sockets in TIME-WAIT share the address pair
+			continue
+		}
+
+		c, ok := d.live[key]
+		if !ok {
+			c = &Connection{
+				Key:        key,
+				Cookie:     s.GetCookie(),
+				LocalAddr:  s.GetLocalAddr(),
+				PeerAddr:   s.GetPeerAddr(),
+				StateSince: ts,
+			}
+			c.update(ts, s)
+			if d.initialized {
+				events = append(events, newEvent(gproto.ConnectionEventType_OPENED, c, ts))
+			}
+		} else {
+			prev := c.State
+			c.update(ts, s)
+			if prev != c.State {
+				c.StateSince = ts
+				c.stuckReported = false
+				e := newEvent(gproto.ConnectionEventType_STATE_CHANGED, c, ts)
+				e.PrevState = prev
+				events = append(events, e)
+			}
+		}
+
+		if d.stuckThreshold > 0 && stuckStates[c.State] && !c.stuckReported &&
+			ts.Sub(c.StateSince) >= d.stuckThreshold {
+			c.stuckReported = true
+			e := newEvent(gproto.ConnectionEventType_STUCK, c, ts)
+			e.DurationMs = ts.Sub(c.StateSince).Milliseconds()
+			events = append(events, e)
+		}
+
+		seen[key] = c
+	}
+
+	gone := make([]string, 0)
+	for key := range d.live {
+		if _, ok := seen[key]; !ok {
+			gone = append(gone, key)
+		}
+	}
+	sort.Strings(gone)
+
+	for _, key := range gone {
+		c := d.live[key]
+
+		t := gproto.ConnectionEventType_CLOSED
+		if abortedStates[c.State] {
+			t = gproto.ConnectionEventType_DISAPPEARED
+		}
+		e := newEvent(t, c, c.LastSeen)
+		e.Timestamp = ts.Unix()
+		events = append(events, e)
+	}
+
+	d.live = seen
+	d.initialized = true
+	return events
+}
+
+// newEvent creates an event of the connection, the duration is measured until ts
+func newEvent(t gproto.ConnectionEventType, c *Connection, ts time.Time) *gproto.ConnectionEvent {
+	return &gproto.ConnectionEvent{
+		Type:          t,
+		Cookie:        c.Cookie,
+		LocalAddr:     c.LocalAddr,
+		PeerAddr:      c.PeerAddr,
+		Process:       c.Process,
+		State:         c.State,
+		FirstSeen:     c.FirstSeen.Unix(),
+		DurationMs:    ts.Sub(c.FirstSeen).Milliseconds(),
+		Timestamp:     ts.Unix(),
+		BytesAcked:    c.BytesAcked,
+		BytesReceived: c.BytesReceived,
+	}
+}
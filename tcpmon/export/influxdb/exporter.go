@@ -19,12 +19,23 @@ import (
 )
 
 var ErrTimePointNotIncluded = errors.New("time point not included in this data file")
+var ErrNoMetric = errors.New("no metric in this data file")
+var ErrHostnameNotFound = errors.New("no host info in this data file, the hostname must be specified")
 
 type FastExporter struct {
 	fh     afero.File
+	file   dataFile // fh, or the decompressed data of a sealed file
+	size   int64
 	ranges []RecordRange
 }
 
+// dataFile is the uncompressed data of a data file
+type dataFile interface {
+	io.ReadSeeker
+	io.ReaderAt
+	Name() string
+}
+
 type Range struct {
 	Offset int64
 	Len    uint32
@@ -33,6 +44,14 @@ type Range struct {
 type RecordRange struct {
 	Header Range
 	Body   Range
+	Meta   storage.Header
+}
+
+// exportJob is a record to export. TCP snapshots are reconstructed in order before sent to workers, other records
+// are unmarshalled by workers.
+type exportJob struct {
+	Body   []byte
+	Metric *gproto.Metric
 }
 
 // NewFastExporter creates a new exporter
@@ -48,13 +67,43 @@ func NewFastExporter(f string, fs afero.Fs) (*FastExporter, error) {
 		return nil, errors.Wrap(err, "open file failed")
 	}
 
-	return &FastExporter{
+	r := &FastExporter{
 		fh:     fh,
+		file:   fh,
 		ranges: nil,
-	}, nil
+	}
+
+	if strings.HasSuffix(f, storage.SealFileSuffix) {
+		dicts, err := storage.LoadDicts(fs, f)
+		if err != nil {
+			_ = fh.Close()
+			return nil, err
+		}
+
+		// records are read by the seek table without decompressing the whole file
+		z, err := storage.NewSeekableReader(fh, dicts...)
+		if err != nil {
+			_ = fh.Close()
+			return nil, errors.Wrap(err, "open sealed file failed")
+		}
+		r.file = z
+		r.size = z.Size()
+	} else {
+		stat, err := fh.Stat()
+		if err != nil {
+			_ = fh.Close()
+			return nil, errors.Wrap(err, "stat failed")
+		}
+		r.size = stat.Size()
+	}
+
+	return r, nil
 }
 
 func (r *FastExporter) Close() {
+	if z, ok := r.file.(*storage.SeekableReader); ok {
+		_ = z.Close()
+	}
 	if r.fh != nil {
 		err := r.fh.Close()
 		if err != nil {
@@ -65,7 +114,7 @@ func (r *FastExporter) Close() {
 
 func (r *FastExporter) ReadAt(offset int64, len uint32) ([]byte, error) {
 	buf := make([]byte, len)
-	_, err := r.fh.ReadAt(buf, offset)
+	_, err := r.file.ReadAt(buf, offset)
 	if err != nil {
 		return nil, errors.Wrap(err, "read at failed")
 	}
@@ -94,13 +143,23 @@ func getTimestamp(m *gproto.Metric) (time.Time, error) {
 		return time.Unix(m.Nic.GetTimestamp(), 0), nil
 	case *gproto.Metric_Net:
 		return time.Unix(m.Net.GetTimestamp(), 0), nil
+	case *gproto.Metric_Host:
+		return time.Unix(m.Host.GetTimestamp(), 0), nil
+	case *gproto.Metric_Event:
+		return time.Unix(m.Event.GetTimestamp(), 0), nil
+	case *gproto.Metric_TcpDelta:
+		return time.Unix(m.TcpDelta.GetTimestamp(), 0), nil
+	case *gproto.Metric_Rollup:
+		return time.Unix(m.Rollup.GetTimestamp(), 0), nil
+	case *gproto.Metric_Self:
+		return time.Unix(m.Self.GetTimestamp(), 0), nil
 	default:
 		return time.Time{}, errors.New("unknown metric type")
 	}
 }
 
 type ExportOptions struct {
-	Hostname string
+	Hostname string // leave empty to use the hostname in the host info record
 	Target   time.Time
 	ShowOnly bool
 
@@ -119,39 +178,11 @@ func (r *FastExporter) Export(w io.Writer, option *ExportOptions) error {
 		return err
 	}
 
-	getRecordTime := func(rr RecordRange) (time.Time, error) {
-		buf, err := r.ReadRange(rr.Body)
-		if err != nil {
-			return time.Time{}, err
-		}
-
-		metric, err := r.UnmarshalMetric(buf)
-		if err != nil {
-			return time.Time{}, err
-		}
-
-		start, err := getTimestamp(metric)
-		if err != nil {
-			return time.Time{}, err
-		}
-		return start, nil
-	}
-
-	var start, end time.Time
-	start, err = getRecordTime(ra[0])
+	start, end, err := r.timeRange(ra)
 	if err != nil {
 		return err
 	}
 
-	end, err = getRecordTime(ra[len(ra)-1])
-	if err != nil {
-		return err
-	}
-
-	if start.After(end) {
-		start, end = end, start
-	}
-
 	if option.ShowOnly {
 		log.Info().Time("start", start).Time("end", end).Str("file", r.fh.Name()).Send()
 		return nil
@@ -171,18 +202,25 @@ func (r *FastExporter) Export(w io.Writer, option *ExportOptions) error {
 		return ErrTimePointNotIncluded
 	}
 
+	workerOption := *option
+	if workerOption.Hostname == "" {
+		workerOption.Hostname, err = r.Hostname()
+		if err != nil {
+			return err
+		}
+	}
+
 	var exit sync.WaitGroup
 	var writerMutex sync.Mutex
-	jobs := make(chan RecordRange, 256)
-	defer close(jobs)
-	stop := make(chan struct{})
+	jobs := make(chan exportJob, 256)
 
 	workerNum := runtime.NumCPU()
 	exit.Add(workerNum)
 	for i := 0; i < workerNum; i++ {
-		go r.exportWorker(w, &writerMutex, jobs, &exit, stop, *option)
+		go r.exportWorker(w, &writerMutex, jobs, &exit, workerOption)
 	}
 
+	decoder := storage.NewDeltaDecoder()
 	for _, rr := range ra {
 		if option.Bar != nil {
 			err = option.Bar.Add(1)
@@ -190,78 +228,157 @@ func (r *FastExporter) Export(w io.Writer, option *ExportOptions) error {
 				log.Warn().Err(err).Msg("Add progress bar failed")
 			}
 		}
-		jobs <- rr
+
+		buf, err := r.ReadRange(rr.Body)
+		if err != nil {
+			log.Fatal().Err(err).Msg("Read failed")
+		}
+
+		err = rr.Meta.Verify(buf)
+		if err != nil {
+			log.Warn().Err(err).Int64("offset", rr.Header.Offset).Msg("Corrupted record skipped")
+			// the next deltas may be computed from the corrupted record
+			decoder.Reset()
+			continue
+		}
+
+		metric, err := decoder.DecodeMetric(buf)
+		if errors.Is(err, storage.ErrMissingKeyframe) {
+			// skip to the next keyframe
+			continue
+		}
+		if err != nil {
+			log.Fatal().Err(err).Msg("Decode failed")
+		}
+		jobs <- exportJob{Body: buf, Metric: metric}
 	}
 
-	close(stop)
+	// workers exit after all jobs are done
+	close(jobs)
 	exit.Wait()
 	return nil
 }
 
-func (r *FastExporter) exportWorker(w io.Writer, m *sync.Mutex, jobs <-chan RecordRange, exit *sync.WaitGroup,
-	stop <-chan struct{}, option ExportOptions) {
-work:
-	for {
-		select {
-		case <-stop:
-			break work
-		default:
+// timeRange returns the time of the first and the last metric in the records. The host info at the beginning of the
+// file is collected earlier, it's skipped. Returns ErrNoMetric if there is no metric.
+func (r *FastExporter) timeRange(ra []RecordRange) (time.Time, time.Time, error) {
+	recordTime := func(rr RecordRange) (time.Time, bool) {
+		buf, err := r.ReadRange(rr.Body)
+		if err != nil || rr.Meta.Verify(buf) != nil {
+			return time.Time{}, false
+		}
+		metric, err := r.UnmarshalMetric(buf)
+		if err != nil || metric.GetHost() != nil {
+			return time.Time{}, false
 		}
+		ts, err := getTimestamp(metric)
+		return ts, err == nil
+	}
 
-		select {
-		case job := <-jobs:
-			buf, err := r.ReadRange(job.Body)
-			if err != nil {
-				log.Fatal().Err(err).Msg("Read failed")
-			}
+	first := -1
+	var start, end time.Time
+	for i, rr := range ra {
+		if ts, ok := recordTime(rr); ok {
+			start, first = ts, i
+			break
+		}
+	}
+	if first == -1 {
+		return time.Time{}, time.Time{}, ErrNoMetric
+	}
+	for i := len(ra) - 1; i >= first; i-- {
+		if ts, ok := recordTime(ra[i]); ok {
+			end = ts
+			break
+		}
+	}
 
-			metric, err := r.UnmarshalMetric(buf)
+	if start.After(end) {
+		start, end = end, start
+	}
+	return start, end, nil
+}
+
+// Hostname returns the hostname in the first host info record of the data file
+func (r *FastExporter) Hostname() (string, error) {
+	ra, err := r.Scan()
+	if err != nil {
+		return "", err
+	}
+
+	for _, rr := range ra {
+		buf, err := r.ReadRange(rr.Body)
+		if err != nil {
+			return "", err
+		}
+		if rr.Meta.Verify(buf) != nil {
+			continue
+		}
+
+		metric, err := r.UnmarshalMetric(buf)
+		if err != nil {
+			return "", err
+		}
+
+		if host := metric.GetHost(); host != nil && host.GetHostname() != "" {
+			return host.GetHostname(), nil
+		}
+	}
+
+	return "", ErrHostnameNotFound
+}
+
+func (r *FastExporter) exportWorker(w io.Writer, m *sync.Mutex, jobs <-chan exportJob, exit *sync.WaitGroup,
+	option ExportOptions) {
+	defer exit.Done()
+
+	for job := range jobs {
+		var err error
+		metric := job.Metric
+		if metric == nil {
+			metric, err = r.UnmarshalMetric(job.Body)
 			if err != nil {
 				log.Fatal().Err(err).Msg("Unmarshal failed")
 			}
+		}
 
-			if option.WriteDb {
-				// use influxdb client write metrics to db
-				conn := NewImporter(&ImportOption{
-					Bucket:   option.Bucket,
-					Org:      option.Org,
-					Token:    option.Token,
-					Address:  option.DbAddress,
-					Hostname: option.Hostname,
-				})
-
-				err = conn.Submit(metric)
-				if err != nil {
-					conn.Close()
-					log.Fatal().Err(err).Msg("Submit failed")
-				}
+		if option.WriteDb {
+			// use influxdb client write metrics to db
+			conn := NewImporter(&ImportOption{
+				Bucket:   option.Bucket,
+				Org:      option.Org,
+				Token:    option.Token,
+				Address:  option.DbAddress,
+				Hostname: option.Hostname,
+			})
+
+			err = conn.Submit(metric)
+			if err != nil {
 				conn.Close()
+				log.Fatal().Err(err).Msg("Submit failed")
+			}
+			conn.Close()
 
-			} else {
-				// export metrics to txt file with line protocol
-				var builder strings.Builder
-				exporter := New(option.Hostname, &builder)
-				exporter.ExportMetric(metric)
-
-				m.Lock()
-				writer := bufio.NewWriter(w)
-				_, err = writer.WriteString(builder.String())
-				if err != nil {
-					log.Fatal().Err(err).Msg("Write failed")
-				}
-
-				err = writer.Flush()
-				if err != nil {
-					log.Fatal().Err(err).Msg("Flush failed")
-				}
-				m.Unlock()
+		} else {
+			// export metrics to txt file with line protocol
+			var builder strings.Builder
+			exporter := New(option.Hostname, &builder)
+			exporter.ExportMetric(metric)
+
+			m.Lock()
+			writer := bufio.NewWriter(w)
+			_, err = writer.WriteString(builder.String())
+			if err != nil {
+				log.Fatal().Err(err).Msg("Write failed")
 			}
 
-		case <-time.After(time.Second):
-			log.Info().Msg("Get job timeout")
+			err = writer.Flush()
+			if err != nil {
+				log.Fatal().Err(err).Msg("Flush failed")
+			}
+			m.Unlock()
 		}
 	}
-	exit.Done()
 }
 
 func (r *FastExporter) Scan() ([]RecordRange, error) {
@@ -275,22 +392,32 @@ func (r *FastExporter) Scan() ([]RecordRange, error) {
 func (r *FastExporter) doScan() ([]RecordRange, error) {
 	ranges := make([]RecordRange, 0)
 
+	_, err := r.file.Seek(0, io.SeekStart)
+	if err != nil {
+		return nil, errors.Wrap(err, "seek failed")
+	}
+
 	offset := int64(0)
-	var err error
 	for {
 		var ra RecordRange
-		var size uint32
+		var h storage.Header
 
-		size, err = storage.ReadHeader(r.fh)
+		h, err = storage.ReadHeader(r.file)
 		if err != nil {
 			break
 		}
+		ra.Meta = h
 		ra.Header.Offset = offset
-		ra.Header.Len = storage.HeaderSize
-		ra.Body.Offset = offset + storage.HeaderSize
-		ra.Body.Len = size
+		ra.Header.Len = uint32(h.Len())
+		ra.Body.Offset = offset + int64(h.Len())
+		ra.Body.Len = h.Size
+
+		if ra.Body.Offset+int64(h.Size) > r.size {
+			err = errors.Wrapf(storage.ErrTornRecord, "record at offset %d exceeds the file", offset)
+			break
+		}
 
-		offset, err = r.fh.Seek(int64(size), io.SeekCurrent)
+		offset, err = r.file.Seek(int64(h.Size), io.SeekCurrent)
 		if err != nil {
 			break
 		}
@@ -298,7 +425,10 @@ func (r *FastExporter) doScan() ([]RecordRange, error) {
 		ranges = append(ranges, ra)
 	}
 	if err != nil && err != io.EOF {
-		return nil, errors.Wrapf(err, "scan data file failed")
+		if !errors.Is(err, storage.ErrTornRecord) {
+			return nil, errors.Wrapf(err, "scan data file failed")
+		}
+		log.Warn().Err(err).Str("file", r.fh.Name()).Int64("offset", offset).Msg("Torn tail ignored")
 	}
 
 	r.ranges = ranges
@@ -32,6 +32,14 @@ func (e *LineProtocolExporter) ExportMetric(m *gproto.Metric) {
 		e.exportMetricNet(m.Net)
 	case *gproto.Metric_Nic:
 		e.exportMetricNic(m.Nic)
+	case *gproto.Metric_Host:
+		e.exportMetricHost(m.Host)
+	case *gproto.Metric_Event:
+		e.exportMetricEvent(m.Event)
+	case *gproto.Metric_Rollup:
+		e.exportMetricRollup(m.Rollup)
+	case *gproto.Metric_Self:
+		e.exportMetricSelf(m.Self)
 	default:
 		log.Fatal().Msg("Unknown metric type")
 	}
@@ -58,6 +66,10 @@ func (e *LineProtocolExporter) exportMetricTcp(m *gproto.TcpMetric) {
 		} else {
 			prefix = fmt.Sprintf("tcp,LocalAddr=%s,PeerAddr=%s,Hostname=%s,Process=%v", s.GetLocalAddr(), s.GetPeerAddr(), e.hostname, processText)
 		}
+		if s.GetCookie() != 0 {
+			// tells a reused address pair from a long-lived connection
+			prefix += fmt.Sprintf(",Cookie=%x", s.GetCookie())
+		}
 
 		for _, timer := range s.GetTimers() {
 			e.Printf("%s Timer=\"%v\",ExpireTimeUs=%v,Retrans=%v %v", prefix, timer.GetName(), timer.GetExpireTimeUs(), timer.GetRetrans(), ts)
@@ -167,6 +179,7 @@ func (e *LineProtocolExporter) exportMetricNet(m *gproto.NetstatMetric) {
 	e.Printf("%s IpFragOks=%v %v", prefix, m.GetIpFragOks(), ts)
 	e.Printf("%s IpFragFails=%v %v", prefix, m.GetIpFragFails(), ts)
 	e.Printf("%s IpFragCreates=%v %v", prefix, m.GetIpFragCreates(), ts)
+	e.Printf("%s IpOutTransmits=%v %v", prefix, m.GetIpOutTransmits(), ts)
 	e.Printf("%s IpInNoRoutes=%v %v", prefix, m.GetIpInNoRoutes(), ts)
 	e.Printf("%s IpInTruncatedPkts=%v %v", prefix, m.GetIpInTruncatedPkts(), ts)
 	e.Printf("%s IpInMcastPkts=%v %v", prefix, m.GetIpInMcastPkts(), ts)
@@ -368,3 +381,62 @@ func (e *LineProtocolExporter) exportMetricNet(m *gproto.NetstatMetric) {
 	e.Printf("%s IcmpOutAddrMasks=%v %v", prefix, m.GetIcmpOutAddrMasks(), ts)
 	e.Printf("%s IcmpOutAddrMaskReps=%v %v", prefix, m.GetIcmpOutAddrMaskReps(), ts)
 }
+
+func (e *LineProtocolExporter) exportMetricHost(m *gproto.HostInfo) {
+	ts := m.GetTimestamp()
+	prefix := fmt.Sprintf("host,Hostname=%s", e.hostname)
+
+	e.Printf("%s MachineId=\"%v\" %v", prefix, m.GetMachineId(), ts)
+	e.Printf("%s BootId=\"%v\" %v", prefix, m.GetBootId(), ts)
+	e.Printf("%s KernelRelease=\"%v\" %v", prefix, m.GetKernelRelease(), ts)
+	e.Printf("%s Version=\"%v\" %v", prefix, m.GetVersion(), ts)
+	e.Printf("%s CpuCount=%v %v", prefix, m.GetCpuCount(), ts)
+	e.Printf("%s Interfaces=\"%v\" %v", prefix, strings.Join(m.GetInterfaces(), ","), ts)
+	e.Printf("%s CollectIntervalMs=%v %v", prefix, m.GetCollectIntervalMs(), ts)
+	e.Printf("%s ConfigHash=\"%v\" %v", prefix, m.GetConfigHash(), ts)
+}
+
+func (e *LineProtocolExporter) exportMetricEvent(m *gproto.EventMetric) {
+	for _, ev := range m.GetEvents() {
+		prefix := fmt.Sprintf("conn_event,Hostname=%s,Event=%s,LocalAddr=%s,PeerAddr=%s",
+			e.hostname, strings.ToLower(ev.GetType().String()), ev.GetLocalAddr(), ev.GetPeerAddr())
+		if ev.GetProcess() != "" {
+			prefix += fmt.Sprintf(",ProcessName=%s", ev.GetProcess())
+		}
+		if ev.GetCookie() != 0 {
+			prefix += fmt.Sprintf(",Cookie=%x", ev.GetCookie())
+		}
+
+		e.Printf("%s State=\"%v\",PrevState=\"%v\",FirstSeen=%v,DurationMs=%v,BytesAcked=%v,BytesReceived=%v %v",
+			prefix, ev.GetState(), ev.GetPrevState(), ev.GetFirstSeen(), ev.GetDurationMs(),
+			ev.GetBytesAcked(), ev.GetBytesReceived(), ev.GetTimestamp())
+	}
+}
+
+func (e *LineProtocolExporter) exportMetricRollup(m *gproto.RollupMetric) {
+	for _, g := range m.GetGroups() {
+		prefix := rollupMeasurement(m)
+		for _, tag := range rollupTags(e.hostname, m, g) {
+			prefix += fmt.Sprintf(",%s=%s", tag.Key, tag.Value)
+		}
+
+		for _, f := range rollupFields(g) {
+			e.Printf("%s %s=%v %v", prefix, f.Name, f.Value, m.GetTimestamp())
+		}
+	}
+}
+
+func (e *LineProtocolExporter) exportMetricSelf(m *gproto.SelfMetric) {
+	ts := m.GetTimestamp()
+	prefix := fmt.Sprintf("self,Hostname=%s", e.hostname)
+	for _, f := range messageFields(m.ProtoReflect()) {
+		e.Printf("%s %s=%v %v", prefix, f.Key, f.Value, ts)
+	}
+
+	for _, c := range m.GetCollectors() {
+		prefix = fmt.Sprintf("self_collector,Hostname=%s,Name=%s", e.hostname, c.GetName())
+		for _, f := range messageFields(c.ProtoReflect()) {
+			e.Printf("%s %s=%v %v", prefix, f.Key, f.Value, ts)
+		}
+	}
+}
@@ -8,8 +8,10 @@ import (
 	"github.com/influxdata/influxdb-client-go/v2/api/write"
 	"github.com/rs/zerolog/log"
 	"github.com/samber/lo"
+	"google.golang.org/protobuf/reflect/protoreflect"
 
 	"github.com/zperf/tcpmon/tcpmon/gproto"
+	"github.com/zperf/tcpmon/tcpmon/rollup"
 )
 
 type MetricConv struct {
@@ -28,12 +30,181 @@ func (c *MetricConv) Metric(metric *gproto.Metric) (int64, []*write.Point) {
 		return m.Net.Timestamp, c.Net(m.Net)
 	case *gproto.Metric_Nic:
 		return m.Nic.Timestamp, c.Nic(m.Nic)
+	case *gproto.Metric_Host:
+		return m.Host.Timestamp, c.Host(m.Host)
+	case *gproto.Metric_Event:
+		return m.Event.Timestamp, c.Event(m.Event)
+	case *gproto.Metric_Rollup:
+		return m.Rollup.Timestamp, c.Rollup(m.Rollup)
+	case *gproto.Metric_Self:
+		return m.Self.Timestamp, c.Self(m.Self)
 	default:
 		log.Fatal().Msg("Unknown metric type")
 	}
 	return 0, nil
 }
 
+func (c *MetricConv) Host(metric *gproto.HostInfo) []*write.Point {
+	ts := time.Unix(metric.GetTimestamp(), 0)
+	p := write.NewPoint("host",
+		map[string]string{"Hostname": c.Hostname},
+		map[string]interface{}{
+			"MachineId":         metric.GetMachineId(),
+			"BootId":            metric.GetBootId(),
+			"KernelRelease":     metric.GetKernelRelease(),
+			"Version":           metric.GetVersion(),
+			"CpuCount":          metric.GetCpuCount(),
+			"Interfaces":        strings.Join(metric.GetInterfaces(), ","),
+			"CollectIntervalMs": metric.GetCollectIntervalMs(),
+			"ConfigHash":        metric.GetConfigHash(),
+		},
+		ts)
+	return []*write.Point{p}
+}
+
+func (c *MetricConv) Event(metric *gproto.EventMetric) []*write.Point {
+	points := make([]*write.Point, 0, len(metric.GetEvents()))
+	for _, e := range metric.GetEvents() {
+		tags := map[string]string{
+			"Hostname":  c.Hostname,
+			"Event":     strings.ToLower(e.GetType().String()),
+			"LocalAddr": e.GetLocalAddr(),
+			"PeerAddr":  e.GetPeerAddr(),
+		}
+		if e.GetProcess() != "" {
+			tags["ProcessName"] = e.GetProcess()
+		}
+		if e.GetCookie() != 0 {
+			tags["Cookie"] = fmt.Sprintf("%x", e.GetCookie())
+		}
+
+		p := write.NewPoint("conn_event", tags,
+			map[string]interface{}{
+				"State":         e.GetState().String(),
+				"PrevState":     e.GetPrevState().String(),
+				"FirstSeen":     e.GetFirstSeen(),
+				"DurationMs":    e.GetDurationMs(),
+				"BytesAcked":    e.GetBytesAcked(),
+				"BytesReceived": e.GetBytesReceived(),
+			},
+			time.Unix(e.GetTimestamp(), 0))
+		points = append(points, p)
+	}
+	return points
+}
+
+// Rollup returns a point of each group at the start of the window, e.g. rollup_tcp
+func (c *MetricConv) Rollup(metric *gproto.RollupMetric) []*write.Point {
+	ts := time.Unix(metric.GetTimestamp(), 0)
+	points := make([]*write.Point, 0, len(metric.GetGroups()))
+	for _, g := range metric.GetGroups() {
+		fields := make(map[string]interface{})
+		for _, f := range rollupFields(g) {
+			fields[f.Name] = f.Value
+		}
+		points = append(points, write.NewPoint(rollupMeasurement(metric),
+			lo.FromEntries(rollupTags(c.Hostname, metric, g)), fields, ts))
+	}
+	return points
+}
+
+// Self returns a point of the cost of tcpmon and a point for each collector
+func (c *MetricConv) Self(metric *gproto.SelfMetric) []*write.Point {
+	ts := time.Unix(metric.GetTimestamp(), 0)
+	points := make([]*write.Point, 0, len(metric.GetCollectors())+1)
+	points = append(points, write.NewPoint("self", map[string]string{"Hostname": c.Hostname},
+		lo.FromEntries(messageFields(metric.ProtoReflect())), ts))
+	for _, cc := range metric.GetCollectors() {
+		points = append(points, write.NewPoint("self_collector",
+			map[string]string{"Hostname": c.Hostname, "Name": cc.GetName()},
+			lo.FromEntries(messageFields(cc.ProtoReflect())), ts))
+	}
+	return points
+}
+
+// messageFields returns the numeric fields of the message in PascalCase, e.g. HeapAllocBytes. Headers, strings and
+// lists are skipped.
+func messageFields(m protoreflect.Message) []lo.Entry[string, any] {
+	fields := make([]lo.Entry[string, any], 0)
+	list := m.Descriptor().Fields()
+	for i := 0; i < list.Len(); i++ {
+		fd := list.Get(i)
+		name := string(fd.Name())
+		if fd.IsList() || name == "timestamp" || name == "type" {
+			continue
+		}
+		var value any
+		switch fd.Kind() {
+		case protoreflect.Int64Kind:
+			value = m.Get(fd).Int()
+		case protoreflect.Uint32Kind, protoreflect.Uint64Kind:
+			value = m.Get(fd).Uint()
+		case protoreflect.DoubleKind:
+			value = m.Get(fd).Float()
+		default:
+			continue
+		}
+		fields = append(fields, lo.Entry[string, any]{Key: pascalCase(name), Value: value})
+	}
+	return fields
+}
+
+func rollupMeasurement(metric *gproto.RollupMetric) string {
+	return "rollup_" + strings.ToLower(metric.GetSource().String())
+}
+
+// rollupTags returns tags of the group in a stable order
+func rollupTags(hostname string, metric *gproto.RollupMetric, g *gproto.RollupGroup) []lo.Entry[string, string] {
+	tags := []lo.Entry[string, string]{
+		{Key: "Hostname", Value: hostname},
+		{Key: "Resolution", Value: (time.Duration(metric.GetResolution()) * time.Second).String()},
+	}
+	if g.GetKey() == "" {
+		return tags
+	}
+
+	switch metric.GetSource() {
+	case gproto.MetricType_NIC:
+		tags = append(tags, lo.Entry[string, string]{Key: "Name", Value: g.GetKey()})
+	case gproto.MetricType_TCP:
+		tags = append(tags,
+			lo.Entry[string, string]{Key: "Key", Value: g.GetKey()},
+			lo.Entry[string, string]{Key: "LocalAddr", Value: g.GetLocalAddr()},
+			lo.Entry[string, string]{Key: "PeerAddr", Value: g.GetPeerAddr()})
+	}
+	return tags
+}
+
+type rollupField struct {
+	Name  string
+	Value any
+}
+
+// rollupFields returns min, max, avg and last of each field in the group, e.g. RetransTotalMax
+func rollupFields(g *gproto.RollupGroup) []rollupField {
+	fields := []rollupField{{Name: "Samples", Value: g.GetSamples()}}
+	for _, f := range g.GetFields() {
+		name := pascalCase(f.GetName())
+		fields = append(fields,
+			rollupField{Name: name + "Min", Value: f.GetMin()},
+			rollupField{Name: name + "Max", Value: f.GetMax()},
+			rollupField{Name: name + "Avg", Value: rollup.Avg(g, f)},
+			rollupField{Name: name + "Last", Value: f.GetLast()})
+	}
+	return fields
+}
+
+// pascalCase converts snake_case names of proto fields, e.g. retrans_total to RetransTotal
+func pascalCase(s string) string {
+	words := strings.Split(s, "_")
+	for i, w := range words {
+		if w != "" {
+			words[i] = strings.ToUpper(w[:1]) + w[1:]
+		}
+	}
+	return strings.Join(words, "")
+}
+
 func (c *MetricConv) Nic(metric *gproto.NicMetric) []*write.Point {
 	ts := time.Unix(metric.GetTimestamp(), 0)
 	points := make([]*write.Point, 0)
@@ -127,6 +298,9 @@ func (c *MetricConv) Tcp(metric *gproto.TcpMetric) []*write.Point {
 			tags["Process"] = processText
 			tags["ProcessName"] = s.GetProcesses()[0].GetName()
 		}
+		if s.GetCookie() != 0 {
+			tags["Cookie"] = fmt.Sprintf("%x", s.GetCookie())
+		}
 
 		for _, timer := range s.GetTimers() {
 			p := write.NewPoint("tcp", tags,
@@ -456,6 +630,11 @@ func (c *MetricConv) Net(metric *gproto.NetstatMetric) []*write.Point {
 		map[string]interface{}{"IpFragCreates": metric.IpFragCreates},
 		ts)
 	points = append(points, p)
+	p = write.NewPoint("net",
+		map[string]string{"Hostname": c.Hostname},
+		map[string]interface{}{"IpOutTransmits": metric.IpOutTransmits},
+		ts)
+	points = append(points, p)
 	p = write.NewPoint("net",
 		map[string]string{"Hostname": c.Hostname},
 		map[string]interface{}{"IpInNoRoutes": metric.IpInNoRoutes},
@@ -0,0 +1,447 @@
+package prometheus
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"github.com/zperf/tcpmon/tcpmon/gproto"
+)
+
+const (
+	ContentTypeText        = "text/plain; version=0.0.4; charset=utf-8"
+	ContentTypeOpenMetrics = "application/openmetrics-text; version=1.0.0; charset=utf-8"
+)
+
+// Format is the exposition format, the Prometheus text format or OpenMetrics
+type Format int
+
+const (
+	FormatText Format = iota
+	FormatOpenMetrics
+)
+
+// NegotiateFormat returns OpenMetrics if the Accept header asks for it, or the Prometheus text format
+func NegotiateFormat(accept string) Format {
+	if strings.Contains(accept, "application/openmetrics-text") {
+		return FormatOpenMetrics
+	}
+	return FormatText
+}
+
+func (f Format) ContentType() string {
+	if f == FormatOpenMetrics {
+		return ContentTypeOpenMetrics
+	}
+	return ContentTypeText
+}
+
+var (
+	// RttBuckets are the upper bounds of the socket RTT histogram in milliseconds
+	RttBuckets = []float64{0.1, 0.5, 1, 5, 10, 50, 100, 500, 1000}
+	// RetransBuckets are the upper bounds of the socket retransmissions histogram
+	RetransBuckets = []float64{0, 1, 5, 10, 50, 100, 500}
+)
+
+// netstat fields exposed as gauges, others are counters
+var netstatGauges = map[protoreflect.Name]bool{
+	"ip_forwarding":     true,
+	"ip_default_ttl":    true,
+	"tcp_rto_algorithm": true,
+	"tcp_rto_min":       true,
+	"tcp_rto_max":       true,
+	"tcp_max_conn":      true,
+	"tcp_curr_estab":    true,
+}
+
+// Snapshot is the latest metric of each type, metrics not collected yet are nil
+type Snapshot struct {
+	Tcp *gproto.TcpMetric
+	Nic *gproto.NicMetric
+	Net *gproto.NetstatMetric
+}
+
+// Config limits the series labelled by socket addresses
+type Config struct {
+	// TopN is the number of sockets exposed with their own series, by retransmissions. 0 disables them
+	TopN int
+	// MaxSeries caps the label sets of listeners and top sockets, the rest are dropped and counted in
+	// tcpmon_scrape_dropped_series. 0 for no limit
+	MaxSeries int
+}
+
+// Write writes the snapshot in the exposition format
+func Write(w io.Writer, s *Snapshot, format Format, config Config) error {
+	e := &encoder{w: w, format: format}
+
+	e.collectTimestamps(s)
+	if s.Net != nil {
+		e.netstat(s.Net)
+	}
+	if s.Nic != nil {
+		e.nic(s.Nic)
+	}
+	if s.Tcp != nil {
+		e.sockets(s.Tcp, config)
+	}
+	if format == FormatOpenMetrics {
+		e.printf("# EOF\n")
+	}
+	return e.err
+}
+
+func (e *encoder) collectTimestamps(s *Snapshot) {
+	e.family("tcpmon_collect_timestamp_seconds", "gauge", "Unix time of the latest collection by metric type")
+	if s.Tcp != nil {
+		e.sample("tcpmon_collect_timestamp_seconds", labels("type", "tcp"), float64(s.Tcp.GetTimestamp()))
+	}
+	if s.Nic != nil {
+		e.sample("tcpmon_collect_timestamp_seconds", labels("type", "nic"), float64(s.Nic.GetTimestamp()))
+	}
+	if s.Net != nil {
+		e.sample("tcpmon_collect_timestamp_seconds", labels("type", "net"), float64(s.Net.GetTimestamp()))
+	}
+}
+
+// netstat writes each field of /proc/net/snmp and /proc/net/netstat, e.g. tcpmon_netstat_tcp_retrans_segs_total
+func (e *encoder) netstat(m *gproto.NetstatMetric) {
+	r := m.ProtoReflect()
+	fields := r.Descriptor().Fields()
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		if fd.Name() == "timestamp" || fd.Name() == "type" {
+			continue
+		}
+
+		name := "tcpmon_netstat_" + string(fd.Name())
+		if netstatGauges[fd.Name()] {
+			e.family(name, "gauge", "Netstat "+string(fd.Name()))
+			e.sample(name, nil, numeric(r.Get(fd), fd))
+		} else {
+			e.family(name+"_total", "counter", "Netstat "+string(fd.Name()))
+			e.sample(name+"_total", nil, numeric(r.Get(fd), fd))
+		}
+	}
+}
+
+// nic writes the error counters of each interface, e.g. tcpmon_nic_rx_dropped_total{iface="eth0"}
+func (e *encoder) nic(m *gproto.NicMetric) {
+	fields := (&gproto.IfaceMetric{}).ProtoReflect().Descriptor().Fields()
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		if fd.Name() == "name" {
+			continue
+		}
+
+		name := "tcpmon_nic_" + string(fd.Name()) + "_total"
+		e.family(name, "counter", "NIC "+string(fd.Name()))
+		for _, iface := range m.GetIfaces() {
+			e.sample(name, labels("iface", iface.GetName()), numeric(iface.ProtoReflect().Get(fd), fd))
+		}
+	}
+}
+
+func (e *encoder) sockets(m *gproto.TcpMetric, config Config) {
+	states := make(map[gproto.SocketState]int)
+	var rtt, retrans histogram
+	rtt.init(RttBuckets)
+	retrans.init(RetransBuckets)
+	connected := make([]*gproto.SocketMetric, 0, len(m.GetSockets()))
+	for _, s := range m.GetSockets() {
+		states[s.GetState()]++
+		// listeners and sockets without TCP info (ss without -i) have no RTT
+		if s.GetState() == gproto.SocketState_TCP_LISTEN || s.GetRtt() == 0 {
+			continue
+		}
+		rtt.observe(s.GetRtt())
+		retrans.observe(float64(s.GetRetransTotal()))
+		connected = append(connected, s)
+	}
+
+	e.family("tcpmon_sockets", "gauge", "Sockets by state")
+	for i := 0; i < len(gproto.SocketState_name); i++ {
+		state := gproto.SocketState(i)
+		e.sample("tcpmon_sockets", labels("state", stateName(state)), float64(states[state]))
+	}
+	e.histogram("tcpmon_sockets_rtt_milliseconds", "Smoothed RTT of sockets", &rtt)
+	e.histogram("tcpmon_sockets_retrans", "Total retransmissions of sockets", &retrans)
+
+	budget := config.MaxSeries
+	if budget <= 0 {
+		budget = math.MaxInt
+	}
+	dropped := 0
+
+	listeners := listenerQueues(m.GetSockets())
+	if len(listeners) > budget {
+		dropped += len(listeners) - budget
+		listeners = listeners[:budget]
+	}
+	budget -= len(listeners)
+	e.family("tcpmon_listener_accept_queue", "gauge", "Connections waiting to be accepted by the listener")
+	for _, l := range listeners {
+		e.sample("tcpmon_listener_accept_queue", labels("local", l.local, "process", l.process), l.queue)
+	}
+	e.family("tcpmon_listener_accept_queue_max", "gauge", "Backlog of the listener")
+	for _, l := range listeners {
+		e.sample("tcpmon_listener_accept_queue_max", labels("local", l.local, "process", l.process), l.max)
+	}
+
+	if config.TopN > 0 {
+		top := topSockets(connected, config.TopN)
+		if len(top) > budget {
+			dropped += len(top) - budget
+			top = top[:budget]
+		}
+		e.topSockets(top)
+	}
+
+	e.family("tcpmon_scrape_dropped_series", "gauge", "Label sets of listeners and sockets dropped by the cap")
+	e.sample("tcpmon_scrape_dropped_series", nil, float64(dropped))
+}
+
+// topSockets writes a series of each field for each socket
+func (e *encoder) topSockets(sockets []*gproto.SocketMetric) {
+	fields := []struct {
+		name  string
+		help  string
+		value func(s *gproto.SocketMetric) float64
+	}{
+		{"tcpmon_socket_rtt_milliseconds", "Smoothed RTT of the socket",
+			func(s *gproto.SocketMetric) float64 { return s.GetRtt() }},
+		{"tcpmon_socket_retrans", "Total retransmissions of the socket",
+			func(s *gproto.SocketMetric) float64 { return float64(s.GetRetransTotal()) }},
+		{"tcpmon_socket_cwnd", "Congestion window of the socket",
+			func(s *gproto.SocketMetric) float64 { return float64(s.GetCwnd()) }},
+		{"tcpmon_socket_recv_queue_bytes", "Bytes not copied by the user program",
+			func(s *gproto.SocketMetric) float64 { return float64(s.GetRecvQ()) }},
+		{"tcpmon_socket_send_queue_bytes", "Bytes not acknowledged by the peer",
+			func(s *gproto.SocketMetric) float64 { return float64(s.GetSendQ()) }},
+		{"tcpmon_socket_bytes_acked", "Bytes acknowledged by the peer",
+			func(s *gproto.SocketMetric) float64 { return float64(s.GetBytesAcked()) }},
+		{"tcpmon_socket_bytes_received", "Bytes received from the peer",
+			func(s *gproto.SocketMetric) float64 { return float64(s.GetBytesReceived()) }},
+	}
+
+	for _, f := range fields {
+		e.family(f.name, "gauge", f.help+", top sockets by retransmissions only")
+		for _, s := range sockets {
+			e.sample(f.name, labels("local", s.GetLocalAddr(), "peer", s.GetPeerAddr(), "process", process(s)),
+				f.value(s))
+		}
+	}
+}
+
+type listener struct {
+	local   string
+	process string
+	queue   float64
+	max     float64
+}
+
+// listenerQueues returns the accept queue of each listener in the order of addresses. Listeners on the same address
+// and process, e.g. with SO_REUSEPORT, are summed up.
+func listenerQueues(sockets []*gproto.SocketMetric) []*listener {
+	listeners := make(map[string]*listener)
+	for _, s := range sockets {
+		if s.GetState() != gproto.SocketState_TCP_LISTEN {
+			continue
+		}
+		key := s.GetLocalAddr() + "|" + process(s)
+		l, ok := listeners[key]
+		if !ok {
+			l = &listener{local: s.GetLocalAddr(), process: process(s)}
+			listeners[key] = l
+		}
+		// for listeners, Recv-Q is the accept queue and Send-Q is the backlog
+		l.queue += float64(s.GetRecvQ())
+		l.max += float64(s.GetSendQ())
+	}
+
+	r := make([]*listener, 0, len(listeners))
+	for _, l := range listeners {
+		r = append(r, l)
+	}
+	sort.Slice(r, func(i, j int) bool {
+		if r[i].local != r[j].local {
+			return r[i].local < r[j].local
+		}
+		return r[i].process < r[j].process
+	})
+	return r
+}
+
+// topSockets returns the top n sockets by retransmissions, then by bytes, sockets with the same addresses are
+// exposed once
+func topSockets(sockets []*gproto.SocketMetric, n int) []*gproto.SocketMetric {
+	sorted := make([]*gproto.SocketMetric, len(sockets))
+	copy(sorted, sockets)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		a, b := sorted[i], sorted[j]
+		if a.GetRetransTotal() != b.GetRetransTotal() {
+			return a.GetRetransTotal() > b.GetRetransTotal()
+		}
+		return a.GetBytesAcked()+a.GetBytesReceived() > b.GetBytesAcked()+b.GetBytesReceived()
+	})
+
+	seen := make(map[string]bool)
+	top := make([]*gproto.SocketMetric, 0, n)
+	for _, s := range sorted {
+		if len(top) == n {
+			break
+		}
+		key := s.GetLocalAddr() + "|" + s.GetPeerAddr() + "|" + process(s)
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		top = append(top, s)
+	}
+	return top
+}
+
+func process(s *gproto.SocketMetric) string {
+	if len(s.GetProcesses()) == 0 {
+		return ""
+	}
+	return s.GetProcesses()[0].GetName()
+}
+
+func stateName(state gproto.SocketState) string {
+	return strings.ToLower(strings.TrimPrefix(state.String(), "TCP_"))
+}
+
+func numeric(v protoreflect.Value, fd protoreflect.FieldDescriptor) float64 {
+	switch fd.Kind() {
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind,
+		protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		return float64(v.Int())
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind, protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		return float64(v.Uint())
+	case protoreflect.FloatKind, protoreflect.DoubleKind:
+		return v.Float()
+	}
+	return 0
+}
+
+type histogram struct {
+	bounds []float64
+	counts []uint64 // cumulative
+	count  uint64
+	sum    float64
+}
+
+func (h *histogram) init(bounds []float64) {
+	h.bounds = bounds
+	h.counts = make([]uint64, len(bounds))
+}
+
+func (h *histogram) observe(v float64) {
+	for i, bound := range h.bounds {
+		if v <= bound {
+			h.counts[i]++
+		}
+	}
+	h.count++
+	h.sum += v
+}
+
+type label struct {
+	name  string
+	value string
+}
+
+func labels(kv ...string) []label {
+	r := make([]label, 0, len(kv)/2)
+	for i := 0; i+1 < len(kv); i += 2 {
+		r = append(r, label{name: kv[i], value: kv[i+1]})
+	}
+	return r
+}
+
+// encoder writes metric families, the first error is kept and later writes are skipped
+type encoder struct {
+	w      io.Writer
+	format Format
+	err    error
+}
+
+func (e *encoder) printf(format string, args ...any) {
+	if e.err != nil {
+		return
+	}
+	_, err := fmt.Fprintf(e.w, format, args...)
+	e.err = errors.WithStack(err)
+}
+
+// family writes the metadata of a family. Counters are named with _total in the text format, OpenMetrics names the
+// family without it.
+func (e *encoder) family(name string, typ string, help string) {
+	if e.format == FormatOpenMetrics && typ == "counter" {
+		name = strings.TrimSuffix(name, "_total")
+	}
+	e.printf("# HELP %s %s\n", name, e.escapeHelp(help))
+	e.printf("# TYPE %s %s\n", name, typ)
+}
+
+func (e *encoder) sample(name string, labels []label, value float64) {
+	var sb strings.Builder
+	sb.WriteString(name)
+	if len(labels) != 0 {
+		sb.WriteByte('{')
+		for i, l := range labels {
+			if i != 0 {
+				sb.WriteByte(',')
+			}
+			sb.WriteString(l.name)
+			sb.WriteString(`="`)
+			sb.WriteString(escapeLabel(l.value))
+			sb.WriteByte('"')
+		}
+		sb.WriteByte('}')
+	}
+	e.printf("%s %s\n", sb.String(), formatValue(value))
+}
+
+func (e *encoder) histogram(name string, help string, h *histogram) {
+	e.family(name, "histogram", help)
+	for i, bound := range h.bounds {
+		e.sample(name+"_bucket", labels("le", formatValue(bound)), float64(h.counts[i]))
+	}
+	e.sample(name+"_bucket", labels("le", "+Inf"), float64(h.count))
+	e.sample(name+"_sum", nil, h.sum)
+	e.sample(name+"_count", nil, float64(h.count))
+}
+
+func (e *encoder) escapeHelp(s string) string {
+	s = strings.ReplaceAll(s, `\`, `\\`)
+	s = strings.ReplaceAll(s, "\n", `\n`)
+	if e.format == FormatOpenMetrics {
+		s = strings.ReplaceAll(s, `"`, `\"`)
+	}
+	return s
+}
+
+func escapeLabel(s string) string {
+	s = strings.ReplaceAll(s, `\`, `\\`)
+	s = strings.ReplaceAll(s, "\n", `\n`)
+	return strings.ReplaceAll(s, `"`, `\"`)
+}
+
+func formatValue(v float64) string {
+	switch {
+	case math.IsInf(v, 1):
+		return "+Inf"
+	case math.IsInf(v, -1):
+		return "-Inf"
+	case math.IsNaN(v):
+		return "NaN"
+	}
+	return strconv.FormatFloat(v, 'g', -1, 64)
+}
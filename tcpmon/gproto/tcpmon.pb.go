@@ -23,9 +23,14 @@ const (
 type MetricType int32
 
 const (
-	MetricType_TCP MetricType = 0
-	MetricType_NIC MetricType = 1
-	MetricType_NET MetricType = 2
+	MetricType_TCP       MetricType = 0
+	MetricType_NIC       MetricType = 1
+	MetricType_NET       MetricType = 2
+	MetricType_HOST      MetricType = 3
+	MetricType_EVENT     MetricType = 4
+	MetricType_TCP_DELTA MetricType = 5
+	MetricType_ROLLUP    MetricType = 6
+	MetricType_SELF      MetricType = 7
 )
 
 // Enum value maps for MetricType.
@@ -34,11 +39,21 @@ var (
 		0: "TCP",
 		1: "NIC",
 		2: "NET",
+		3: "HOST",
+		4: "EVENT",
+		5: "TCP_DELTA",
+		6: "ROLLUP",
+		7: "SELF",
 	}
 	MetricType_value = map[string]int32{
-		"TCP": 0,
-		"NIC": 1,
-		"NET": 2,
+		"TCP":       0,
+		"NIC":       1,
+		"NET":       2,
+		"HOST":      3,
+		"EVENT":     4,
+		"TCP_DELTA": 5,
+		"ROLLUP":    6,
+		"SELF":      7,
 	}
 )
 
@@ -146,6 +161,61 @@ func (SocketState) EnumDescriptor() ([]byte, []int) {
 	return file_proto_tcpmon_proto_rawDescGZIP(), []int{1}
 }
 
+type ConnectionEventType int32
+
+const (
+	ConnectionEventType_OPENED        ConnectionEventType = 0
+	ConnectionEventType_CLOSED        ConnectionEventType = 1 // disappeared after the close handshake, e.g. from TIME-WAIT
+	ConnectionEventType_STATE_CHANGED ConnectionEventType = 2
+	ConnectionEventType_DISAPPEARED   ConnectionEventType = 3 // disappeared without the close handshake, e.g. reset or aborted
+	ConnectionEventType_STUCK         ConnectionEventType = 4 // stays in a closing state (CLOSE-WAIT, FIN-WAIT-2, ...) longer than the threshold
+)
+
+// Enum value maps for ConnectionEventType.
+var (
+	ConnectionEventType_name = map[int32]string{
+		0: "OPENED",
+		1: "CLOSED",
+		2: "STATE_CHANGED",
+		3: "DISAPPEARED",
+		4: "STUCK",
+	}
+	ConnectionEventType_value = map[string]int32{
+		"OPENED":        0,
+		"CLOSED":        1,
+		"STATE_CHANGED": 2,
+		"DISAPPEARED":   3,
+		"STUCK":         4,
+	}
+)
+
+func (x ConnectionEventType) Enum() *ConnectionEventType {
+	p := new(ConnectionEventType)
+	*p = x
+	return p
+}
+
+func (x ConnectionEventType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ConnectionEventType) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_tcpmon_proto_enumTypes[2].Descriptor()
+}
+
+func (ConnectionEventType) Type() protoreflect.EnumType {
+	return &file_proto_tcpmon_proto_enumTypes[2]
+}
+
+func (x ConnectionEventType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ConnectionEventType.Descriptor instead.
+func (ConnectionEventType) EnumDescriptor() ([]byte, []int) {
+	return file_proto_tcpmon_proto_rawDescGZIP(), []int{2}
+}
+
 type Metric struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -156,6 +226,11 @@ type Metric struct {
 	//	*Metric_Tcp
 	//	*Metric_Nic
 	//	*Metric_Net
+	//	*Metric_Host
+	//	*Metric_Event
+	//	*Metric_TcpDelta
+	//	*Metric_Rollup
+	//	*Metric_Self
 	Body isMetric_Body `protobuf_oneof:"body"`
 }
 
@@ -219,6 +294,41 @@ func (x *Metric) GetNet() *NetstatMetric {
 	return nil
 }
 
+func (x *Metric) GetHost() *HostInfo {
+	if x, ok := x.GetBody().(*Metric_Host); ok {
+		return x.Host
+	}
+	return nil
+}
+
+func (x *Metric) GetEvent() *EventMetric {
+	if x, ok := x.GetBody().(*Metric_Event); ok {
+		return x.Event
+	}
+	return nil
+}
+
+func (x *Metric) GetTcpDelta() *TcpDelta {
+	if x, ok := x.GetBody().(*Metric_TcpDelta); ok {
+		return x.TcpDelta
+	}
+	return nil
+}
+
+func (x *Metric) GetRollup() *RollupMetric {
+	if x, ok := x.GetBody().(*Metric_Rollup); ok {
+		return x.Rollup
+	}
+	return nil
+}
+
+func (x *Metric) GetSelf() *SelfMetric {
+	if x, ok := x.GetBody().(*Metric_Self); ok {
+		return x.Self
+	}
+	return nil
+}
+
 type isMetric_Body interface {
 	isMetric_Body()
 }
@@ -235,12 +345,42 @@ type Metric_Net struct {
 	Net *NetstatMetric `protobuf:"bytes,3,opt,name=net,proto3,oneof"`
 }
 
+type Metric_Host struct {
+	Host *HostInfo `protobuf:"bytes,4,opt,name=host,proto3,oneof"`
+}
+
+type Metric_Event struct {
+	Event *EventMetric `protobuf:"bytes,5,opt,name=event,proto3,oneof"`
+}
+
+type Metric_TcpDelta struct {
+	TcpDelta *TcpDelta `protobuf:"bytes,6,opt,name=tcp_delta,json=tcpDelta,proto3,oneof"`
+}
+
+type Metric_Rollup struct {
+	Rollup *RollupMetric `protobuf:"bytes,7,opt,name=rollup,proto3,oneof"`
+}
+
+type Metric_Self struct {
+	Self *SelfMetric `protobuf:"bytes,8,opt,name=self,proto3,oneof"`
+}
+
 func (*Metric_Tcp) isMetric_Body() {}
 
 func (*Metric_Nic) isMetric_Body() {}
 
 func (*Metric_Net) isMetric_Body() {}
 
+func (*Metric_Host) isMetric_Body() {}
+
+func (*Metric_Event) isMetric_Body() {}
+
+func (*Metric_TcpDelta) isMetric_Body() {}
+
+func (*Metric_Rollup) isMetric_Body() {}
+
+func (*Metric_Self) isMetric_Body() {}
+
 // Socket memory usage. aka skmem
 // check: https://man7.org/linux/man-pages/man8/ss.8.html
 type SocketMemoryUsage struct {
@@ -534,6 +674,10 @@ type SocketMetric struct {
 	SndbufLimited uint32             `protobuf:"varint,52,opt,name=sndbuf_limited,json=sndbufLimited,proto3" json:"sndbuf_limited,omitempty"`
 	Ecn           bool               `protobuf:"varint,53,opt,name=ecn,proto3" json:"ecn,omitempty"`
 	Ecnseen       bool               `protobuf:"varint,54,opt,name=ecnseen,proto3" json:"ecnseen,omitempty"`
+	// socket identity, requires 'ss -e'
+	Cookie uint64 `protobuf:"varint,55,opt,name=cookie,proto3" json:"cookie,omitempty"` // sk: kernel socket cookie, unique during the boot
+	Inode  uint64 `protobuf:"varint,56,opt,name=inode,proto3" json:"inode,omitempty"`   // ino:
+	Uid    uint32 `protobuf:"varint,57,opt,name=uid,proto3" json:"uid,omitempty"`       // uid:
 }
 
 func (x *SocketMetric) Reset() {
@@ -911,6 +1055,27 @@ func (x *SocketMetric) GetEcnseen() bool {
 	return false
 }
 
+func (x *SocketMetric) GetCookie() uint64 {
+	if x != nil {
+		return x.Cookie
+	}
+	return 0
+}
+
+func (x *SocketMetric) GetInode() uint64 {
+	if x != nil {
+		return x.Inode
+	}
+	return 0
+}
+
+func (x *SocketMetric) GetUid() uint32 {
+	if x != nil {
+		return x.Uid
+	}
+	return 0
+}
+
 type TcpMetric struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -976,6 +1141,170 @@ func (x *TcpMetric) GetSockets() []*SocketMetric {
 	return nil
 }
 
+// SocketDelta is a socket in a TcpDelta. Sockets unchanged and in the same order as the previous snapshot are
+// encoded as empty messages.
+type SocketDelta struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	New        bool          `protobuf:"varint,1,opt,name=new,proto3" json:"new,omitempty"`                // a new socket, changed holds the whole socket
+	Skip       int32         `protobuf:"zigzag32,2,opt,name=skip,proto3" json:"skip,omitempty"`            // index in the previous snapshot - (index of the last matched socket + 1)
+	Changed    *SocketMetric `protobuf:"bytes,3,opt,name=changed,proto3" json:"changed,omitempty"`         // changed fields
+	Increments *SocketMetric `protobuf:"bytes,4,opt,name=increments,proto3" json:"increments,omitempty"`   // increments of unsigned integer fields
+	Cleared    []uint32      `protobuf:"varint,5,rep,packed,name=cleared,proto3" json:"cleared,omitempty"` // numbers of the fields reset to the default value
+}
+
+func (x *SocketDelta) Reset() {
+	*x = SocketDelta{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_tcpmon_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SocketDelta) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SocketDelta) ProtoMessage() {}
+
+func (x *SocketDelta) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_tcpmon_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SocketDelta.ProtoReflect.Descriptor instead.
+func (*SocketDelta) Descriptor() ([]byte, []int) {
+	return file_proto_tcpmon_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *SocketDelta) GetNew() bool {
+	if x != nil {
+		return x.New
+	}
+	return false
+}
+
+func (x *SocketDelta) GetSkip() int32 {
+	if x != nil {
+		return x.Skip
+	}
+	return 0
+}
+
+func (x *SocketDelta) GetChanged() *SocketMetric {
+	if x != nil {
+		return x.Changed
+	}
+	return nil
+}
+
+func (x *SocketDelta) GetIncrements() *SocketMetric {
+	if x != nil {
+		return x.Increments
+	}
+	return nil
+}
+
+func (x *SocketDelta) GetCleared() []uint32 {
+	if x != nil {
+		return x.Cleared
+	}
+	return nil
+}
+
+// TcpDelta is a TcpMetric stored as the difference against the previous snapshot in the same data file
+type TcpDelta struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// header
+	Timestamp int64      `protobuf:"varint,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Type      MetricType `protobuf:"varint,2,opt,name=type,proto3,enum=MetricType" json:"type,omitempty"`
+	// fields
+	Sockets []*SocketDelta `protobuf:"bytes,3,rep,name=sockets,proto3" json:"sockets,omitempty"` // in the order of the snapshot, removed sockets are not referenced
+	// the snapshot the delta is computed from, both are 0 in files written before they were added
+	BaseTimestamp int64  `protobuf:"varint,4,opt,name=base_timestamp,json=baseTimestamp,proto3" json:"base_timestamp,omitempty"`
+	Seq           uint32 `protobuf:"varint,5,opt,name=seq,proto3" json:"seq,omitempty"` // deltas since the keyframe, from 1
+}
+
+func (x *TcpDelta) Reset() {
+	*x = TcpDelta{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_tcpmon_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TcpDelta) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TcpDelta) ProtoMessage() {}
+
+func (x *TcpDelta) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_tcpmon_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TcpDelta.ProtoReflect.Descriptor instead.
+func (*TcpDelta) Descriptor() ([]byte, []int) {
+	return file_proto_tcpmon_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *TcpDelta) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *TcpDelta) GetType() MetricType {
+	if x != nil {
+		return x.Type
+	}
+	return MetricType_TCP
+}
+
+func (x *TcpDelta) GetSockets() []*SocketDelta {
+	if x != nil {
+		return x.Sockets
+	}
+	return nil
+}
+
+func (x *TcpDelta) GetBaseTimestamp() int64 {
+	if x != nil {
+		return x.BaseTimestamp
+	}
+	return 0
+}
+
+func (x *TcpDelta) GetSeq() uint32 {
+	if x != nil {
+		return x.Seq
+	}
+	return 0
+}
+
 type IfaceMetric struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -996,7 +1325,7 @@ type IfaceMetric struct {
 func (x *IfaceMetric) Reset() {
 	*x = IfaceMetric{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_tcpmon_proto_msgTypes[6]
+		mi := &file_proto_tcpmon_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1009,7 +1338,7 @@ func (x *IfaceMetric) String() string {
 func (*IfaceMetric) ProtoMessage() {}
 
 func (x *IfaceMetric) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_tcpmon_proto_msgTypes[6]
+	mi := &file_proto_tcpmon_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1022,7 +1351,7 @@ func (x *IfaceMetric) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use IfaceMetric.ProtoReflect.Descriptor instead.
 func (*IfaceMetric) Descriptor() ([]byte, []int) {
-	return file_proto_tcpmon_proto_rawDescGZIP(), []int{6}
+	return file_proto_tcpmon_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *IfaceMetric) GetName() string {
@@ -1110,7 +1439,7 @@ type NicMetric struct {
 func (x *NicMetric) Reset() {
 	*x = NicMetric{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_tcpmon_proto_msgTypes[7]
+		mi := &file_proto_tcpmon_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1123,7 +1452,7 @@ func (x *NicMetric) String() string {
 func (*NicMetric) ProtoMessage() {}
 
 func (x *NicMetric) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_tcpmon_proto_msgTypes[7]
+	mi := &file_proto_tcpmon_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1136,7 +1465,7 @@ func (x *NicMetric) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NicMetric.ProtoReflect.Descriptor instead.
 func (*NicMetric) Descriptor() ([]byte, []int) {
-	return file_proto_tcpmon_proto_rawDescGZIP(), []int{7}
+	return file_proto_tcpmon_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *NicMetric) GetTimestamp() int64 {
@@ -1188,6 +1517,7 @@ type NetstatMetric struct {
 	IpFragOks         uint64 `protobuf:"varint,116,opt,name=ip_frag_oks,json=ipFragOks,proto3" json:"ip_frag_oks,omitempty"`
 	IpFragFails       uint64 `protobuf:"varint,117,opt,name=ip_frag_fails,json=ipFragFails,proto3" json:"ip_frag_fails,omitempty"`
 	IpFragCreates     uint64 `protobuf:"varint,118,opt,name=ip_frag_creates,json=ipFragCreates,proto3" json:"ip_frag_creates,omitempty"`
+	IpOutTransmits    uint64 `protobuf:"varint,119,opt,name=ip_out_transmits,json=ipOutTransmits,proto3" json:"ip_out_transmits,omitempty"`
 	// ip ext /proc/net/netstat
 	IpInNoRoutes      uint64 `protobuf:"varint,600,opt,name=ip_in_no_routes,json=ipInNoRoutes,proto3" json:"ip_in_no_routes,omitempty"`
 	IpInTruncatedPkts uint64 `protobuf:"varint,601,opt,name=ip_in_truncated_pkts,json=ipInTruncatedPkts,proto3" json:"ip_in_truncated_pkts,omitempty"`
@@ -1395,7 +1725,7 @@ type NetstatMetric struct {
 func (x *NetstatMetric) Reset() {
 	*x = NetstatMetric{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_tcpmon_proto_msgTypes[8]
+		mi := &file_proto_tcpmon_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1408,7 +1738,7 @@ func (x *NetstatMetric) String() string {
 func (*NetstatMetric) ProtoMessage() {}
 
 func (x *NetstatMetric) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_tcpmon_proto_msgTypes[8]
+	mi := &file_proto_tcpmon_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1421,7 +1751,7 @@ func (x *NetstatMetric) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NetstatMetric.ProtoReflect.Descriptor instead.
 func (*NetstatMetric) Descriptor() ([]byte, []int) {
-	return file_proto_tcpmon_proto_rawDescGZIP(), []int{8}
+	return file_proto_tcpmon_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *NetstatMetric) GetTimestamp() int64 {
@@ -1571,6 +1901,13 @@ func (x *NetstatMetric) GetIpFragCreates() uint64 {
 	return 0
 }
 
+func (x *NetstatMetric) GetIpOutTransmits() uint64 {
+	if x != nil {
+		return x.IpOutTransmits
+	}
+	return 0
+}
+
 func (x *NetstatMetric) GetIpInNoRoutes() uint64 {
 	if x != nil {
 		return x.IpInNoRoutes
@@ -2950,853 +3287,2196 @@ func (x *NetstatMetric) GetIcmpOutAddrMaskReps() uint64 {
 	return 0
 }
 
-var File_proto_tcpmon_proto protoreflect.FileDescriptor
+// HostInfo identifies the host which the data file belongs to. It's written at the beginning of each data file and
+// every time it changes.
+type HostInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-var file_proto_tcpmon_proto_rawDesc = []byte{
-	0x0a, 0x12, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x74, 0x63, 0x70, 0x6d, 0x6f, 0x6e, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x22, 0x74, 0x0a, 0x06, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x12, 0x1e,
-	0x0a, 0x03, 0x74, 0x63, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x54, 0x63,
-	0x70, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x48, 0x00, 0x52, 0x03, 0x74, 0x63, 0x70, 0x12, 0x1e,
-	0x0a, 0x03, 0x6e, 0x69, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x4e, 0x69,
-	0x63, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x48, 0x00, 0x52, 0x03, 0x6e, 0x69, 0x63, 0x12, 0x22,
-	0x0a, 0x03, 0x6e, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x4e, 0x65,
-	0x74, 0x73, 0x74, 0x61, 0x74, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x48, 0x00, 0x52, 0x03, 0x6e,
-	0x65, 0x74, 0x42, 0x06, 0x0a, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x22, 0x92, 0x02, 0x0a, 0x11, 0x53,
-	0x6f, 0x63, 0x6b, 0x65, 0x74, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x55, 0x73, 0x61, 0x67, 0x65,
-	0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x6d, 0x65, 0x6d, 0x5f, 0x61, 0x6c, 0x6c, 0x6f, 0x63, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x72, 0x6d, 0x65, 0x6d, 0x41, 0x6c, 0x6c, 0x6f, 0x63, 0x12,
-	0x17, 0x0a, 0x07, 0x72, 0x63, 0x76, 0x5f, 0x62, 0x75, 0x66, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d,
-	0x52, 0x06, 0x72, 0x63, 0x76, 0x42, 0x75, 0x66, 0x12, 0x1d, 0x0a, 0x0a, 0x77, 0x6d, 0x65, 0x6d,
-	0x5f, 0x61, 0x6c, 0x6c, 0x6f, 0x63, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x77, 0x6d,
-	0x65, 0x6d, 0x41, 0x6c, 0x6c, 0x6f, 0x63, 0x12, 0x17, 0x0a, 0x07, 0x73, 0x6e, 0x64, 0x5f, 0x62,
-	0x75, 0x66, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x73, 0x6e, 0x64, 0x42, 0x75, 0x66,
-	0x12, 0x1b, 0x0a, 0x09, 0x66, 0x77, 0x64, 0x5f, 0x61, 0x6c, 0x6c, 0x6f, 0x63, 0x18, 0x05, 0x20,
-	0x01, 0x28, 0x0d, 0x52, 0x08, 0x66, 0x77, 0x64, 0x41, 0x6c, 0x6c, 0x6f, 0x63, 0x12, 0x1f, 0x0a,
-	0x0b, 0x77, 0x6d, 0x65, 0x6d, 0x5f, 0x71, 0x75, 0x65, 0x75, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01,
-	0x28, 0x0d, 0x52, 0x0a, 0x77, 0x6d, 0x65, 0x6d, 0x51, 0x75, 0x65, 0x75, 0x65, 0x64, 0x12, 0x17,
-	0x0a, 0x07, 0x6f, 0x70, 0x74, 0x5f, 0x6d, 0x65, 0x6d, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52,
-	0x06, 0x6f, 0x70, 0x74, 0x4d, 0x65, 0x6d, 0x12, 0x19, 0x0a, 0x08, 0x62, 0x61, 0x63, 0x6b, 0x5f,
-	0x6c, 0x6f, 0x67, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x62, 0x61, 0x63, 0x6b, 0x4c,
-	0x6f, 0x67, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x6f, 0x63, 0x6b, 0x5f, 0x64, 0x72, 0x6f, 0x70, 0x18,
-	0x09, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x73, 0x6f, 0x63, 0x6b, 0x44, 0x72, 0x6f, 0x70, 0x22,
-	0x5f, 0x0a, 0x09, 0x54, 0x69, 0x6d, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x12, 0x0a, 0x04,
-	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
-	0x12, 0x24, 0x0a, 0x0e, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f,
-	0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65,
-	0x54, 0x69, 0x6d, 0x65, 0x55, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x74, 0x72, 0x61, 0x6e,
-	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x72, 0x65, 0x74, 0x72, 0x61, 0x6e, 0x73,
-	0x22, 0x43, 0x0a, 0x0b, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x12,
-	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
-	0x61, 0x6d, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x70, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d,
-	0x52, 0x03, 0x70, 0x69, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x66, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x0d, 0x52, 0x02, 0x66, 0x64, 0x22, 0xed, 0x0a, 0x0a, 0x0c, 0x53, 0x6f, 0x63, 0x6b, 0x65, 0x74,
-	0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x12, 0x22, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0c, 0x2e, 0x53, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x53, 0x74,
-	0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x15, 0x0a, 0x06, 0x72, 0x65,
-	0x63, 0x76, 0x5f, 0x71, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x72, 0x65, 0x63, 0x76,
-	0x51, 0x12, 0x15, 0x0a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x5f, 0x71, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x05, 0x73, 0x65, 0x6e, 0x64, 0x51, 0x12, 0x1d, 0x0a, 0x0a, 0x6c, 0x6f, 0x63, 0x61,
-	0x6c, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6c, 0x6f,
-	0x63, 0x61, 0x6c, 0x41, 0x64, 0x64, 0x72, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x65, 0x65, 0x72, 0x5f,
-	0x61, 0x64, 0x64, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x65, 0x65, 0x72,
-	0x41, 0x64, 0x64, 0x72, 0x12, 0x2a, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x65,
-	0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73,
-	0x73, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x65, 0x73,
-	0x12, 0x22, 0x0a, 0x06, 0x74, 0x69, 0x6d, 0x65, 0x72, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x0a, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x06, 0x74, 0x69,
-	0x6d, 0x65, 0x72, 0x73, 0x12, 0x28, 0x0a, 0x05, 0x73, 0x6b, 0x6d, 0x65, 0x6d, 0x18, 0x0a, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x53, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x4d, 0x65, 0x6d, 0x6f,
-	0x72, 0x79, 0x55, 0x73, 0x61, 0x67, 0x65, 0x52, 0x05, 0x73, 0x6b, 0x6d, 0x65, 0x6d, 0x12, 0x0e,
-	0x0a, 0x02, 0x74, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x02, 0x74, 0x73, 0x12, 0x12,
-	0x0a, 0x04, 0x73, 0x61, 0x63, 0x6b, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x73, 0x61,
-	0x63, 0x6b, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x75, 0x62, 0x69, 0x63, 0x18, 0x0d, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x05, 0x63, 0x75, 0x62, 0x69, 0x63, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x70, 0x70, 0x5f,
-	0x6c, 0x69, 0x6d, 0x69, 0x74, 0x65, 0x64, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x61,
-	0x70, 0x70, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x65, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x61, 0x63,
-	0x69, 0x6e, 0x67, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a,
-	0x70, 0x61, 0x63, 0x69, 0x6e, 0x67, 0x52, 0x61, 0x74, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x64, 0x65,
-	0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x10, 0x20, 0x01, 0x28,
-	0x01, 0x52, 0x0c, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x52, 0x61, 0x74, 0x65, 0x12,
-	0x12, 0x0a, 0x04, 0x73, 0x65, 0x6e, 0x64, 0x18, 0x11, 0x20, 0x01, 0x28, 0x01, 0x52, 0x04, 0x73,
-	0x65, 0x6e, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x6e, 0x64, 0x5f, 0x77, 0x73, 0x63, 0x61, 0x6c,
-	0x65, 0x18, 0x12, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x73, 0x6e, 0x64, 0x57, 0x73, 0x63, 0x61,
-	0x6c, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x63, 0x76, 0x5f, 0x77, 0x73, 0x63, 0x61, 0x6c, 0x65,
-	0x18, 0x13, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x72, 0x63, 0x76, 0x57, 0x73, 0x63, 0x61, 0x6c,
-	0x65, 0x12, 0x10, 0x0a, 0x03, 0x72, 0x74, 0x6f, 0x18, 0x14, 0x20, 0x01, 0x28, 0x01, 0x52, 0x03,
-	0x72, 0x74, 0x6f, 0x12, 0x10, 0x0a, 0x03, 0x72, 0x74, 0x74, 0x18, 0x15, 0x20, 0x01, 0x28, 0x01,
-	0x52, 0x03, 0x72, 0x74, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x74, 0x74, 0x76, 0x61, 0x72, 0x18,
-	0x16, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x72, 0x74, 0x74, 0x76, 0x61, 0x72, 0x12, 0x16, 0x0a,
-	0x06, 0x6d, 0x69, 0x6e, 0x72, 0x74, 0x74, 0x18, 0x17, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x6d,
-	0x69, 0x6e, 0x72, 0x74, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x72, 0x63, 0x76, 0x5f, 0x72, 0x74, 0x74,
-	0x18, 0x18, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x72, 0x63, 0x76, 0x52, 0x74, 0x74, 0x12, 0x1f,
-	0x0a, 0x0b, 0x72, 0x65, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x5f, 0x6e, 0x6f, 0x77, 0x18, 0x19, 0x20,
-	0x01, 0x28, 0x0d, 0x52, 0x0a, 0x72, 0x65, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x4e, 0x6f, 0x77, 0x12,
-	0x23, 0x0a, 0x0d, 0x72, 0x65, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c,
-	0x18, 0x1a, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x72, 0x65, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x54,
-	0x6f, 0x74, 0x61, 0x6c, 0x12, 0x10, 0x0a, 0x03, 0x61, 0x74, 0x6f, 0x18, 0x1e, 0x20, 0x01, 0x28,
-	0x01, 0x52, 0x03, 0x61, 0x74, 0x6f, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x73, 0x73, 0x18, 0x1f, 0x20,
-	0x01, 0x28, 0x0d, 0x52, 0x03, 0x6d, 0x73, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x6d, 0x74, 0x75,
-	0x18, 0x20, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x70, 0x6d, 0x74, 0x75, 0x12, 0x16, 0x0a, 0x06,
-	0x72, 0x63, 0x76, 0x6d, 0x73, 0x73, 0x18, 0x21, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x72, 0x63,
-	0x76, 0x6d, 0x73, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x64, 0x76, 0x6d, 0x73, 0x73, 0x18, 0x22,
-	0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x61, 0x64, 0x76, 0x6d, 0x73, 0x73, 0x12, 0x12, 0x0a, 0x04,
-	0x63, 0x77, 0x6e, 0x64, 0x18, 0x23, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x63, 0x77, 0x6e, 0x64,
-	0x12, 0x17, 0x0a, 0x07, 0x73, 0x6e, 0x64, 0x5f, 0x77, 0x6e, 0x64, 0x18, 0x24, 0x20, 0x01, 0x28,
-	0x0d, 0x52, 0x06, 0x73, 0x6e, 0x64, 0x57, 0x6e, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x79, 0x74,
-	0x65, 0x73, 0x5f, 0x73, 0x65, 0x6e, 0x74, 0x18, 0x25, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x62,
-	0x79, 0x74, 0x65, 0x73, 0x53, 0x65, 0x6e, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x62, 0x79, 0x74, 0x65,
-	0x73, 0x5f, 0x61, 0x63, 0x6b, 0x65, 0x64, 0x18, 0x26, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x62,
-	0x79, 0x74, 0x65, 0x73, 0x41, 0x63, 0x6b, 0x65, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x62, 0x79, 0x74,
-	0x65, 0x73, 0x5f, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x18, 0x27, 0x20, 0x01, 0x28,
-	0x04, 0x52, 0x0d, 0x62, 0x79, 0x74, 0x65, 0x73, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64,
-	0x12, 0x19, 0x0a, 0x08, 0x73, 0x65, 0x67, 0x73, 0x5f, 0x6f, 0x75, 0x74, 0x18, 0x28, 0x20, 0x01,
-	0x28, 0x0d, 0x52, 0x07, 0x73, 0x65, 0x67, 0x73, 0x4f, 0x75, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x73,
-	0x65, 0x67, 0x73, 0x5f, 0x69, 0x6e, 0x18, 0x29, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x73, 0x65,
-	0x67, 0x73, 0x49, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x6c, 0x61, 0x73, 0x74, 0x73, 0x6e, 0x64, 0x18,
-	0x2a, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x6c, 0x61, 0x73, 0x74, 0x73, 0x6e, 0x64, 0x12, 0x18,
-	0x0a, 0x07, 0x6c, 0x61, 0x73, 0x74, 0x72, 0x63, 0x76, 0x18, 0x2b, 0x20, 0x01, 0x28, 0x0d, 0x52,
-	0x07, 0x6c, 0x61, 0x73, 0x74, 0x72, 0x63, 0x76, 0x12, 0x18, 0x0a, 0x07, 0x6c, 0x61, 0x73, 0x74,
-	0x61, 0x63, 0x6b, 0x18, 0x2c, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x6c, 0x61, 0x73, 0x74, 0x61,
-	0x63, 0x6b, 0x12, 0x1c, 0x0a, 0x09, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x65, 0x64, 0x18,
-	0x2d, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x65, 0x64,
-	0x12, 0x17, 0x0a, 0x07, 0x62, 0x75, 0x73, 0x79, 0x5f, 0x6d, 0x73, 0x18, 0x2e, 0x20, 0x01, 0x28,
-	0x0d, 0x52, 0x06, 0x62, 0x75, 0x73, 0x79, 0x4d, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x63, 0x76,
-	0x5f, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x2f, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x72, 0x63,
-	0x76, 0x53, 0x70, 0x61, 0x63, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x63, 0x76, 0x5f, 0x73, 0x73,
-	0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x18, 0x30, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x72, 0x63,
-	0x76, 0x53, 0x73, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x12, 0x22, 0x0a, 0x0d, 0x64, 0x61, 0x74,
-	0x61, 0x5f, 0x73, 0x65, 0x67, 0x73, 0x5f, 0x6f, 0x75, 0x74, 0x18, 0x31, 0x20, 0x01, 0x28, 0x0d,
-	0x52, 0x0b, 0x64, 0x61, 0x74, 0x61, 0x53, 0x65, 0x67, 0x73, 0x4f, 0x75, 0x74, 0x12, 0x20, 0x0a,
-	0x0c, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x73, 0x65, 0x67, 0x73, 0x5f, 0x69, 0x6e, 0x18, 0x32, 0x20,
-	0x01, 0x28, 0x0d, 0x52, 0x0a, 0x64, 0x61, 0x74, 0x61, 0x53, 0x65, 0x67, 0x73, 0x49, 0x6e, 0x12,
-	0x21, 0x0a, 0x0c, 0x72, 0x77, 0x6e, 0x64, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x65, 0x64, 0x18,
-	0x33, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x72, 0x77, 0x6e, 0x64, 0x4c, 0x69, 0x6d, 0x69, 0x74,
-	0x65, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x6e, 0x64, 0x62, 0x75, 0x66, 0x5f, 0x6c, 0x69, 0x6d,
-	0x69, 0x74, 0x65, 0x64, 0x18, 0x34, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x73, 0x6e, 0x64, 0x62,
-	0x75, 0x66, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x65, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x65, 0x63, 0x6e,
-	0x18, 0x35, 0x20, 0x01, 0x28, 0x08, 0x52, 0x03, 0x65, 0x63, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x65,
-	0x63, 0x6e, 0x73, 0x65, 0x65, 0x6e, 0x18, 0x36, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x63,
-	0x6e, 0x73, 0x65, 0x65, 0x6e, 0x22, 0x73, 0x0a, 0x09, 0x54, 0x63, 0x70, 0x4d, 0x65, 0x74, 0x72,
-	0x69, 0x63, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
-	0x12, 0x1f, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0b,
-	0x2e, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70,
-	0x65, 0x12, 0x27, 0x0a, 0x07, 0x73, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x53, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x4d, 0x65, 0x74, 0x72, 0x69,
-	0x63, 0x52, 0x07, 0x73, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x22, 0xba, 0x02, 0x0a, 0x0b, 0x49,
-	0x66, 0x61, 0x63, 0x65, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
-	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1b,
-	0x0a, 0x09, 0x72, 0x78, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x04, 0x52, 0x08, 0x72, 0x78, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x72,
-	0x78, 0x5f, 0x64, 0x72, 0x6f, 0x70, 0x70, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x09, 0x72, 0x78, 0x44, 0x72, 0x6f, 0x70, 0x70, 0x65, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x78,
-	0x5f, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x75, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x0a, 0x72, 0x78, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x75, 0x6e, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x72,
-	0x78, 0x5f, 0x66, 0x72, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x72,
-	0x78, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x78, 0x5f, 0x65, 0x72, 0x72,
-	0x6f, 0x72, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x74, 0x78, 0x45, 0x72, 0x72,
-	0x6f, 0x72, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x78, 0x5f, 0x64, 0x72, 0x6f, 0x70, 0x70, 0x65,
-	0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x74, 0x78, 0x44, 0x72, 0x6f, 0x70, 0x70,
-	0x65, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x78, 0x5f, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x75, 0x6e,
-	0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x74, 0x78, 0x4f, 0x76, 0x65, 0x72, 0x72,
-	0x75, 0x6e, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x78, 0x5f, 0x63, 0x61, 0x72, 0x72, 0x69, 0x65,
-	0x72, 0x18, 0x09, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x74, 0x78, 0x43, 0x61, 0x72, 0x72, 0x69,
-	0x65, 0x72, 0x12, 0x23, 0x0a, 0x0d, 0x74, 0x78, 0x5f, 0x63, 0x6f, 0x6c, 0x6c, 0x69, 0x73, 0x69,
-	0x6f, 0x6e, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x74, 0x78, 0x43, 0x6f, 0x6c,
-	0x6c, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x70, 0x0a, 0x09, 0x4e, 0x69, 0x63, 0x4d, 0x65,
-	0x74, 0x72, 0x69, 0x63, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
-	0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
-	0x6d, 0x70, 0x12, 0x1f, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e,
-	0x32, 0x0b, 0x2e, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74,
-	0x79, 0x70, 0x65, 0x12, 0x24, 0x0a, 0x06, 0x69, 0x66, 0x61, 0x63, 0x65, 0x73, 0x18, 0x03, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x49, 0x66, 0x61, 0x63, 0x65, 0x4d, 0x65, 0x74, 0x72, 0x69,
-	0x63, 0x52, 0x06, 0x69, 0x66, 0x61, 0x63, 0x65, 0x73, 0x22, 0xc8, 0x4d, 0x0a, 0x0d, 0x4e, 0x65,
-	0x74, 0x73, 0x74, 0x61, 0x74, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x12, 0x1c, 0x0a, 0x09, 0x74,
-	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09,
-	0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x1f, 0x0a, 0x04, 0x74, 0x79, 0x70,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0b, 0x2e, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63,
-	0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x69, 0x70,
-	0x5f, 0x66, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x64, 0x20, 0x01, 0x28,
-	0x04, 0x52, 0x0c, 0x69, 0x70, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x12,
-	0x24, 0x0a, 0x0e, 0x69, 0x70, 0x5f, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x74, 0x74,
-	0x6c, 0x18, 0x65, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x69, 0x70, 0x44, 0x65, 0x66, 0x61, 0x75,
-	0x6c, 0x74, 0x54, 0x74, 0x6c, 0x12, 0x24, 0x0a, 0x0e, 0x69, 0x70, 0x5f, 0x69, 0x6e, 0x5f, 0x72,
-	0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x73, 0x18, 0x66, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x69,
-	0x70, 0x49, 0x6e, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x73, 0x12, 0x27, 0x0a, 0x10, 0x69,
-	0x70, 0x5f, 0x69, 0x6e, 0x5f, 0x68, 0x64, 0x72, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18,
-	0x67, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x69, 0x70, 0x49, 0x6e, 0x48, 0x64, 0x72, 0x45, 0x72,
-	0x72, 0x6f, 0x72, 0x73, 0x12, 0x29, 0x0a, 0x11, 0x69, 0x70, 0x5f, 0x69, 0x6e, 0x5f, 0x61, 0x64,
-	0x64, 0x72, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x68, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x0e, 0x69, 0x70, 0x49, 0x6e, 0x41, 0x64, 0x64, 0x72, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x12,
-	0x2a, 0x0a, 0x11, 0x69, 0x70, 0x5f, 0x66, 0x6f, 0x72, 0x77, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x67,
-	0x72, 0x61, 0x6d, 0x73, 0x18, 0x69, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x69, 0x70, 0x46, 0x6f,
-	0x72, 0x77, 0x44, 0x61, 0x74, 0x61, 0x67, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x2f, 0x0a, 0x14, 0x69,
-	0x70, 0x5f, 0x69, 0x6e, 0x5f, 0x75, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x5f, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x73, 0x18, 0x6a, 0x20, 0x01, 0x28, 0x04, 0x52, 0x11, 0x69, 0x70, 0x49, 0x6e, 0x55,
-	0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x12, 0x24, 0x0a, 0x0e,
-	0x69, 0x70, 0x5f, 0x69, 0x6e, 0x5f, 0x64, 0x69, 0x73, 0x63, 0x61, 0x72, 0x64, 0x73, 0x18, 0x6b,
-	0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x69, 0x70, 0x49, 0x6e, 0x44, 0x69, 0x73, 0x63, 0x61, 0x72,
-	0x64, 0x73, 0x12, 0x24, 0x0a, 0x0e, 0x69, 0x70, 0x5f, 0x69, 0x6e, 0x5f, 0x64, 0x65, 0x6c, 0x69,
-	0x76, 0x65, 0x72, 0x73, 0x18, 0x6c, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x69, 0x70, 0x49, 0x6e,
-	0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x69, 0x70, 0x5f, 0x6f,
-	0x75, 0x74, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x18, 0x6d, 0x20, 0x01, 0x28,
-	0x04, 0x52, 0x0d, 0x69, 0x70, 0x4f, 0x75, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73,
-	0x12, 0x26, 0x0a, 0x0f, 0x69, 0x70, 0x5f, 0x6f, 0x75, 0x74, 0x5f, 0x64, 0x69, 0x73, 0x63, 0x61,
-	0x72, 0x64, 0x73, 0x18, 0x6e, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x69, 0x70, 0x4f, 0x75, 0x74,
-	0x44, 0x69, 0x73, 0x63, 0x61, 0x72, 0x64, 0x73, 0x12, 0x27, 0x0a, 0x10, 0x69, 0x70, 0x5f, 0x6f,
-	0x75, 0x74, 0x5f, 0x6e, 0x6f, 0x5f, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x18, 0x6f, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x0d, 0x69, 0x70, 0x4f, 0x75, 0x74, 0x4e, 0x6f, 0x52, 0x6f, 0x75, 0x74, 0x65,
-	0x73, 0x12, 0x28, 0x0a, 0x10, 0x69, 0x70, 0x5f, 0x72, 0x65, 0x61, 0x73, 0x6d, 0x5f, 0x74, 0x69,
-	0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x70, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x69, 0x70, 0x52,
-	0x65, 0x61, 0x73, 0x6d, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x24, 0x0a, 0x0e, 0x69,
-	0x70, 0x5f, 0x72, 0x65, 0x61, 0x73, 0x6d, 0x5f, 0x72, 0x65, 0x71, 0x64, 0x73, 0x18, 0x71, 0x20,
-	0x01, 0x28, 0x04, 0x52, 0x0c, 0x69, 0x70, 0x52, 0x65, 0x61, 0x73, 0x6d, 0x52, 0x65, 0x71, 0x64,
-	0x73, 0x12, 0x20, 0x0a, 0x0c, 0x69, 0x70, 0x5f, 0x72, 0x65, 0x61, 0x73, 0x6d, 0x5f, 0x6f, 0x6b,
-	0x73, 0x18, 0x72, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x69, 0x70, 0x52, 0x65, 0x61, 0x73, 0x6d,
-	0x4f, 0x6b, 0x73, 0x12, 0x24, 0x0a, 0x0e, 0x69, 0x70, 0x5f, 0x72, 0x65, 0x61, 0x73, 0x6d, 0x5f,
-	0x66, 0x61, 0x69, 0x6c, 0x73, 0x18, 0x73, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x69, 0x70, 0x52,
-	0x65, 0x61, 0x73, 0x6d, 0x46, 0x61, 0x69, 0x6c, 0x73, 0x12, 0x1e, 0x0a, 0x0b, 0x69, 0x70, 0x5f,
-	0x66, 0x72, 0x61, 0x67, 0x5f, 0x6f, 0x6b, 0x73, 0x18, 0x74, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09,
-	0x69, 0x70, 0x46, 0x72, 0x61, 0x67, 0x4f, 0x6b, 0x73, 0x12, 0x22, 0x0a, 0x0d, 0x69, 0x70, 0x5f,
-	0x66, 0x72, 0x61, 0x67, 0x5f, 0x66, 0x61, 0x69, 0x6c, 0x73, 0x18, 0x75, 0x20, 0x01, 0x28, 0x04,
-	0x52, 0x0b, 0x69, 0x70, 0x46, 0x72, 0x61, 0x67, 0x46, 0x61, 0x69, 0x6c, 0x73, 0x12, 0x26, 0x0a,
-	0x0f, 0x69, 0x70, 0x5f, 0x66, 0x72, 0x61, 0x67, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x73,
-	0x18, 0x76, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x69, 0x70, 0x46, 0x72, 0x61, 0x67, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x69, 0x70, 0x5f, 0x69, 0x6e, 0x5f, 0x6e,
-	0x6f, 0x5f, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x18, 0xd8, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x0c, 0x69, 0x70, 0x49, 0x6e, 0x4e, 0x6f, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x12, 0x30, 0x0a,
-	0x14, 0x69, 0x70, 0x5f, 0x69, 0x6e, 0x5f, 0x74, 0x72, 0x75, 0x6e, 0x63, 0x61, 0x74, 0x65, 0x64,
-	0x5f, 0x70, 0x6b, 0x74, 0x73, 0x18, 0xd9, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x11, 0x69, 0x70,
-	0x49, 0x6e, 0x54, 0x72, 0x75, 0x6e, 0x63, 0x61, 0x74, 0x65, 0x64, 0x50, 0x6b, 0x74, 0x73, 0x12,
-	0x28, 0x0a, 0x10, 0x69, 0x70, 0x5f, 0x69, 0x6e, 0x5f, 0x6d, 0x63, 0x61, 0x73, 0x74, 0x5f, 0x70,
-	0x6b, 0x74, 0x73, 0x18, 0xda, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x69, 0x70, 0x49, 0x6e,
-	0x4d, 0x63, 0x61, 0x73, 0x74, 0x50, 0x6b, 0x74, 0x73, 0x12, 0x2a, 0x0a, 0x11, 0x69, 0x70, 0x5f,
-	0x6f, 0x75, 0x74, 0x5f, 0x6d, 0x63, 0x61, 0x73, 0x74, 0x5f, 0x70, 0x6b, 0x74, 0x73, 0x18, 0xdb,
-	0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x69, 0x70, 0x4f, 0x75, 0x74, 0x4d, 0x63, 0x61, 0x73,
-	0x74, 0x50, 0x6b, 0x74, 0x73, 0x12, 0x28, 0x0a, 0x10, 0x69, 0x70, 0x5f, 0x69, 0x6e, 0x5f, 0x62,
-	0x63, 0x61, 0x73, 0x74, 0x5f, 0x70, 0x6b, 0x74, 0x73, 0x18, 0xdc, 0x04, 0x20, 0x01, 0x28, 0x04,
-	0x52, 0x0d, 0x69, 0x70, 0x49, 0x6e, 0x42, 0x63, 0x61, 0x73, 0x74, 0x50, 0x6b, 0x74, 0x73, 0x12,
-	0x2a, 0x0a, 0x11, 0x69, 0x70, 0x5f, 0x6f, 0x75, 0x74, 0x5f, 0x62, 0x63, 0x61, 0x73, 0x74, 0x5f,
-	0x70, 0x6b, 0x74, 0x73, 0x18, 0xdd, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x69, 0x70, 0x4f,
-	0x75, 0x74, 0x42, 0x63, 0x61, 0x73, 0x74, 0x50, 0x6b, 0x74, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x69,
-	0x70, 0x5f, 0x69, 0x6e, 0x5f, 0x6f, 0x63, 0x74, 0x65, 0x74, 0x73, 0x18, 0xde, 0x04, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x0a, 0x69, 0x70, 0x49, 0x6e, 0x4f, 0x63, 0x74, 0x65, 0x74, 0x73, 0x12, 0x23,
-	0x0a, 0x0d, 0x69, 0x70, 0x5f, 0x6f, 0x75, 0x74, 0x5f, 0x6f, 0x63, 0x74, 0x65, 0x74, 0x73, 0x18,
-	0xdf, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x69, 0x70, 0x4f, 0x75, 0x74, 0x4f, 0x63, 0x74,
-	0x65, 0x74, 0x73, 0x12, 0x2c, 0x0a, 0x12, 0x69, 0x70, 0x5f, 0x69, 0x6e, 0x5f, 0x6d, 0x63, 0x61,
-	0x73, 0x74, 0x5f, 0x6f, 0x63, 0x74, 0x65, 0x74, 0x73, 0x18, 0xe0, 0x04, 0x20, 0x01, 0x28, 0x04,
-	0x52, 0x0f, 0x69, 0x70, 0x49, 0x6e, 0x4d, 0x63, 0x61, 0x73, 0x74, 0x4f, 0x63, 0x74, 0x65, 0x74,
-	0x73, 0x12, 0x2e, 0x0a, 0x13, 0x69, 0x70, 0x5f, 0x6f, 0x75, 0x74, 0x5f, 0x6d, 0x63, 0x61, 0x73,
-	0x74, 0x5f, 0x6f, 0x63, 0x74, 0x65, 0x74, 0x73, 0x18, 0xe1, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x10, 0x69, 0x70, 0x4f, 0x75, 0x74, 0x4d, 0x63, 0x61, 0x73, 0x74, 0x4f, 0x63, 0x74, 0x65, 0x74,
-	0x73, 0x12, 0x2c, 0x0a, 0x12, 0x69, 0x70, 0x5f, 0x69, 0x6e, 0x5f, 0x62, 0x63, 0x61, 0x73, 0x74,
-	0x5f, 0x6f, 0x63, 0x74, 0x65, 0x74, 0x73, 0x18, 0xe2, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f,
-	0x69, 0x70, 0x49, 0x6e, 0x42, 0x63, 0x61, 0x73, 0x74, 0x4f, 0x63, 0x74, 0x65, 0x74, 0x73, 0x12,
-	0x2e, 0x0a, 0x13, 0x69, 0x70, 0x5f, 0x6f, 0x75, 0x74, 0x5f, 0x62, 0x63, 0x61, 0x73, 0x74, 0x5f,
-	0x6f, 0x63, 0x74, 0x65, 0x74, 0x73, 0x18, 0xe3, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x69,
-	0x70, 0x4f, 0x75, 0x74, 0x42, 0x63, 0x61, 0x73, 0x74, 0x4f, 0x63, 0x74, 0x65, 0x74, 0x73, 0x12,
-	0x2a, 0x0a, 0x11, 0x69, 0x70, 0x5f, 0x69, 0x6e, 0x5f, 0x63, 0x73, 0x75, 0x6d, 0x5f, 0x65, 0x72,
-	0x72, 0x6f, 0x72, 0x73, 0x18, 0xe4, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x69, 0x70, 0x49,
-	0x6e, 0x43, 0x73, 0x75, 0x6d, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x12, 0x29, 0x0a, 0x11, 0x69,
-	0x70, 0x5f, 0x69, 0x6e, 0x5f, 0x6e, 0x6f, 0x5f, 0x65, 0x63, 0x74, 0x5f, 0x70, 0x6b, 0x74, 0x73,
-	0x18, 0xe5, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x69, 0x70, 0x49, 0x6e, 0x4e, 0x6f, 0x45,
-	0x63, 0x74, 0x50, 0x6b, 0x74, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x69, 0x70, 0x5f, 0x69, 0x6e, 0x5f,
-	0x65, 0x63, 0x74, 0x31, 0x5f, 0x70, 0x6b, 0x74, 0x73, 0x18, 0xe6, 0x04, 0x20, 0x01, 0x28, 0x04,
-	0x52, 0x0c, 0x69, 0x70, 0x49, 0x6e, 0x45, 0x63, 0x74, 0x31, 0x50, 0x6b, 0x74, 0x73, 0x12, 0x26,
-	0x0a, 0x0f, 0x69, 0x70, 0x5f, 0x69, 0x6e, 0x5f, 0x65, 0x63, 0x74, 0x30, 0x5f, 0x70, 0x6b, 0x74,
-	0x73, 0x18, 0xe7, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x69, 0x70, 0x49, 0x6e, 0x45, 0x63,
-	0x74, 0x30, 0x50, 0x6b, 0x74, 0x73, 0x12, 0x22, 0x0a, 0x0d, 0x69, 0x70, 0x5f, 0x69, 0x6e, 0x5f,
-	0x63, 0x65, 0x5f, 0x70, 0x6b, 0x74, 0x73, 0x18, 0xe8, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a,
-	0x69, 0x70, 0x49, 0x6e, 0x43, 0x65, 0x50, 0x6b, 0x74, 0x73, 0x12, 0x2b, 0x0a, 0x11, 0x69, 0x70,
-	0x5f, 0x72, 0x65, 0x61, 0x73, 0x6d, 0x5f, 0x6f, 0x76, 0x65, 0x72, 0x6c, 0x61, 0x70, 0x73, 0x18,
-	0xe9, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x69, 0x70, 0x52, 0x65, 0x61, 0x73, 0x6d, 0x4f,
-	0x76, 0x65, 0x72, 0x6c, 0x61, 0x70, 0x73, 0x12, 0x29, 0x0a, 0x10, 0x75, 0x64, 0x70, 0x5f, 0x69,
-	0x6e, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x67, 0x72, 0x61, 0x6d, 0x73, 0x18, 0xc8, 0x01, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x0e, 0x75, 0x64, 0x70, 0x49, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x67, 0x72, 0x61,
-	0x6d, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x75, 0x64, 0x70, 0x5f, 0x6e, 0x6f, 0x5f, 0x70, 0x6f, 0x72,
-	0x74, 0x73, 0x18, 0xc9, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x75, 0x64, 0x70, 0x4e, 0x6f,
-	0x50, 0x6f, 0x72, 0x74, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x75, 0x64, 0x70, 0x5f, 0x69, 0x6e, 0x5f,
-	0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0xca, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x75,
-	0x64, 0x70, 0x49, 0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x12, 0x2b, 0x0a, 0x11, 0x75, 0x64,
-	0x70, 0x5f, 0x6f, 0x75, 0x74, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x67, 0x72, 0x61, 0x6d, 0x73, 0x18,
-	0xcb, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x75, 0x64, 0x70, 0x4f, 0x75, 0x74, 0x44, 0x61,
-	0x74, 0x61, 0x67, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x2b, 0x0a, 0x11, 0x75, 0x64, 0x70, 0x5f, 0x72,
-	0x63, 0x76, 0x62, 0x75, 0x66, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0xcc, 0x01, 0x20,
-	0x01, 0x28, 0x04, 0x52, 0x0f, 0x75, 0x64, 0x70, 0x52, 0x63, 0x76, 0x62, 0x75, 0x66, 0x45, 0x72,
-	0x72, 0x6f, 0x72, 0x73, 0x12, 0x2b, 0x0a, 0x11, 0x75, 0x64, 0x70, 0x5f, 0x73, 0x6e, 0x64, 0x62,
-	0x75, 0x66, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0xcd, 0x01, 0x20, 0x01, 0x28, 0x04,
-	0x52, 0x0f, 0x75, 0x64, 0x70, 0x53, 0x6e, 0x64, 0x62, 0x75, 0x66, 0x45, 0x72, 0x72, 0x6f, 0x72,
-	0x73, 0x12, 0x2c, 0x0a, 0x12, 0x75, 0x64, 0x70, 0x5f, 0x69, 0x6e, 0x5f, 0x63, 0x73, 0x75, 0x6d,
-	0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0xce, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f,
-	0x75, 0x64, 0x70, 0x49, 0x6e, 0x43, 0x73, 0x75, 0x6d, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x12,
-	0x2b, 0x0a, 0x11, 0x75, 0x64, 0x70, 0x5f, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x64, 0x5f, 0x6d,
-	0x75, 0x6c, 0x74, 0x69, 0x18, 0xcf, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x75, 0x64, 0x70,
-	0x49, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x64, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x12, 0x25, 0x0a, 0x0e,
-	0x75, 0x64, 0x70, 0x5f, 0x6d, 0x65, 0x6d, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0xd0,
-	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x75, 0x64, 0x70, 0x4d, 0x65, 0x6d, 0x45, 0x72, 0x72,
-	0x6f, 0x72, 0x73, 0x12, 0x2b, 0x0a, 0x11, 0x74, 0x63, 0x70, 0x5f, 0x72, 0x74, 0x6f, 0x5f, 0x61,
-	0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x18, 0xac, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x0f, 0x74, 0x63, 0x70, 0x52, 0x74, 0x6f, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d,
-	0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x63, 0x70, 0x5f, 0x72, 0x74, 0x6f, 0x5f, 0x6d, 0x69, 0x6e, 0x18,
-	0xad, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x74, 0x63, 0x70, 0x52, 0x74, 0x6f, 0x4d, 0x69,
-	0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x63, 0x70, 0x5f, 0x72, 0x74, 0x6f, 0x5f, 0x6d, 0x61, 0x78,
-	0x18, 0xae, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x74, 0x63, 0x70, 0x52, 0x74, 0x6f, 0x4d,
-	0x61, 0x78, 0x12, 0x21, 0x0a, 0x0c, 0x74, 0x63, 0x70, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x63, 0x6f,
-	0x6e, 0x6e, 0x18, 0xaf, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x74, 0x63, 0x70, 0x4d, 0x61,
-	0x78, 0x43, 0x6f, 0x6e, 0x6e, 0x12, 0x29, 0x0a, 0x10, 0x74, 0x63, 0x70, 0x5f, 0x61, 0x63, 0x74,
-	0x69, 0x76, 0x65, 0x5f, 0x6f, 0x70, 0x65, 0x6e, 0x73, 0x18, 0xb0, 0x02, 0x20, 0x01, 0x28, 0x04,
-	0x52, 0x0e, 0x74, 0x63, 0x70, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x4f, 0x70, 0x65, 0x6e, 0x73,
-	0x12, 0x2b, 0x0a, 0x11, 0x74, 0x63, 0x70, 0x5f, 0x70, 0x61, 0x73, 0x73, 0x69, 0x76, 0x65, 0x5f,
-	0x6f, 0x70, 0x65, 0x6e, 0x73, 0x18, 0xb1, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x74, 0x63,
-	0x70, 0x50, 0x61, 0x73, 0x73, 0x69, 0x76, 0x65, 0x4f, 0x70, 0x65, 0x6e, 0x73, 0x12, 0x2b, 0x0a,
-	0x11, 0x74, 0x63, 0x70, 0x5f, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x5f, 0x66, 0x61, 0x69,
-	0x6c, 0x73, 0x18, 0xb2, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x74, 0x63, 0x70, 0x41, 0x74,
-	0x74, 0x65, 0x6d, 0x70, 0x74, 0x46, 0x61, 0x69, 0x6c, 0x73, 0x12, 0x29, 0x0a, 0x10, 0x74, 0x63,
-	0x70, 0x5f, 0x65, 0x73, 0x74, 0x61, 0x62, 0x5f, 0x72, 0x65, 0x73, 0x65, 0x74, 0x73, 0x18, 0xb3,
-	0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x74, 0x63, 0x70, 0x45, 0x73, 0x74, 0x61, 0x62, 0x52,
-	0x65, 0x73, 0x65, 0x74, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x74, 0x63, 0x70, 0x5f, 0x63, 0x75, 0x72,
-	0x72, 0x5f, 0x65, 0x73, 0x74, 0x61, 0x62, 0x18, 0xb4, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c,
-	0x74, 0x63, 0x70, 0x43, 0x75, 0x72, 0x72, 0x45, 0x73, 0x74, 0x61, 0x62, 0x12, 0x1f, 0x0a, 0x0b,
-	0x74, 0x63, 0x70, 0x5f, 0x69, 0x6e, 0x5f, 0x73, 0x65, 0x67, 0x73, 0x18, 0xb5, 0x02, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x09, 0x74, 0x63, 0x70, 0x49, 0x6e, 0x53, 0x65, 0x67, 0x73, 0x12, 0x21, 0x0a,
-	0x0c, 0x74, 0x63, 0x70, 0x5f, 0x6f, 0x75, 0x74, 0x5f, 0x73, 0x65, 0x67, 0x73, 0x18, 0xb6, 0x02,
-	0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x74, 0x63, 0x70, 0x4f, 0x75, 0x74, 0x53, 0x65, 0x67, 0x73,
-	0x12, 0x29, 0x0a, 0x10, 0x74, 0x63, 0x70, 0x5f, 0x72, 0x65, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x5f,
-	0x73, 0x65, 0x67, 0x73, 0x18, 0xb7, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x74, 0x63, 0x70,
-	0x52, 0x65, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x53, 0x65, 0x67, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x74,
-	0x63, 0x70, 0x5f, 0x69, 0x6e, 0x5f, 0x65, 0x72, 0x72, 0x73, 0x18, 0xb8, 0x02, 0x20, 0x01, 0x28,
-	0x04, 0x52, 0x09, 0x74, 0x63, 0x70, 0x49, 0x6e, 0x45, 0x72, 0x72, 0x73, 0x12, 0x21, 0x0a, 0x0c,
-	0x74, 0x63, 0x70, 0x5f, 0x6f, 0x75, 0x74, 0x5f, 0x72, 0x73, 0x74, 0x73, 0x18, 0xb9, 0x02, 0x20,
-	0x01, 0x28, 0x04, 0x52, 0x0a, 0x74, 0x63, 0x70, 0x4f, 0x75, 0x74, 0x52, 0x73, 0x74, 0x73, 0x12,
-	0x2c, 0x0a, 0x12, 0x74, 0x63, 0x70, 0x5f, 0x69, 0x6e, 0x5f, 0x63, 0x73, 0x75, 0x6d, 0x5f, 0x65,
-	0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0xba, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x74, 0x63,
-	0x70, 0x49, 0x6e, 0x43, 0x73, 0x75, 0x6d, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x12, 0x2f, 0x0a,
-	0x13, 0x74, 0x63, 0x70, 0x5f, 0x73, 0x79, 0x6e, 0x63, 0x6f, 0x6f, 0x6b, 0x69, 0x65, 0x73, 0x5f,
-	0x73, 0x65, 0x6e, 0x74, 0x18, 0x90, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x11, 0x74, 0x63, 0x70,
-	0x53, 0x79, 0x6e, 0x63, 0x6f, 0x6f, 0x6b, 0x69, 0x65, 0x73, 0x53, 0x65, 0x6e, 0x74, 0x12, 0x2f,
-	0x0a, 0x13, 0x74, 0x63, 0x70, 0x5f, 0x73, 0x79, 0x6e, 0x63, 0x6f, 0x6f, 0x6b, 0x69, 0x65, 0x73,
-	0x5f, 0x72, 0x65, 0x63, 0x76, 0x18, 0x91, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x11, 0x74, 0x63,
-	0x70, 0x53, 0x79, 0x6e, 0x63, 0x6f, 0x6f, 0x6b, 0x69, 0x65, 0x73, 0x52, 0x65, 0x63, 0x76, 0x12,
-	0x33, 0x0a, 0x15, 0x74, 0x63, 0x70, 0x5f, 0x73, 0x79, 0x6e, 0x63, 0x6f, 0x6f, 0x6b, 0x69, 0x65,
-	0x73, 0x5f, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x18, 0x92, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x13, 0x74, 0x63, 0x70, 0x53, 0x79, 0x6e, 0x63, 0x6f, 0x6f, 0x6b, 0x69, 0x65, 0x73, 0x46, 0x61,
-	0x69, 0x6c, 0x65, 0x64, 0x12, 0x2d, 0x0a, 0x12, 0x74, 0x63, 0x70, 0x5f, 0x65, 0x6d, 0x62, 0x72,
-	0x79, 0x6f, 0x6e, 0x69, 0x63, 0x5f, 0x72, 0x73, 0x74, 0x73, 0x18, 0x93, 0x03, 0x20, 0x01, 0x28,
-	0x04, 0x52, 0x10, 0x74, 0x63, 0x70, 0x45, 0x6d, 0x62, 0x72, 0x79, 0x6f, 0x6e, 0x69, 0x63, 0x52,
-	0x73, 0x74, 0x73, 0x12, 0x29, 0x0a, 0x10, 0x74, 0x63, 0x70, 0x5f, 0x70, 0x72, 0x75, 0x6e, 0x65,
-	0x5f, 0x63, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x18, 0x94, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e,
-	0x74, 0x63, 0x70, 0x50, 0x72, 0x75, 0x6e, 0x65, 0x43, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x12, 0x25,
-	0x0a, 0x0e, 0x74, 0x63, 0x70, 0x5f, 0x72, 0x63, 0x76, 0x5f, 0x70, 0x72, 0x75, 0x6e, 0x65, 0x64,
-	0x18, 0x95, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x74, 0x63, 0x70, 0x52, 0x63, 0x76, 0x50,
-	0x72, 0x75, 0x6e, 0x65, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x74, 0x63, 0x70, 0x5f, 0x6f, 0x66, 0x6f,
-	0x5f, 0x70, 0x72, 0x75, 0x6e, 0x65, 0x64, 0x18, 0x96, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c,
-	0x74, 0x63, 0x70, 0x4f, 0x66, 0x6f, 0x50, 0x72, 0x75, 0x6e, 0x65, 0x64, 0x12, 0x35, 0x0a, 0x17,
-	0x74, 0x63, 0x70, 0x5f, 0x6f, 0x75, 0x74, 0x5f, 0x6f, 0x66, 0x5f, 0x77, 0x69, 0x6e, 0x64, 0x6f,
-	0x77, 0x5f, 0x69, 0x63, 0x6d, 0x70, 0x73, 0x18, 0x97, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x13,
-	0x74, 0x63, 0x70, 0x4f, 0x75, 0x74, 0x4f, 0x66, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x49, 0x63,
-	0x6d, 0x70, 0x73, 0x12, 0x34, 0x0a, 0x16, 0x74, 0x63, 0x70, 0x5f, 0x6c, 0x6f, 0x63, 0x6b, 0x5f,
-	0x64, 0x72, 0x6f, 0x70, 0x70, 0x65, 0x64, 0x5f, 0x69, 0x63, 0x6d, 0x70, 0x73, 0x18, 0x98, 0x03,
-	0x20, 0x01, 0x28, 0x04, 0x52, 0x13, 0x74, 0x63, 0x70, 0x4c, 0x6f, 0x63, 0x6b, 0x44, 0x72, 0x6f,
-	0x70, 0x70, 0x65, 0x64, 0x49, 0x63, 0x6d, 0x70, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x74, 0x63, 0x70,
-	0x5f, 0x61, 0x72, 0x70, 0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x99, 0x03, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x0c, 0x74, 0x63, 0x70, 0x41, 0x72, 0x70, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72,
-	0x12, 0x16, 0x0a, 0x06, 0x74, 0x63, 0x70, 0x5f, 0x74, 0x77, 0x18, 0x9a, 0x03, 0x20, 0x01, 0x28,
-	0x04, 0x52, 0x05, 0x74, 0x63, 0x70, 0x54, 0x77, 0x12, 0x27, 0x0a, 0x0f, 0x74, 0x63, 0x70, 0x5f,
-	0x74, 0x77, 0x5f, 0x72, 0x65, 0x63, 0x79, 0x63, 0x6c, 0x65, 0x64, 0x18, 0x9b, 0x03, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x0d, 0x74, 0x63, 0x70, 0x54, 0x77, 0x52, 0x65, 0x63, 0x79, 0x63, 0x6c, 0x65,
-	0x64, 0x12, 0x23, 0x0a, 0x0d, 0x74, 0x63, 0x70, 0x5f, 0x74, 0x77, 0x5f, 0x6b, 0x69, 0x6c, 0x6c,
-	0x65, 0x64, 0x18, 0x9c, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x74, 0x63, 0x70, 0x54, 0x77,
-	0x4b, 0x69, 0x6c, 0x6c, 0x65, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x74, 0x63, 0x70, 0x5f, 0x70, 0x61,
-	0x77, 0x73, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x9d, 0x03, 0x20, 0x01, 0x28, 0x04,
-	0x52, 0x0d, 0x74, 0x63, 0x70, 0x50, 0x61, 0x77, 0x73, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x12,
-	0x25, 0x0a, 0x0e, 0x74, 0x63, 0x70, 0x5f, 0x70, 0x61, 0x77, 0x73, 0x5f, 0x65, 0x73, 0x74, 0x61,
-	0x62, 0x18, 0x9e, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x74, 0x63, 0x70, 0x50, 0x61, 0x77,
-	0x73, 0x45, 0x73, 0x74, 0x61, 0x62, 0x12, 0x29, 0x0a, 0x10, 0x74, 0x63, 0x70, 0x5f, 0x64, 0x65,
-	0x6c, 0x61, 0x79, 0x65, 0x64, 0x5f, 0x61, 0x63, 0x6b, 0x73, 0x18, 0x9f, 0x03, 0x20, 0x01, 0x28,
-	0x04, 0x52, 0x0e, 0x74, 0x63, 0x70, 0x44, 0x65, 0x6c, 0x61, 0x79, 0x65, 0x64, 0x41, 0x63, 0x6b,
-	0x73, 0x12, 0x34, 0x0a, 0x16, 0x74, 0x63, 0x70, 0x5f, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x65, 0x64,
-	0x5f, 0x61, 0x63, 0x6b, 0x5f, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x18, 0xa0, 0x03, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x13, 0x74, 0x63, 0x70, 0x44, 0x65, 0x6c, 0x61, 0x79, 0x65, 0x64, 0x41, 0x63,
-	0x6b, 0x4c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x12, 0x30, 0x0a, 0x14, 0x74, 0x63, 0x70, 0x5f, 0x64,
-	0x65, 0x6c, 0x61, 0x79, 0x65, 0x64, 0x5f, 0x61, 0x63, 0x6b, 0x5f, 0x6c, 0x6f, 0x73, 0x74, 0x18,
-	0xa1, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x11, 0x74, 0x63, 0x70, 0x44, 0x65, 0x6c, 0x61, 0x79,
-	0x65, 0x64, 0x41, 0x63, 0x6b, 0x4c, 0x6f, 0x73, 0x74, 0x12, 0x31, 0x0a, 0x14, 0x74, 0x63, 0x70,
-	0x5f, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x5f, 0x6f, 0x76, 0x65, 0x72, 0x66, 0x6c, 0x6f, 0x77,
-	0x73, 0x18, 0xa2, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x12, 0x74, 0x63, 0x70, 0x4c, 0x69, 0x73,
-	0x74, 0x65, 0x6e, 0x4f, 0x76, 0x65, 0x72, 0x66, 0x6c, 0x6f, 0x77, 0x73, 0x12, 0x29, 0x0a, 0x10,
-	0x74, 0x63, 0x70, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x5f, 0x64, 0x72, 0x6f, 0x70, 0x73,
-	0x18, 0xa3, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x74, 0x63, 0x70, 0x4c, 0x69, 0x73, 0x74,
-	0x65, 0x6e, 0x44, 0x72, 0x6f, 0x70, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x63, 0x70, 0x5f, 0x68,
-	0x70, 0x5f, 0x68, 0x69, 0x74, 0x73, 0x18, 0xa4, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x74,
-	0x63, 0x70, 0x48, 0x70, 0x48, 0x69, 0x74, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x74, 0x63, 0x70, 0x5f,
-	0x70, 0x75, 0x72, 0x65, 0x5f, 0x61, 0x63, 0x6b, 0x73, 0x18, 0xa5, 0x03, 0x20, 0x01, 0x28, 0x04,
-	0x52, 0x0b, 0x74, 0x63, 0x70, 0x50, 0x75, 0x72, 0x65, 0x41, 0x63, 0x6b, 0x73, 0x12, 0x1f, 0x0a,
-	0x0b, 0x74, 0x63, 0x70, 0x5f, 0x68, 0x70, 0x5f, 0x61, 0x63, 0x6b, 0x73, 0x18, 0xa6, 0x03, 0x20,
-	0x01, 0x28, 0x04, 0x52, 0x09, 0x74, 0x63, 0x70, 0x48, 0x70, 0x41, 0x63, 0x6b, 0x73, 0x12, 0x2b,
-	0x0a, 0x11, 0x74, 0x63, 0x70, 0x5f, 0x72, 0x65, 0x6e, 0x6f, 0x5f, 0x72, 0x65, 0x63, 0x6f, 0x76,
-	0x65, 0x72, 0x79, 0x18, 0xa7, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x74, 0x63, 0x70, 0x52,
-	0x65, 0x6e, 0x6f, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x12, 0x2b, 0x0a, 0x11, 0x74,
-	0x63, 0x70, 0x5f, 0x73, 0x61, 0x63, 0x6b, 0x5f, 0x72, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79,
-	0x18, 0xa8, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x74, 0x63, 0x70, 0x53, 0x61, 0x63, 0x6b,
-	0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x12, 0x2b, 0x0a, 0x11, 0x74, 0x63, 0x70, 0x5f,
-	0x73, 0x61, 0x63, 0x6b, 0x5f, 0x72, 0x65, 0x6e, 0x65, 0x67, 0x69, 0x6e, 0x67, 0x18, 0xa9, 0x03,
-	0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x74, 0x63, 0x70, 0x53, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x6e,
-	0x65, 0x67, 0x69, 0x6e, 0x67, 0x12, 0x29, 0x0a, 0x10, 0x74, 0x63, 0x70, 0x5f, 0x73, 0x61, 0x63,
-	0x6b, 0x5f, 0x72, 0x65, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x18, 0xaa, 0x03, 0x20, 0x01, 0x28, 0x04,
-	0x52, 0x0e, 0x74, 0x63, 0x70, 0x53, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x6f, 0x72, 0x64, 0x65, 0x72,
-	0x12, 0x29, 0x0a, 0x10, 0x74, 0x63, 0x70, 0x5f, 0x72, 0x65, 0x6e, 0x6f, 0x5f, 0x72, 0x65, 0x6f,
-	0x72, 0x64, 0x65, 0x72, 0x18, 0xab, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x74, 0x63, 0x70,
-	0x52, 0x65, 0x6e, 0x6f, 0x52, 0x65, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x25, 0x0a, 0x0e, 0x74,
-	0x63, 0x70, 0x5f, 0x74, 0x73, 0x5f, 0x72, 0x65, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x18, 0xac, 0x03,
-	0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x74, 0x63, 0x70, 0x54, 0x73, 0x52, 0x65, 0x6f, 0x72, 0x64,
-	0x65, 0x72, 0x12, 0x23, 0x0a, 0x0d, 0x74, 0x63, 0x70, 0x5f, 0x66, 0x75, 0x6c, 0x6c, 0x5f, 0x75,
-	0x6e, 0x64, 0x6f, 0x18, 0xad, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x74, 0x63, 0x70, 0x46,
-	0x75, 0x6c, 0x6c, 0x55, 0x6e, 0x64, 0x6f, 0x12, 0x29, 0x0a, 0x10, 0x74, 0x63, 0x70, 0x5f, 0x70,
-	0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x5f, 0x75, 0x6e, 0x64, 0x6f, 0x18, 0xae, 0x03, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x0e, 0x74, 0x63, 0x70, 0x50, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x55, 0x6e,
-	0x64, 0x6f, 0x12, 0x25, 0x0a, 0x0e, 0x74, 0x63, 0x70, 0x5f, 0x64, 0x73, 0x61, 0x63, 0x6b, 0x5f,
-	0x75, 0x6e, 0x64, 0x6f, 0x18, 0xaf, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x74, 0x63, 0x70,
-	0x44, 0x73, 0x61, 0x63, 0x6b, 0x55, 0x6e, 0x64, 0x6f, 0x12, 0x23, 0x0a, 0x0d, 0x74, 0x63, 0x70,
-	0x5f, 0x6c, 0x6f, 0x73, 0x73, 0x5f, 0x75, 0x6e, 0x64, 0x6f, 0x18, 0xb0, 0x03, 0x20, 0x01, 0x28,
-	0x04, 0x52, 0x0b, 0x74, 0x63, 0x70, 0x4c, 0x6f, 0x73, 0x73, 0x55, 0x6e, 0x64, 0x6f, 0x12, 0x2f,
-	0x0a, 0x13, 0x74, 0x63, 0x70, 0x5f, 0x6c, 0x6f, 0x73, 0x74, 0x5f, 0x72, 0x65, 0x74, 0x72, 0x61,
-	0x6e, 0x73, 0x6d, 0x69, 0x74, 0x18, 0xb1, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x11, 0x74, 0x63,
-	0x70, 0x4c, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x6d, 0x69, 0x74, 0x12,
-	0x2b, 0x0a, 0x11, 0x74, 0x63, 0x70, 0x5f, 0x72, 0x65, 0x6e, 0x6f, 0x5f, 0x66, 0x61, 0x69, 0x6c,
-	0x75, 0x72, 0x65, 0x73, 0x18, 0xb2, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x74, 0x63, 0x70,
-	0x52, 0x65, 0x6e, 0x6f, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x73, 0x12, 0x2b, 0x0a, 0x11,
-	0x74, 0x63, 0x70, 0x5f, 0x73, 0x61, 0x63, 0x6b, 0x5f, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65,
-	0x73, 0x18, 0xb3, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x74, 0x63, 0x70, 0x53, 0x61, 0x63,
-	0x6b, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x73, 0x12, 0x2b, 0x0a, 0x11, 0x74, 0x63, 0x70,
-	0x5f, 0x6c, 0x6f, 0x73, 0x73, 0x5f, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x73, 0x18, 0xb4,
-	0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x74, 0x63, 0x70, 0x4c, 0x6f, 0x73, 0x73, 0x46, 0x61,
-	0x69, 0x6c, 0x75, 0x72, 0x65, 0x73, 0x12, 0x29, 0x0a, 0x10, 0x74, 0x63, 0x70, 0x5f, 0x66, 0x61,
-	0x73, 0x74, 0x5f, 0x72, 0x65, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x18, 0xb5, 0x03, 0x20, 0x01, 0x28,
-	0x04, 0x52, 0x0e, 0x74, 0x63, 0x70, 0x46, 0x61, 0x73, 0x74, 0x52, 0x65, 0x74, 0x72, 0x61, 0x6e,
-	0x73, 0x12, 0x34, 0x0a, 0x16, 0x74, 0x63, 0x70, 0x5f, 0x73, 0x6c, 0x6f, 0x77, 0x5f, 0x73, 0x74,
-	0x61, 0x72, 0x74, 0x5f, 0x72, 0x65, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x18, 0xb6, 0x03, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x13, 0x74, 0x63, 0x70, 0x53, 0x6c, 0x6f, 0x77, 0x53, 0x74, 0x61, 0x72, 0x74,
-	0x52, 0x65, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x74, 0x63, 0x70, 0x5f, 0x74,
-	0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x73, 0x18, 0xb7, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b,
-	0x74, 0x63, 0x70, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x74,
-	0x63, 0x70, 0x5f, 0x6c, 0x6f, 0x73, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x73, 0x18, 0xb8,
-	0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x74, 0x63, 0x70, 0x4c, 0x6f, 0x73, 0x73, 0x50, 0x72,
-	0x6f, 0x62, 0x65, 0x73, 0x12, 0x36, 0x0a, 0x17, 0x74, 0x63, 0x70, 0x5f, 0x6c, 0x6f, 0x73, 0x73,
-	0x5f, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x5f, 0x72, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x18,
-	0xb9, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x14, 0x74, 0x63, 0x70, 0x4c, 0x6f, 0x73, 0x73, 0x50,
-	0x72, 0x6f, 0x62, 0x65, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x12, 0x34, 0x0a, 0x16,
-	0x74, 0x63, 0x70, 0x5f, 0x72, 0x65, 0x6e, 0x6f, 0x5f, 0x72, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72,
-	0x79, 0x5f, 0x66, 0x61, 0x69, 0x6c, 0x18, 0xba, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x13, 0x74,
-	0x63, 0x70, 0x52, 0x65, 0x6e, 0x6f, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x46, 0x61,
-	0x69, 0x6c, 0x12, 0x34, 0x0a, 0x16, 0x74, 0x63, 0x70, 0x5f, 0x73, 0x61, 0x63, 0x6b, 0x5f, 0x72,
-	0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x5f, 0x66, 0x61, 0x69, 0x6c, 0x18, 0xbb, 0x03, 0x20,
-	0x01, 0x28, 0x04, 0x52, 0x13, 0x74, 0x63, 0x70, 0x53, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x63, 0x6f,
-	0x76, 0x65, 0x72, 0x79, 0x46, 0x61, 0x69, 0x6c, 0x12, 0x2b, 0x0a, 0x11, 0x74, 0x63, 0x70, 0x5f,
-	0x72, 0x63, 0x76, 0x5f, 0x63, 0x6f, 0x6c, 0x6c, 0x61, 0x70, 0x73, 0x65, 0x64, 0x18, 0xbc, 0x03,
-	0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x74, 0x63, 0x70, 0x52, 0x63, 0x76, 0x43, 0x6f, 0x6c, 0x6c,
-	0x61, 0x70, 0x73, 0x65, 0x64, 0x12, 0x31, 0x0a, 0x14, 0x74, 0x63, 0x70, 0x5f, 0x62, 0x61, 0x63,
-	0x6b, 0x6c, 0x6f, 0x67, 0x5f, 0x63, 0x6f, 0x61, 0x6c, 0x65, 0x73, 0x63, 0x65, 0x18, 0xbd, 0x03,
-	0x20, 0x01, 0x28, 0x04, 0x52, 0x12, 0x74, 0x63, 0x70, 0x42, 0x61, 0x63, 0x6b, 0x6c, 0x6f, 0x67,
-	0x43, 0x6f, 0x61, 0x6c, 0x65, 0x73, 0x63, 0x65, 0x12, 0x2c, 0x0a, 0x12, 0x74, 0x63, 0x70, 0x5f,
-	0x64, 0x73, 0x61, 0x63, 0x6b, 0x5f, 0x6f, 0x6c, 0x64, 0x5f, 0x73, 0x65, 0x6e, 0x74, 0x18, 0xbe,
-	0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x74, 0x63, 0x70, 0x44, 0x73, 0x61, 0x63, 0x6b, 0x4f,
-	0x6c, 0x64, 0x53, 0x65, 0x6e, 0x74, 0x12, 0x2c, 0x0a, 0x12, 0x74, 0x63, 0x70, 0x5f, 0x64, 0x73,
-	0x61, 0x63, 0x6b, 0x5f, 0x6f, 0x66, 0x6f, 0x5f, 0x73, 0x65, 0x6e, 0x74, 0x18, 0xbf, 0x03, 0x20,
-	0x01, 0x28, 0x04, 0x52, 0x0f, 0x74, 0x63, 0x70, 0x44, 0x73, 0x61, 0x63, 0x6b, 0x4f, 0x66, 0x6f,
-	0x53, 0x65, 0x6e, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x74, 0x63, 0x70, 0x5f, 0x64, 0x73, 0x61, 0x63,
-	0x6b, 0x5f, 0x72, 0x65, 0x63, 0x76, 0x18, 0xc0, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x74,
-	0x63, 0x70, 0x44, 0x73, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x63, 0x76, 0x12, 0x2c, 0x0a, 0x12, 0x74,
-	0x63, 0x70, 0x5f, 0x64, 0x73, 0x61, 0x63, 0x6b, 0x5f, 0x6f, 0x66, 0x6f, 0x5f, 0x72, 0x65, 0x63,
-	0x76, 0x18, 0xc1, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x74, 0x63, 0x70, 0x44, 0x73, 0x61,
-	0x63, 0x6b, 0x4f, 0x66, 0x6f, 0x52, 0x65, 0x63, 0x76, 0x12, 0x2a, 0x0a, 0x11, 0x74, 0x63, 0x70,
-	0x5f, 0x61, 0x62, 0x6f, 0x72, 0x74, 0x5f, 0x6f, 0x6e, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0xc2,
-	0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x74, 0x63, 0x70, 0x41, 0x62, 0x6f, 0x72, 0x74, 0x4f,
-	0x6e, 0x44, 0x61, 0x74, 0x61, 0x12, 0x2c, 0x0a, 0x12, 0x74, 0x63, 0x70, 0x5f, 0x61, 0x62, 0x6f,
-	0x72, 0x74, 0x5f, 0x6f, 0x6e, 0x5f, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x18, 0xc3, 0x03, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x0f, 0x74, 0x63, 0x70, 0x41, 0x62, 0x6f, 0x72, 0x74, 0x4f, 0x6e, 0x43, 0x6c,
-	0x6f, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x13, 0x74, 0x63, 0x70, 0x5f, 0x61, 0x62, 0x6f, 0x72, 0x74,
-	0x5f, 0x6f, 0x6e, 0x5f, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x18, 0xc4, 0x03, 0x20, 0x01, 0x28,
-	0x04, 0x52, 0x10, 0x74, 0x63, 0x70, 0x41, 0x62, 0x6f, 0x72, 0x74, 0x4f, 0x6e, 0x4d, 0x65, 0x6d,
-	0x6f, 0x72, 0x79, 0x12, 0x30, 0x0a, 0x14, 0x74, 0x63, 0x70, 0x5f, 0x61, 0x62, 0x6f, 0x72, 0x74,
-	0x5f, 0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0xc5, 0x03, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x11, 0x74, 0x63, 0x70, 0x41, 0x62, 0x6f, 0x72, 0x74, 0x4f, 0x6e, 0x54, 0x69,
-	0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x2e, 0x0a, 0x13, 0x74, 0x63, 0x70, 0x5f, 0x61, 0x62, 0x6f,
-	0x72, 0x74, 0x5f, 0x6f, 0x6e, 0x5f, 0x6c, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x18, 0xc6, 0x03, 0x20,
-	0x01, 0x28, 0x04, 0x52, 0x10, 0x74, 0x63, 0x70, 0x41, 0x62, 0x6f, 0x72, 0x74, 0x4f, 0x6e, 0x4c,
-	0x69, 0x6e, 0x67, 0x65, 0x72, 0x12, 0x29, 0x0a, 0x10, 0x74, 0x63, 0x70, 0x5f, 0x61, 0x62, 0x6f,
-	0x72, 0x74, 0x5f, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x18, 0xc7, 0x03, 0x20, 0x01, 0x28, 0x04,
-	0x52, 0x0e, 0x74, 0x63, 0x70, 0x41, 0x62, 0x6f, 0x72, 0x74, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64,
-	0x12, 0x31, 0x0a, 0x14, 0x74, 0x63, 0x70, 0x5f, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x5f, 0x70,
-	0x72, 0x65, 0x73, 0x73, 0x75, 0x72, 0x65, 0x73, 0x18, 0xc8, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x12, 0x74, 0x63, 0x70, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x50, 0x72, 0x65, 0x73, 0x73, 0x75,
-	0x72, 0x65, 0x73, 0x12, 0x3e, 0x0a, 0x1b, 0x74, 0x63, 0x70, 0x5f, 0x6d, 0x65, 0x6d, 0x6f, 0x72,
-	0x79, 0x5f, 0x70, 0x72, 0x65, 0x73, 0x73, 0x75, 0x72, 0x65, 0x73, 0x5f, 0x63, 0x68, 0x72, 0x6f,
-	0x6e, 0x6f, 0x18, 0xc9, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x18, 0x74, 0x63, 0x70, 0x4d, 0x65,
-	0x6d, 0x6f, 0x72, 0x79, 0x50, 0x72, 0x65, 0x73, 0x73, 0x75, 0x72, 0x65, 0x73, 0x43, 0x68, 0x72,
-	0x6f, 0x6e, 0x6f, 0x12, 0x29, 0x0a, 0x10, 0x74, 0x63, 0x70, 0x5f, 0x73, 0x61, 0x63, 0x6b, 0x5f,
-	0x64, 0x69, 0x73, 0x63, 0x61, 0x72, 0x64, 0x18, 0xca, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e,
-	0x74, 0x63, 0x70, 0x53, 0x61, 0x63, 0x6b, 0x44, 0x69, 0x73, 0x63, 0x61, 0x72, 0x64, 0x12, 0x32,
-	0x0a, 0x15, 0x74, 0x63, 0x70, 0x5f, 0x64, 0x73, 0x61, 0x63, 0x6b, 0x5f, 0x69, 0x67, 0x6e, 0x6f,
-	0x72, 0x65, 0x64, 0x5f, 0x6f, 0x6c, 0x64, 0x18, 0xcb, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x12,
-	0x74, 0x63, 0x70, 0x44, 0x73, 0x61, 0x63, 0x6b, 0x49, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x64, 0x4f,
-	0x6c, 0x64, 0x12, 0x39, 0x0a, 0x19, 0x74, 0x63, 0x70, 0x5f, 0x64, 0x73, 0x61, 0x63, 0x6b, 0x5f,
-	0x69, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x64, 0x5f, 0x6e, 0x6f, 0x5f, 0x75, 0x6e, 0x64, 0x6f, 0x18,
-	0xcc, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x15, 0x74, 0x63, 0x70, 0x44, 0x73, 0x61, 0x63, 0x6b,
-	0x49, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x64, 0x4e, 0x6f, 0x55, 0x6e, 0x64, 0x6f, 0x12, 0x2b, 0x0a,
-	0x11, 0x74, 0x63, 0x70, 0x5f, 0x73, 0x70, 0x75, 0x72, 0x69, 0x6f, 0x75, 0x73, 0x5f, 0x72, 0x74,
-	0x6f, 0x73, 0x18, 0xcd, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x74, 0x63, 0x70, 0x53, 0x70,
-	0x75, 0x72, 0x69, 0x6f, 0x75, 0x73, 0x52, 0x74, 0x6f, 0x73, 0x12, 0x2a, 0x0a, 0x11, 0x74, 0x63,
-	0x70, 0x5f, 0x6d, 0x64, 0x35, 0x5f, 0x6e, 0x6f, 0x74, 0x5f, 0x66, 0x6f, 0x75, 0x6e, 0x64, 0x18,
-	0xce, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x74, 0x63, 0x70, 0x4d, 0x64, 0x35, 0x4e, 0x6f,
-	0x74, 0x46, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x2d, 0x0a, 0x12, 0x74, 0x63, 0x70, 0x5f, 0x6d, 0x64,
-	0x35, 0x5f, 0x75, 0x6e, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x18, 0xcf, 0x03, 0x20,
-	0x01, 0x28, 0x04, 0x52, 0x10, 0x74, 0x63, 0x70, 0x4d, 0x64, 0x35, 0x55, 0x6e, 0x65, 0x78, 0x70,
-	0x65, 0x63, 0x74, 0x65, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x74, 0x63, 0x70, 0x5f, 0x6d, 0x64, 0x35,
-	0x5f, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x18, 0xd0, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x0d, 0x74, 0x63, 0x70, 0x4d, 0x64, 0x35, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x12, 0x29,
-	0x0a, 0x10, 0x74, 0x63, 0x70, 0x5f, 0x73, 0x61, 0x63, 0x6b, 0x5f, 0x73, 0x68, 0x69, 0x66, 0x74,
-	0x65, 0x64, 0x18, 0xd1, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x74, 0x63, 0x70, 0x53, 0x61,
-	0x63, 0x6b, 0x53, 0x68, 0x69, 0x66, 0x74, 0x65, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x74, 0x63, 0x70,
-	0x5f, 0x73, 0x61, 0x63, 0x6b, 0x5f, 0x6d, 0x65, 0x72, 0x67, 0x65, 0x64, 0x18, 0xd2, 0x03, 0x20,
-	0x01, 0x28, 0x04, 0x52, 0x0d, 0x74, 0x63, 0x70, 0x53, 0x61, 0x63, 0x6b, 0x4d, 0x65, 0x72, 0x67,
-	0x65, 0x64, 0x12, 0x36, 0x0a, 0x17, 0x74, 0x63, 0x70, 0x5f, 0x73, 0x61, 0x63, 0x6b, 0x5f, 0x73,
-	0x68, 0x69, 0x66, 0x74, 0x5f, 0x66, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x18, 0xd3, 0x03,
-	0x20, 0x01, 0x28, 0x04, 0x52, 0x14, 0x74, 0x63, 0x70, 0x53, 0x61, 0x63, 0x6b, 0x53, 0x68, 0x69,
-	0x66, 0x74, 0x46, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x12, 0x29, 0x0a, 0x10, 0x74, 0x63,
-	0x70, 0x5f, 0x62, 0x61, 0x63, 0x6b, 0x6c, 0x6f, 0x67, 0x5f, 0x64, 0x72, 0x6f, 0x70, 0x18, 0xd4,
-	0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x74, 0x63, 0x70, 0x42, 0x61, 0x63, 0x6b, 0x6c, 0x6f,
-	0x67, 0x44, 0x72, 0x6f, 0x70, 0x12, 0x30, 0x0a, 0x14, 0x74, 0x63, 0x70, 0x5f, 0x70, 0x66, 0x5f,
-	0x6d, 0x65, 0x6d, 0x61, 0x6c, 0x6c, 0x6f, 0x63, 0x5f, 0x64, 0x72, 0x6f, 0x70, 0x18, 0xd5, 0x03,
-	0x20, 0x01, 0x28, 0x04, 0x52, 0x11, 0x74, 0x63, 0x70, 0x50, 0x66, 0x4d, 0x65, 0x6d, 0x61, 0x6c,
-	0x6c, 0x6f, 0x63, 0x44, 0x72, 0x6f, 0x70, 0x12, 0x28, 0x0a, 0x10, 0x74, 0x63, 0x70, 0x5f, 0x6d,
-	0x69, 0x6e, 0x5f, 0x74, 0x74, 0x6c, 0x5f, 0x64, 0x72, 0x6f, 0x70, 0x18, 0xd6, 0x03, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x0d, 0x74, 0x63, 0x70, 0x4d, 0x69, 0x6e, 0x54, 0x74, 0x6c, 0x44, 0x72, 0x6f,
-	0x70, 0x12, 0x32, 0x0a, 0x15, 0x74, 0x63, 0x70, 0x5f, 0x64, 0x65, 0x66, 0x65, 0x72, 0x5f, 0x61,
-	0x63, 0x63, 0x65, 0x70, 0x74, 0x5f, 0x64, 0x72, 0x6f, 0x70, 0x18, 0xd7, 0x03, 0x20, 0x01, 0x28,
-	0x04, 0x52, 0x12, 0x74, 0x63, 0x70, 0x44, 0x65, 0x66, 0x65, 0x72, 0x41, 0x63, 0x63, 0x65, 0x70,
-	0x74, 0x44, 0x72, 0x6f, 0x70, 0x12, 0x3b, 0x0a, 0x1a, 0x74, 0x63, 0x70, 0x5f, 0x69, 0x70, 0x5f,
-	0x72, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x5f, 0x66, 0x69, 0x6c,
-	0x74, 0x65, 0x72, 0x18, 0xd8, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x16, 0x74, 0x63, 0x70, 0x49,
-	0x70, 0x52, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x50, 0x61, 0x74, 0x68, 0x46, 0x69, 0x6c, 0x74,
-	0x65, 0x72, 0x12, 0x34, 0x0a, 0x16, 0x74, 0x63, 0x70, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x77,
-	0x61, 0x69, 0x74, 0x5f, 0x6f, 0x76, 0x65, 0x72, 0x66, 0x6c, 0x6f, 0x77, 0x18, 0xd9, 0x03, 0x20,
-	0x01, 0x28, 0x04, 0x52, 0x13, 0x74, 0x63, 0x70, 0x54, 0x69, 0x6d, 0x65, 0x57, 0x61, 0x69, 0x74,
-	0x4f, 0x76, 0x65, 0x72, 0x66, 0x6c, 0x6f, 0x77, 0x12, 0x38, 0x0a, 0x19, 0x74, 0x63, 0x70, 0x5f,
-	0x72, 0x65, 0x71, 0x5f, 0x71, 0x5f, 0x66, 0x75, 0x6c, 0x6c, 0x5f, 0x64, 0x6f, 0x5f, 0x63, 0x6f,
-	0x6f, 0x6b, 0x69, 0x65, 0x73, 0x18, 0xda, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x14, 0x74, 0x63,
-	0x70, 0x52, 0x65, 0x71, 0x51, 0x46, 0x75, 0x6c, 0x6c, 0x44, 0x6f, 0x43, 0x6f, 0x6f, 0x6b, 0x69,
-	0x65, 0x73, 0x12, 0x2d, 0x0a, 0x13, 0x74, 0x63, 0x70, 0x5f, 0x72, 0x65, 0x71, 0x5f, 0x71, 0x5f,
-	0x66, 0x75, 0x6c, 0x6c, 0x5f, 0x64, 0x72, 0x6f, 0x70, 0x18, 0xdb, 0x03, 0x20, 0x01, 0x28, 0x04,
-	0x52, 0x0f, 0x74, 0x63, 0x70, 0x52, 0x65, 0x71, 0x51, 0x46, 0x75, 0x6c, 0x6c, 0x44, 0x72, 0x6f,
-	0x70, 0x12, 0x29, 0x0a, 0x10, 0x74, 0x63, 0x70, 0x5f, 0x72, 0x65, 0x74, 0x72, 0x61, 0x6e, 0x73,
-	0x5f, 0x66, 0x61, 0x69, 0x6c, 0x18, 0xdc, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x74, 0x63,
-	0x70, 0x52, 0x65, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x46, 0x61, 0x69, 0x6c, 0x12, 0x29, 0x0a, 0x10,
-	0x74, 0x63, 0x70, 0x5f, 0x72, 0x63, 0x76, 0x5f, 0x63, 0x6f, 0x61, 0x6c, 0x65, 0x73, 0x63, 0x65,
-	0x18, 0xdd, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x74, 0x63, 0x70, 0x52, 0x63, 0x76, 0x43,
-	0x6f, 0x61, 0x6c, 0x65, 0x73, 0x63, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x74, 0x63, 0x70, 0x5f, 0x6f,
-	0x66, 0x6f, 0x5f, 0x71, 0x75, 0x65, 0x75, 0x65, 0x18, 0xde, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x0b, 0x74, 0x63, 0x70, 0x4f, 0x66, 0x6f, 0x51, 0x75, 0x65, 0x75, 0x65, 0x12, 0x21, 0x0a, 0x0c,
-	0x74, 0x63, 0x70, 0x5f, 0x6f, 0x66, 0x6f, 0x5f, 0x64, 0x72, 0x6f, 0x70, 0x18, 0xdf, 0x03, 0x20,
-	0x01, 0x28, 0x04, 0x52, 0x0a, 0x74, 0x63, 0x70, 0x4f, 0x66, 0x6f, 0x44, 0x72, 0x6f, 0x70, 0x12,
-	0x23, 0x0a, 0x0d, 0x74, 0x63, 0x70, 0x5f, 0x6f, 0x66, 0x6f, 0x5f, 0x6d, 0x65, 0x72, 0x67, 0x65,
-	0x18, 0xe0, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x74, 0x63, 0x70, 0x4f, 0x66, 0x6f, 0x4d,
-	0x65, 0x72, 0x67, 0x65, 0x12, 0x2b, 0x0a, 0x11, 0x74, 0x63, 0x70, 0x5f, 0x63, 0x68, 0x61, 0x6c,
-	0x6c, 0x65, 0x6e, 0x67, 0x65, 0x5f, 0x61, 0x63, 0x6b, 0x18, 0xe1, 0x03, 0x20, 0x01, 0x28, 0x04,
-	0x52, 0x0f, 0x74, 0x63, 0x70, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x41, 0x63,
-	0x6b, 0x12, 0x2b, 0x0a, 0x11, 0x74, 0x63, 0x70, 0x5f, 0x73, 0x79, 0x6e, 0x5f, 0x63, 0x68, 0x61,
-	0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x18, 0xe2, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x74,
-	0x63, 0x70, 0x53, 0x79, 0x6e, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x12, 0x30,
-	0x0a, 0x14, 0x74, 0x63, 0x70, 0x5f, 0x66, 0x61, 0x73, 0x74, 0x5f, 0x6f, 0x70, 0x65, 0x6e, 0x5f,
-	0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0xe3, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x11, 0x74,
-	0x63, 0x70, 0x46, 0x61, 0x73, 0x74, 0x4f, 0x70, 0x65, 0x6e, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65,
-	0x12, 0x39, 0x0a, 0x19, 0x74, 0x63, 0x70, 0x5f, 0x66, 0x61, 0x73, 0x74, 0x5f, 0x6f, 0x70, 0x65,
-	0x6e, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x66, 0x61, 0x69, 0x6c, 0x18, 0xe4, 0x03,
-	0x20, 0x01, 0x28, 0x04, 0x52, 0x15, 0x74, 0x63, 0x70, 0x46, 0x61, 0x73, 0x74, 0x4f, 0x70, 0x65,
-	0x6e, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x46, 0x61, 0x69, 0x6c, 0x12, 0x32, 0x0a, 0x15, 0x74,
-	0x63, 0x70, 0x5f, 0x66, 0x61, 0x73, 0x74, 0x5f, 0x6f, 0x70, 0x65, 0x6e, 0x5f, 0x70, 0x61, 0x73,
-	0x73, 0x69, 0x76, 0x65, 0x18, 0xe5, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x12, 0x74, 0x63, 0x70,
-	0x46, 0x61, 0x73, 0x74, 0x4f, 0x70, 0x65, 0x6e, 0x50, 0x61, 0x73, 0x73, 0x69, 0x76, 0x65, 0x12,
-	0x3b, 0x0a, 0x1a, 0x74, 0x63, 0x70, 0x5f, 0x66, 0x61, 0x73, 0x74, 0x5f, 0x6f, 0x70, 0x65, 0x6e,
-	0x5f, 0x70, 0x61, 0x73, 0x73, 0x69, 0x76, 0x65, 0x5f, 0x66, 0x61, 0x69, 0x6c, 0x18, 0xe6, 0x03,
-	0x20, 0x01, 0x28, 0x04, 0x52, 0x16, 0x74, 0x63, 0x70, 0x46, 0x61, 0x73, 0x74, 0x4f, 0x70, 0x65,
-	0x6e, 0x50, 0x61, 0x73, 0x73, 0x69, 0x76, 0x65, 0x46, 0x61, 0x69, 0x6c, 0x12, 0x41, 0x0a, 0x1d,
-	0x74, 0x63, 0x70, 0x5f, 0x66, 0x61, 0x73, 0x74, 0x5f, 0x6f, 0x70, 0x65, 0x6e, 0x5f, 0x6c, 0x69,
-	0x73, 0x74, 0x65, 0x6e, 0x5f, 0x6f, 0x76, 0x65, 0x72, 0x66, 0x6c, 0x6f, 0x77, 0x18, 0xe7, 0x03,
-	0x20, 0x01, 0x28, 0x04, 0x52, 0x19, 0x74, 0x63, 0x70, 0x46, 0x61, 0x73, 0x74, 0x4f, 0x70, 0x65,
-	0x6e, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x4f, 0x76, 0x65, 0x72, 0x66, 0x6c, 0x6f, 0x77, 0x12,
-	0x39, 0x0a, 0x19, 0x74, 0x63, 0x70, 0x5f, 0x66, 0x61, 0x73, 0x74, 0x5f, 0x6f, 0x70, 0x65, 0x6e,
-	0x5f, 0x63, 0x6f, 0x6f, 0x6b, 0x69, 0x65, 0x5f, 0x72, 0x65, 0x71, 0x64, 0x18, 0xe8, 0x03, 0x20,
-	0x01, 0x28, 0x04, 0x52, 0x15, 0x74, 0x63, 0x70, 0x46, 0x61, 0x73, 0x74, 0x4f, 0x70, 0x65, 0x6e,
-	0x43, 0x6f, 0x6f, 0x6b, 0x69, 0x65, 0x52, 0x65, 0x71, 0x64, 0x12, 0x36, 0x0a, 0x17, 0x74, 0x63,
-	0x70, 0x5f, 0x66, 0x61, 0x73, 0x74, 0x5f, 0x6f, 0x70, 0x65, 0x6e, 0x5f, 0x62, 0x6c, 0x61, 0x63,
-	0x6b, 0x68, 0x6f, 0x6c, 0x65, 0x18, 0xe9, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x14, 0x74, 0x63,
-	0x70, 0x46, 0x61, 0x73, 0x74, 0x4f, 0x70, 0x65, 0x6e, 0x42, 0x6c, 0x61, 0x63, 0x6b, 0x68, 0x6f,
-	0x6c, 0x65, 0x12, 0x3f, 0x0a, 0x1c, 0x74, 0x63, 0x70, 0x5f, 0x73, 0x70, 0x75, 0x72, 0x69, 0x6f,
-	0x75, 0x73, 0x5f, 0x72, 0x74, 0x78, 0x5f, 0x68, 0x6f, 0x73, 0x74, 0x5f, 0x71, 0x75, 0x65, 0x75,
-	0x65, 0x73, 0x18, 0xea, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x18, 0x74, 0x63, 0x70, 0x53, 0x70,
-	0x75, 0x72, 0x69, 0x6f, 0x75, 0x73, 0x52, 0x74, 0x78, 0x48, 0x6f, 0x73, 0x74, 0x51, 0x75, 0x65,
-	0x75, 0x65, 0x73, 0x12, 0x37, 0x0a, 0x18, 0x74, 0x63, 0x70, 0x5f, 0x62, 0x75, 0x73, 0x79, 0x5f,
-	0x70, 0x6f, 0x6c, 0x6c, 0x5f, 0x72, 0x78, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x18,
-	0xeb, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x14, 0x74, 0x63, 0x70, 0x42, 0x75, 0x73, 0x79, 0x50,
-	0x6f, 0x6c, 0x6c, 0x52, 0x78, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x12, 0x29, 0x0a, 0x10,
-	0x74, 0x63, 0x70, 0x5f, 0x61, 0x75, 0x74, 0x6f, 0x5f, 0x63, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67,
-	0x18, 0xec, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x74, 0x63, 0x70, 0x41, 0x75, 0x74, 0x6f,
-	0x43, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x12, 0x37, 0x0a, 0x18, 0x74, 0x63, 0x70, 0x5f, 0x66,
-	0x72, 0x6f, 0x6d, 0x5f, 0x7a, 0x65, 0x72, 0x6f, 0x5f, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x5f,
-	0x61, 0x64, 0x76, 0x18, 0xed, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x14, 0x74, 0x63, 0x70, 0x46,
-	0x72, 0x6f, 0x6d, 0x5a, 0x65, 0x72, 0x6f, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x41, 0x64, 0x76,
-	0x12, 0x33, 0x0a, 0x16, 0x74, 0x63, 0x70, 0x5f, 0x74, 0x6f, 0x5f, 0x7a, 0x65, 0x72, 0x6f, 0x5f,
-	0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x5f, 0x61, 0x64, 0x76, 0x18, 0xee, 0x03, 0x20, 0x01, 0x28,
-	0x04, 0x52, 0x12, 0x74, 0x63, 0x70, 0x54, 0x6f, 0x5a, 0x65, 0x72, 0x6f, 0x57, 0x69, 0x6e, 0x64,
-	0x6f, 0x77, 0x41, 0x64, 0x76, 0x12, 0x37, 0x0a, 0x18, 0x74, 0x63, 0x70, 0x5f, 0x77, 0x61, 0x6e,
-	0x74, 0x5f, 0x7a, 0x65, 0x72, 0x6f, 0x5f, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x5f, 0x61, 0x64,
-	0x76, 0x18, 0xef, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x14, 0x74, 0x63, 0x70, 0x57, 0x61, 0x6e,
-	0x74, 0x5a, 0x65, 0x72, 0x6f, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x41, 0x64, 0x76, 0x12, 0x27,
-	0x0a, 0x0f, 0x74, 0x63, 0x70, 0x5f, 0x73, 0x79, 0x6e, 0x5f, 0x72, 0x65, 0x74, 0x72, 0x61, 0x6e,
-	0x73, 0x18, 0xf0, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x74, 0x63, 0x70, 0x53, 0x79, 0x6e,
-	0x52, 0x65, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x12, 0x2c, 0x0a, 0x12, 0x74, 0x63, 0x70, 0x5f, 0x6f,
-	0x72, 0x69, 0x67, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x73, 0x65, 0x6e, 0x74, 0x18, 0xf1, 0x03,
-	0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x74, 0x63, 0x70, 0x4f, 0x72, 0x69, 0x67, 0x44, 0x61, 0x74,
-	0x61, 0x53, 0x65, 0x6e, 0x74, 0x12, 0x38, 0x0a, 0x18, 0x74, 0x63, 0x70, 0x5f, 0x68, 0x79, 0x73,
-	0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x5f, 0x64, 0x65, 0x74, 0x65, 0x63,
-	0x74, 0x18, 0xf2, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x15, 0x74, 0x63, 0x70, 0x48, 0x79, 0x73,
-	0x74, 0x61, 0x72, 0x74, 0x54, 0x72, 0x61, 0x69, 0x6e, 0x44, 0x65, 0x74, 0x65, 0x63, 0x74, 0x12,
-	0x34, 0x0a, 0x16, 0x74, 0x63, 0x70, 0x5f, 0x68, 0x79, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74,
-	0x72, 0x61, 0x69, 0x6e, 0x5f, 0x63, 0x77, 0x6e, 0x64, 0x18, 0xf3, 0x03, 0x20, 0x01, 0x28, 0x04,
-	0x52, 0x13, 0x74, 0x63, 0x70, 0x48, 0x79, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x72, 0x61, 0x69,
-	0x6e, 0x43, 0x77, 0x6e, 0x64, 0x12, 0x38, 0x0a, 0x18, 0x74, 0x63, 0x70, 0x5f, 0x68, 0x79, 0x73,
-	0x74, 0x61, 0x72, 0x74, 0x5f, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x5f, 0x64, 0x65, 0x74, 0x65, 0x63,
-	0x74, 0x18, 0xf4, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x15, 0x74, 0x63, 0x70, 0x48, 0x79, 0x73,
-	0x74, 0x61, 0x72, 0x74, 0x44, 0x65, 0x6c, 0x61, 0x79, 0x44, 0x65, 0x74, 0x65, 0x63, 0x74, 0x12,
-	0x34, 0x0a, 0x16, 0x74, 0x63, 0x70, 0x5f, 0x68, 0x79, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x64,
-	0x65, 0x6c, 0x61, 0x79, 0x5f, 0x63, 0x77, 0x6e, 0x64, 0x18, 0xf5, 0x03, 0x20, 0x01, 0x28, 0x04,
-	0x52, 0x13, 0x74, 0x63, 0x70, 0x48, 0x79, 0x73, 0x74, 0x61, 0x72, 0x74, 0x44, 0x65, 0x6c, 0x61,
-	0x79, 0x43, 0x77, 0x6e, 0x64, 0x12, 0x37, 0x0a, 0x18, 0x74, 0x63, 0x70, 0x5f, 0x61, 0x63, 0x6b,
-	0x5f, 0x73, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x5f, 0x73, 0x79, 0x6e, 0x5f, 0x72, 0x65, 0x63,
-	0x76, 0x18, 0xf6, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x14, 0x74, 0x63, 0x70, 0x41, 0x63, 0x6b,
-	0x53, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x53, 0x79, 0x6e, 0x52, 0x65, 0x63, 0x76, 0x12, 0x30,
-	0x0a, 0x14, 0x74, 0x63, 0x70, 0x5f, 0x61, 0x63, 0x6b, 0x5f, 0x73, 0x6b, 0x69, 0x70, 0x70, 0x65,
-	0x64, 0x5f, 0x70, 0x61, 0x77, 0x73, 0x18, 0xf7, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x11, 0x74,
-	0x63, 0x70, 0x41, 0x63, 0x6b, 0x53, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x50, 0x61, 0x77, 0x73,
-	0x12, 0x2e, 0x0a, 0x13, 0x74, 0x63, 0x70, 0x5f, 0x61, 0x63, 0x6b, 0x5f, 0x73, 0x6b, 0x69, 0x70,
-	0x70, 0x65, 0x64, 0x5f, 0x73, 0x65, 0x71, 0x18, 0xf8, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10,
-	0x74, 0x63, 0x70, 0x41, 0x63, 0x6b, 0x53, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x53, 0x65, 0x71,
-	0x12, 0x39, 0x0a, 0x19, 0x74, 0x63, 0x70, 0x5f, 0x61, 0x63, 0x6b, 0x5f, 0x73, 0x6b, 0x69, 0x70,
-	0x70, 0x65, 0x64, 0x5f, 0x66, 0x69, 0x6e, 0x5f, 0x77, 0x61, 0x69, 0x74, 0x32, 0x18, 0xf9, 0x03,
-	0x20, 0x01, 0x28, 0x04, 0x52, 0x15, 0x74, 0x63, 0x70, 0x41, 0x63, 0x6b, 0x53, 0x6b, 0x69, 0x70,
-	0x70, 0x65, 0x64, 0x46, 0x69, 0x6e, 0x57, 0x61, 0x69, 0x74, 0x32, 0x12, 0x39, 0x0a, 0x19, 0x74,
-	0x63, 0x70, 0x5f, 0x61, 0x63, 0x6b, 0x5f, 0x73, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x5f, 0x74,
-	0x69, 0x6d, 0x65, 0x5f, 0x77, 0x61, 0x69, 0x74, 0x18, 0xfa, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x15, 0x74, 0x63, 0x70, 0x41, 0x63, 0x6b, 0x53, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x54, 0x69,
-	0x6d, 0x65, 0x57, 0x61, 0x69, 0x74, 0x12, 0x3a, 0x0a, 0x19, 0x74, 0x63, 0x70, 0x5f, 0x61, 0x63,
-	0x6b, 0x5f, 0x73, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x5f, 0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65,
-	0x6e, 0x67, 0x65, 0x18, 0xfb, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x16, 0x74, 0x63, 0x70, 0x41,
-	0x63, 0x6b, 0x53, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e,
-	0x67, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x74, 0x63, 0x70, 0x5f, 0x77, 0x69, 0x6e, 0x5f, 0x70, 0x72,
-	0x6f, 0x62, 0x65, 0x18, 0xfc, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x74, 0x63, 0x70, 0x57,
-	0x69, 0x6e, 0x50, 0x72, 0x6f, 0x62, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x74, 0x63, 0x70, 0x5f, 0x6b,
-	0x65, 0x65, 0x70, 0x5f, 0x61, 0x6c, 0x69, 0x76, 0x65, 0x18, 0xfd, 0x03, 0x20, 0x01, 0x28, 0x04,
-	0x52, 0x0c, 0x74, 0x63, 0x70, 0x4b, 0x65, 0x65, 0x70, 0x41, 0x6c, 0x69, 0x76, 0x65, 0x12, 0x23,
-	0x0a, 0x0d, 0x74, 0x63, 0x70, 0x5f, 0x6d, 0x74, 0x75, 0x70, 0x5f, 0x66, 0x61, 0x69, 0x6c, 0x18,
-	0xfe, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x74, 0x63, 0x70, 0x4d, 0x74, 0x75, 0x70, 0x46,
-	0x61, 0x69, 0x6c, 0x12, 0x29, 0x0a, 0x10, 0x74, 0x63, 0x70, 0x5f, 0x6d, 0x74, 0x75, 0x70, 0x5f,
-	0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0xff, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e,
-	0x74, 0x63, 0x70, 0x4d, 0x74, 0x75, 0x70, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x24,
-	0x0a, 0x0d, 0x74, 0x63, 0x70, 0x5f, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x65, 0x64, 0x18,
-	0x80, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x74, 0x63, 0x70, 0x44, 0x65, 0x6c, 0x69, 0x76,
-	0x65, 0x72, 0x65, 0x64, 0x12, 0x29, 0x0a, 0x10, 0x74, 0x63, 0x70, 0x5f, 0x64, 0x65, 0x6c, 0x69,
-	0x76, 0x65, 0x72, 0x65, 0x64, 0x5f, 0x63, 0x65, 0x18, 0x81, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x0e, 0x74, 0x63, 0x70, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x65, 0x64, 0x43, 0x65, 0x12,
-	0x2d, 0x0a, 0x12, 0x74, 0x63, 0x70, 0x5f, 0x61, 0x63, 0x6b, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x72,
-	0x65, 0x73, 0x73, 0x65, 0x64, 0x18, 0x82, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x74, 0x63,
-	0x70, 0x41, 0x63, 0x6b, 0x43, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x65, 0x64, 0x12, 0x30,
-	0x0a, 0x14, 0x74, 0x63, 0x70, 0x5f, 0x7a, 0x65, 0x72, 0x6f, 0x5f, 0x77, 0x69, 0x6e, 0x64, 0x6f,
-	0x77, 0x5f, 0x64, 0x72, 0x6f, 0x70, 0x18, 0x83, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x11, 0x74,
-	0x63, 0x70, 0x5a, 0x65, 0x72, 0x6f, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x44, 0x72, 0x6f, 0x70,
-	0x12, 0x24, 0x0a, 0x0e, 0x74, 0x63, 0x70, 0x5f, 0x72, 0x63, 0x76, 0x5f, 0x71, 0x5f, 0x64, 0x72,
-	0x6f, 0x70, 0x18, 0x84, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x74, 0x63, 0x70, 0x52, 0x63,
-	0x76, 0x51, 0x44, 0x72, 0x6f, 0x70, 0x12, 0x2c, 0x0a, 0x12, 0x74, 0x63, 0x70, 0x5f, 0x77, 0x71,
-	0x75, 0x65, 0x75, 0x65, 0x5f, 0x74, 0x6f, 0x6f, 0x5f, 0x62, 0x69, 0x67, 0x18, 0x85, 0x04, 0x20,
-	0x01, 0x28, 0x04, 0x52, 0x0f, 0x74, 0x63, 0x70, 0x57, 0x71, 0x75, 0x65, 0x75, 0x65, 0x54, 0x6f,
-	0x6f, 0x42, 0x69, 0x67, 0x12, 0x40, 0x0a, 0x1d, 0x74, 0x63, 0x70, 0x5f, 0x66, 0x61, 0x73, 0x74,
-	0x5f, 0x6f, 0x70, 0x65, 0x6e, 0x5f, 0x70, 0x61, 0x73, 0x73, 0x69, 0x76, 0x65, 0x5f, 0x61, 0x6c,
-	0x74, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x86, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x18, 0x74, 0x63,
-	0x70, 0x46, 0x61, 0x73, 0x74, 0x4f, 0x70, 0x65, 0x6e, 0x50, 0x61, 0x73, 0x73, 0x69, 0x76, 0x65,
-	0x41, 0x6c, 0x74, 0x4b, 0x65, 0x79, 0x12, 0x2d, 0x0a, 0x12, 0x74, 0x63, 0x70, 0x5f, 0x74, 0x69,
-	0x6d, 0x65, 0x6f, 0x75, 0x74, 0x5f, 0x72, 0x65, 0x68, 0x61, 0x73, 0x68, 0x18, 0x87, 0x04, 0x20,
-	0x01, 0x28, 0x04, 0x52, 0x10, 0x74, 0x63, 0x70, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x52,
-	0x65, 0x68, 0x61, 0x73, 0x68, 0x12, 0x3a, 0x0a, 0x19, 0x74, 0x63, 0x70, 0x5f, 0x64, 0x75, 0x70,
-	0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x72, 0x65, 0x68, 0x61,
-	0x73, 0x68, 0x18, 0x88, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x16, 0x74, 0x63, 0x70, 0x44, 0x75,
-	0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x68, 0x61, 0x73,
-	0x68, 0x12, 0x2e, 0x0a, 0x13, 0x74, 0x63, 0x70, 0x5f, 0x64, 0x73, 0x61, 0x63, 0x6b, 0x5f, 0x72,
-	0x65, 0x63, 0x76, 0x5f, 0x73, 0x65, 0x67, 0x73, 0x18, 0x89, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x10, 0x74, 0x63, 0x70, 0x44, 0x73, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x63, 0x76, 0x53, 0x65, 0x67,
-	0x73, 0x12, 0x3a, 0x0a, 0x19, 0x74, 0x63, 0x70, 0x5f, 0x64, 0x73, 0x61, 0x63, 0x6b, 0x5f, 0x69,
-	0x67, 0x6e, 0x6f, 0x72, 0x65, 0x64, 0x5f, 0x64, 0x75, 0x62, 0x69, 0x6f, 0x75, 0x73, 0x18, 0x8a,
-	0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x16, 0x74, 0x63, 0x70, 0x44, 0x73, 0x61, 0x63, 0x6b, 0x49,
-	0x67, 0x6e, 0x6f, 0x72, 0x65, 0x64, 0x44, 0x75, 0x62, 0x69, 0x6f, 0x75, 0x73, 0x12, 0x36, 0x0a,
-	0x17, 0x74, 0x63, 0x70, 0x5f, 0x6d, 0x69, 0x67, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x72, 0x65, 0x71,
-	0x5f, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x8b, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x14, 0x74, 0x63, 0x70, 0x4d, 0x69, 0x67, 0x72, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x53, 0x75,
-	0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x36, 0x0a, 0x17, 0x74, 0x63, 0x70, 0x5f, 0x6d, 0x69, 0x67,
-	0x72, 0x61, 0x74, 0x65, 0x5f, 0x72, 0x65, 0x71, 0x5f, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65,
-	0x18, 0x8c, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x14, 0x74, 0x63, 0x70, 0x4d, 0x69, 0x67, 0x72,
-	0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x12, 0x25, 0x0a,
-	0x0e, 0x74, 0x63, 0x70, 0x5f, 0x70, 0x6c, 0x62, 0x5f, 0x72, 0x65, 0x68, 0x61, 0x73, 0x68, 0x18,
-	0x8d, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x74, 0x63, 0x70, 0x50, 0x6c, 0x62, 0x52, 0x65,
-	0x68, 0x61, 0x73, 0x68, 0x12, 0x21, 0x0a, 0x0c, 0x69, 0x63, 0x6d, 0x70, 0x5f, 0x69, 0x6e, 0x5f,
-	0x6d, 0x73, 0x67, 0x73, 0x18, 0xbc, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x69, 0x63, 0x6d,
-	0x70, 0x49, 0x6e, 0x4d, 0x73, 0x67, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x69, 0x63, 0x6d, 0x70, 0x5f,
-	0x69, 0x6e, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0xbd, 0x05, 0x20, 0x01, 0x28, 0x04,
-	0x52, 0x0c, 0x69, 0x63, 0x6d, 0x70, 0x49, 0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x12, 0x2e,
-	0x0a, 0x13, 0x69, 0x63, 0x6d, 0x70, 0x5f, 0x69, 0x6e, 0x5f, 0x63, 0x73, 0x75, 0x6d, 0x5f, 0x65,
-	0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0xbe, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x69, 0x63,
-	0x6d, 0x70, 0x49, 0x6e, 0x43, 0x73, 0x75, 0x6d, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x12, 0x32,
-	0x0a, 0x15, 0x69, 0x63, 0x6d, 0x70, 0x5f, 0x69, 0x6e, 0x5f, 0x64, 0x65, 0x73, 0x74, 0x5f, 0x75,
-	0x6e, 0x72, 0x65, 0x61, 0x63, 0x68, 0x73, 0x18, 0xbf, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x12,
-	0x69, 0x63, 0x6d, 0x70, 0x49, 0x6e, 0x44, 0x65, 0x73, 0x74, 0x55, 0x6e, 0x72, 0x65, 0x61, 0x63,
-	0x68, 0x73, 0x12, 0x2c, 0x0a, 0x12, 0x69, 0x63, 0x6d, 0x70, 0x5f, 0x69, 0x6e, 0x5f, 0x74, 0x69,
-	0x6d, 0x65, 0x5f, 0x65, 0x78, 0x63, 0x64, 0x73, 0x18, 0xc0, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x0f, 0x69, 0x63, 0x6d, 0x70, 0x49, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x45, 0x78, 0x63, 0x64, 0x73,
-	0x12, 0x2c, 0x0a, 0x12, 0x69, 0x63, 0x6d, 0x70, 0x5f, 0x69, 0x6e, 0x5f, 0x70, 0x61, 0x72, 0x6d,
-	0x5f, 0x70, 0x72, 0x6f, 0x62, 0x73, 0x18, 0xc1, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x69,
-	0x63, 0x6d, 0x70, 0x49, 0x6e, 0x50, 0x61, 0x72, 0x6d, 0x50, 0x72, 0x6f, 0x62, 0x73, 0x12, 0x2e,
-	0x0a, 0x13, 0x69, 0x63, 0x6d, 0x70, 0x5f, 0x69, 0x6e, 0x5f, 0x73, 0x72, 0x63, 0x5f, 0x71, 0x75,
-	0x65, 0x6e, 0x63, 0x68, 0x73, 0x18, 0xc2, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x69, 0x63,
-	0x6d, 0x70, 0x49, 0x6e, 0x53, 0x72, 0x63, 0x51, 0x75, 0x65, 0x6e, 0x63, 0x68, 0x73, 0x12, 0x2b,
-	0x0a, 0x11, 0x69, 0x63, 0x6d, 0x70, 0x5f, 0x69, 0x6e, 0x5f, 0x72, 0x65, 0x64, 0x69, 0x72, 0x65,
-	0x63, 0x74, 0x73, 0x18, 0xc3, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x69, 0x63, 0x6d, 0x70,
-	0x49, 0x6e, 0x52, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x69,
-	0x63, 0x6d, 0x70, 0x5f, 0x69, 0x6e, 0x5f, 0x65, 0x63, 0x68, 0x6f, 0x73, 0x18, 0xc4, 0x05, 0x20,
-	0x01, 0x28, 0x04, 0x52, 0x0b, 0x69, 0x63, 0x6d, 0x70, 0x49, 0x6e, 0x45, 0x63, 0x68, 0x6f, 0x73,
-	0x12, 0x2a, 0x0a, 0x11, 0x69, 0x63, 0x6d, 0x70, 0x5f, 0x69, 0x6e, 0x5f, 0x65, 0x63, 0x68, 0x6f,
-	0x5f, 0x72, 0x65, 0x70, 0x73, 0x18, 0xc5, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x69, 0x63,
-	0x6d, 0x70, 0x49, 0x6e, 0x45, 0x63, 0x68, 0x6f, 0x52, 0x65, 0x70, 0x73, 0x12, 0x2d, 0x0a, 0x12,
-	0x69, 0x63, 0x6d, 0x70, 0x5f, 0x69, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
-	0x70, 0x73, 0x18, 0xc6, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x69, 0x63, 0x6d, 0x70, 0x49,
-	0x6e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x73, 0x12, 0x34, 0x0a, 0x16, 0x69,
-	0x63, 0x6d, 0x70, 0x5f, 0x69, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
-	0x5f, 0x72, 0x65, 0x70, 0x73, 0x18, 0xc7, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x13, 0x69, 0x63,
-	0x6d, 0x70, 0x49, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x65, 0x70,
-	0x73, 0x12, 0x2c, 0x0a, 0x12, 0x69, 0x63, 0x6d, 0x70, 0x5f, 0x69, 0x6e, 0x5f, 0x61, 0x64, 0x64,
-	0x72, 0x5f, 0x6d, 0x61, 0x73, 0x6b, 0x73, 0x18, 0xc8, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f,
-	0x69, 0x63, 0x6d, 0x70, 0x49, 0x6e, 0x41, 0x64, 0x64, 0x72, 0x4d, 0x61, 0x73, 0x6b, 0x73, 0x12,
-	0x33, 0x0a, 0x16, 0x69, 0x63, 0x6d, 0x70, 0x5f, 0x69, 0x6e, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x5f,
-	0x6d, 0x61, 0x73, 0x6b, 0x5f, 0x72, 0x65, 0x70, 0x73, 0x18, 0xc9, 0x05, 0x20, 0x01, 0x28, 0x04,
-	0x52, 0x12, 0x69, 0x63, 0x6d, 0x70, 0x49, 0x6e, 0x41, 0x64, 0x64, 0x72, 0x4d, 0x61, 0x73, 0x6b,
-	0x52, 0x65, 0x70, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x69, 0x63, 0x6d, 0x70, 0x5f, 0x6f, 0x75, 0x74,
-	0x5f, 0x6d, 0x73, 0x67, 0x73, 0x18, 0xca, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x69, 0x63,
-	0x6d, 0x70, 0x4f, 0x75, 0x74, 0x4d, 0x73, 0x67, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x69, 0x63, 0x6d,
-	0x70, 0x5f, 0x6f, 0x75, 0x74, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0xcb, 0x05, 0x20,
-	0x01, 0x28, 0x04, 0x52, 0x0d, 0x69, 0x63, 0x6d, 0x70, 0x4f, 0x75, 0x74, 0x45, 0x72, 0x72, 0x6f,
-	0x72, 0x73, 0x12, 0x3b, 0x0a, 0x1a, 0x69, 0x63, 0x6d, 0x70, 0x5f, 0x6f, 0x75, 0x74, 0x5f, 0x72,
-	0x61, 0x74, 0x65, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x5f, 0x67, 0x6c, 0x6f, 0x62, 0x61, 0x6c,
-	0x18, 0xcc, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x16, 0x69, 0x63, 0x6d, 0x70, 0x4f, 0x75, 0x74,
-	0x52, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x47, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x12,
-	0x37, 0x0a, 0x18, 0x69, 0x63, 0x6d, 0x70, 0x5f, 0x6f, 0x75, 0x74, 0x5f, 0x72, 0x61, 0x74, 0x65,
-	0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x5f, 0x68, 0x6f, 0x73, 0x74, 0x18, 0xcd, 0x05, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x14, 0x69, 0x63, 0x6d, 0x70, 0x4f, 0x75, 0x74, 0x52, 0x61, 0x74, 0x65, 0x4c,
-	0x69, 0x6d, 0x69, 0x74, 0x48, 0x6f, 0x73, 0x74, 0x12, 0x34, 0x0a, 0x16, 0x69, 0x63, 0x6d, 0x70,
-	0x5f, 0x6f, 0x75, 0x74, 0x5f, 0x64, 0x65, 0x73, 0x74, 0x5f, 0x75, 0x6e, 0x72, 0x65, 0x61, 0x63,
-	0x68, 0x73, 0x18, 0xce, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x13, 0x69, 0x63, 0x6d, 0x70, 0x4f,
-	0x75, 0x74, 0x44, 0x65, 0x73, 0x74, 0x55, 0x6e, 0x72, 0x65, 0x61, 0x63, 0x68, 0x73, 0x12, 0x2e,
-	0x0a, 0x13, 0x69, 0x63, 0x6d, 0x70, 0x5f, 0x6f, 0x75, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f,
-	0x65, 0x78, 0x63, 0x64, 0x73, 0x18, 0xcf, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x69, 0x63,
-	0x6d, 0x70, 0x4f, 0x75, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x45, 0x78, 0x63, 0x64, 0x73, 0x12, 0x2e,
-	0x0a, 0x13, 0x69, 0x63, 0x6d, 0x70, 0x5f, 0x6f, 0x75, 0x74, 0x5f, 0x70, 0x61, 0x72, 0x6d, 0x5f,
-	0x70, 0x72, 0x6f, 0x62, 0x73, 0x18, 0xd0, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x69, 0x63,
-	0x6d, 0x70, 0x4f, 0x75, 0x74, 0x50, 0x61, 0x72, 0x6d, 0x50, 0x72, 0x6f, 0x62, 0x73, 0x12, 0x30,
-	0x0a, 0x14, 0x69, 0x63, 0x6d, 0x70, 0x5f, 0x6f, 0x75, 0x74, 0x5f, 0x73, 0x72, 0x63, 0x5f, 0x71,
-	0x75, 0x65, 0x6e, 0x63, 0x68, 0x73, 0x18, 0xd1, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x11, 0x69,
-	0x63, 0x6d, 0x70, 0x4f, 0x75, 0x74, 0x53, 0x72, 0x63, 0x51, 0x75, 0x65, 0x6e, 0x63, 0x68, 0x73,
-	0x12, 0x2d, 0x0a, 0x12, 0x69, 0x63, 0x6d, 0x70, 0x5f, 0x6f, 0x75, 0x74, 0x5f, 0x72, 0x65, 0x64,
-	0x69, 0x72, 0x65, 0x63, 0x74, 0x73, 0x18, 0xd2, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x69,
-	0x63, 0x6d, 0x70, 0x4f, 0x75, 0x74, 0x52, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x73, 0x12,
-	0x25, 0x0a, 0x0e, 0x69, 0x63, 0x6d, 0x70, 0x5f, 0x6f, 0x75, 0x74, 0x5f, 0x65, 0x63, 0x68, 0x6f,
-	0x73, 0x18, 0xd3, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x69, 0x63, 0x6d, 0x70, 0x4f, 0x75,
-	0x74, 0x45, 0x63, 0x68, 0x6f, 0x73, 0x12, 0x2c, 0x0a, 0x12, 0x69, 0x63, 0x6d, 0x70, 0x5f, 0x6f,
-	0x75, 0x74, 0x5f, 0x65, 0x63, 0x68, 0x6f, 0x5f, 0x72, 0x65, 0x70, 0x73, 0x18, 0xd4, 0x05, 0x20,
-	0x01, 0x28, 0x04, 0x52, 0x0f, 0x69, 0x63, 0x6d, 0x70, 0x4f, 0x75, 0x74, 0x45, 0x63, 0x68, 0x6f,
-	0x52, 0x65, 0x70, 0x73, 0x12, 0x2f, 0x0a, 0x13, 0x69, 0x63, 0x6d, 0x70, 0x5f, 0x6f, 0x75, 0x74,
-	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x73, 0x18, 0xd5, 0x05, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x11, 0x69, 0x63, 0x6d, 0x70, 0x4f, 0x75, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x73,
-	0x74, 0x61, 0x6d, 0x70, 0x73, 0x12, 0x36, 0x0a, 0x17, 0x69, 0x63, 0x6d, 0x70, 0x5f, 0x6f, 0x75,
-	0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x5f, 0x72, 0x65, 0x70, 0x73,
-	0x18, 0xd6, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x14, 0x69, 0x63, 0x6d, 0x70, 0x4f, 0x75, 0x74,
-	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x65, 0x70, 0x73, 0x12, 0x2e, 0x0a,
-	0x13, 0x69, 0x63, 0x6d, 0x70, 0x5f, 0x6f, 0x75, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x5f, 0x6d,
-	0x61, 0x73, 0x6b, 0x73, 0x18, 0xd7, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x69, 0x63, 0x6d,
-	0x70, 0x4f, 0x75, 0x74, 0x41, 0x64, 0x64, 0x72, 0x4d, 0x61, 0x73, 0x6b, 0x73, 0x12, 0x35, 0x0a,
-	0x17, 0x69, 0x63, 0x6d, 0x70, 0x5f, 0x6f, 0x75, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x5f, 0x6d,
-	0x61, 0x73, 0x6b, 0x5f, 0x72, 0x65, 0x70, 0x73, 0x18, 0xd8, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x13, 0x69, 0x63, 0x6d, 0x70, 0x4f, 0x75, 0x74, 0x41, 0x64, 0x64, 0x72, 0x4d, 0x61, 0x73, 0x6b,
-	0x52, 0x65, 0x70, 0x73, 0x2a, 0x27, 0x0a, 0x0a, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x54, 0x79,
-	0x70, 0x65, 0x12, 0x07, 0x0a, 0x03, 0x54, 0x43, 0x50, 0x10, 0x00, 0x12, 0x07, 0x0a, 0x03, 0x4e,
-	0x49, 0x43, 0x10, 0x01, 0x12, 0x07, 0x0a, 0x03, 0x4e, 0x45, 0x54, 0x10, 0x02, 0x2a, 0xeb, 0x01,
-	0x0a, 0x0b, 0x53, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x13, 0x0a,
-	0x0f, 0x54, 0x43, 0x50, 0x5f, 0x45, 0x53, 0x54, 0x41, 0x42, 0x4c, 0x49, 0x53, 0x48, 0x45, 0x44,
-	0x10, 0x00, 0x12, 0x10, 0x0a, 0x0c, 0x54, 0x43, 0x50, 0x5f, 0x53, 0x59, 0x4e, 0x5f, 0x53, 0x45,
-	0x4e, 0x54, 0x10, 0x01, 0x12, 0x10, 0x0a, 0x0c, 0x54, 0x43, 0x50, 0x5f, 0x53, 0x59, 0x4e, 0x5f,
-	0x52, 0x45, 0x43, 0x56, 0x10, 0x02, 0x12, 0x11, 0x0a, 0x0d, 0x54, 0x43, 0x50, 0x5f, 0x46, 0x49,
-	0x4e, 0x5f, 0x57, 0x41, 0x49, 0x54, 0x31, 0x10, 0x03, 0x12, 0x11, 0x0a, 0x0d, 0x54, 0x43, 0x50,
-	0x5f, 0x46, 0x49, 0x4e, 0x5f, 0x57, 0x41, 0x49, 0x54, 0x32, 0x10, 0x04, 0x12, 0x11, 0x0a, 0x0d,
-	0x54, 0x43, 0x50, 0x5f, 0x54, 0x49, 0x4d, 0x45, 0x5f, 0x57, 0x41, 0x49, 0x54, 0x10, 0x05, 0x12,
-	0x0d, 0x0a, 0x09, 0x54, 0x43, 0x50, 0x5f, 0x43, 0x4c, 0x4f, 0x53, 0x45, 0x10, 0x06, 0x12, 0x12,
-	0x0a, 0x0e, 0x54, 0x43, 0x50, 0x5f, 0x43, 0x4c, 0x4f, 0x53, 0x45, 0x5f, 0x57, 0x41, 0x49, 0x54,
-	0x10, 0x07, 0x12, 0x10, 0x0a, 0x0c, 0x54, 0x43, 0x50, 0x5f, 0x4c, 0x41, 0x53, 0x54, 0x5f, 0x41,
-	0x43, 0x4b, 0x10, 0x08, 0x12, 0x0e, 0x0a, 0x0a, 0x54, 0x43, 0x50, 0x5f, 0x4c, 0x49, 0x53, 0x54,
-	0x45, 0x4e, 0x10, 0x09, 0x12, 0x0f, 0x0a, 0x0b, 0x54, 0x43, 0x50, 0x5f, 0x43, 0x4c, 0x4f, 0x53,
-	0x49, 0x4e, 0x47, 0x10, 0x0a, 0x12, 0x14, 0x0a, 0x10, 0x54, 0x43, 0x50, 0x5f, 0x4e, 0x45, 0x57,
-	0x5f, 0x53, 0x59, 0x4e, 0x5f, 0x52, 0x45, 0x43, 0x56, 0x10, 0x0b, 0x62, 0x06, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x33,
+	// header
+	Timestamp int64      `protobuf:"varint,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Type      MetricType `protobuf:"varint,2,opt,name=type,proto3,enum=MetricType" json:"type,omitempty"`
+	// fields
+	Hostname          string   `protobuf:"bytes,3,opt,name=hostname,proto3" json:"hostname,omitempty"`
+	MachineId         string   `protobuf:"bytes,4,opt,name=machine_id,json=machineId,proto3" json:"machine_id,omitempty"`             // /etc/machine-id
+	BootId            string   `protobuf:"bytes,5,opt,name=boot_id,json=bootId,proto3" json:"boot_id,omitempty"`                      // /proc/sys/kernel/random/boot_id
+	KernelRelease     string   `protobuf:"bytes,6,opt,name=kernel_release,json=kernelRelease,proto3" json:"kernel_release,omitempty"` // /proc/sys/kernel/osrelease
+	Version           string   `protobuf:"bytes,7,opt,name=version,proto3" json:"version,omitempty"`                                  // tcpmon version
+	CpuCount          uint32   `protobuf:"varint,8,opt,name=cpu_count,json=cpuCount,proto3" json:"cpu_count,omitempty"`
+	Interfaces        []string `protobuf:"bytes,9,rep,name=interfaces,proto3" json:"interfaces,omitempty"`
+	CollectIntervalMs int64    `protobuf:"varint,10,opt,name=collect_interval_ms,json=collectIntervalMs,proto3" json:"collect_interval_ms,omitempty"`
+	ConfigHash        string   `protobuf:"bytes,11,opt,name=config_hash,json=configHash,proto3" json:"config_hash,omitempty"` // sha256 of the settings, except cluster members
+}
+
+func (x *HostInfo) Reset() {
+	*x = HostInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_tcpmon_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
 }
 
-var (
-	file_proto_tcpmon_proto_rawDescOnce sync.Once
-	file_proto_tcpmon_proto_rawDescData = file_proto_tcpmon_proto_rawDesc
-)
+func (x *HostInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
 
-func file_proto_tcpmon_proto_rawDescGZIP() []byte {
-	file_proto_tcpmon_proto_rawDescOnce.Do(func() {
-		file_proto_tcpmon_proto_rawDescData = protoimpl.X.CompressGZIP(file_proto_tcpmon_proto_rawDescData)
-	})
-	return file_proto_tcpmon_proto_rawDescData
+func (*HostInfo) ProtoMessage() {}
+
+func (x *HostInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_tcpmon_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-var file_proto_tcpmon_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_proto_tcpmon_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
-var file_proto_tcpmon_proto_goTypes = []interface{}{
-	(MetricType)(0),           // 0: MetricType
-	(SocketState)(0),          // 1: SocketState
-	(*Metric)(nil),            // 2: Metric
-	(*SocketMemoryUsage)(nil), // 3: SocketMemoryUsage
-	(*TimerInfo)(nil),         // 4: TimerInfo
-	(*ProcessInfo)(nil),       // 5: ProcessInfo
-	(*SocketMetric)(nil),      // 6: SocketMetric
-	(*TcpMetric)(nil),         // 7: TcpMetric
-	(*IfaceMetric)(nil),       // 8: IfaceMetric
-	(*NicMetric)(nil),         // 9: NicMetric
-	(*NetstatMetric)(nil),     // 10: NetstatMetric
+// Deprecated: Use HostInfo.ProtoReflect.Descriptor instead.
+func (*HostInfo) Descriptor() ([]byte, []int) {
+	return file_proto_tcpmon_proto_rawDescGZIP(), []int{11}
 }
-var file_proto_tcpmon_proto_depIdxs = []int32{
-	7,  // 0: Metric.tcp:type_name -> TcpMetric
-	9,  // 1: Metric.nic:type_name -> NicMetric
-	10, // 2: Metric.net:type_name -> NetstatMetric
-	1,  // 3: SocketMetric.state:type_name -> SocketState
-	5,  // 4: SocketMetric.processes:type_name -> ProcessInfo
-	4,  // 5: SocketMetric.timers:type_name -> TimerInfo
-	3,  // 6: SocketMetric.skmem:type_name -> SocketMemoryUsage
-	0,  // 7: TcpMetric.type:type_name -> MetricType
-	6,  // 8: TcpMetric.sockets:type_name -> SocketMetric
-	0,  // 9: NicMetric.type:type_name -> MetricType
-	8,  // 10: NicMetric.ifaces:type_name -> IfaceMetric
-	0,  // 11: NetstatMetric.type:type_name -> MetricType
-	12, // [12:12] is the sub-list for method output_type
-	12, // [12:12] is the sub-list for method input_type
-	12, // [12:12] is the sub-list for extension type_name
-	12, // [12:12] is the sub-list for extension extendee
-	0,  // [0:12] is the sub-list for field type_name
+
+func (x *HostInfo) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *HostInfo) GetType() MetricType {
+	if x != nil {
+		return x.Type
+	}
+	return MetricType_TCP
+}
+
+func (x *HostInfo) GetHostname() string {
+	if x != nil {
+		return x.Hostname
+	}
+	return ""
+}
+
+func (x *HostInfo) GetMachineId() string {
+	if x != nil {
+		return x.MachineId
+	}
+	return ""
+}
+
+func (x *HostInfo) GetBootId() string {
+	if x != nil {
+		return x.BootId
+	}
+	return ""
+}
+
+func (x *HostInfo) GetKernelRelease() string {
+	if x != nil {
+		return x.KernelRelease
+	}
+	return ""
+}
+
+func (x *HostInfo) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *HostInfo) GetCpuCount() uint32 {
+	if x != nil {
+		return x.CpuCount
+	}
+	return 0
+}
+
+func (x *HostInfo) GetInterfaces() []string {
+	if x != nil {
+		return x.Interfaces
+	}
+	return nil
+}
+
+func (x *HostInfo) GetCollectIntervalMs() int64 {
+	if x != nil {
+		return x.CollectIntervalMs
+	}
+	return 0
+}
+
+func (x *HostInfo) GetConfigHash() string {
+	if x != nil {
+		return x.ConfigHash
+	}
+	return ""
+}
+
+type ConnectionEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type          ConnectionEventType `protobuf:"varint,1,opt,name=type,proto3,enum=ConnectionEventType" json:"type,omitempty"`
+	Cookie        uint64              `protobuf:"varint,2,opt,name=cookie,proto3" json:"cookie,omitempty"`
+	LocalAddr     string              `protobuf:"bytes,3,opt,name=local_addr,json=localAddr,proto3" json:"local_addr,omitempty"`
+	PeerAddr      string              `protobuf:"bytes,4,opt,name=peer_addr,json=peerAddr,proto3" json:"peer_addr,omitempty"`
+	Process       string              `protobuf:"bytes,5,opt,name=process,proto3" json:"process,omitempty"`
+	State         SocketState         `protobuf:"varint,6,opt,name=state,proto3,enum=SocketState" json:"state,omitempty"`                          // state after the event, the last seen state for CLOSED and DISAPPEARED
+	PrevState     SocketState         `protobuf:"varint,7,opt,name=prev_state,json=prevState,proto3,enum=SocketState" json:"prev_state,omitempty"` // for STATE_CHANGED
+	FirstSeen     int64               `protobuf:"varint,8,opt,name=first_seen,json=firstSeen,proto3" json:"first_seen,omitempty"`                  // unix timestamp
+	DurationMs    int64               `protobuf:"varint,9,opt,name=duration_ms,json=durationMs,proto3" json:"duration_ms,omitempty"`               // since first seen, or since entering the state for STUCK
+	BytesAcked    uint64              `protobuf:"varint,10,opt,name=bytes_acked,json=bytesAcked,proto3" json:"bytes_acked,omitempty"`              // the last seen counters
+	BytesReceived uint64              `protobuf:"varint,11,opt,name=bytes_received,json=bytesReceived,proto3" json:"bytes_received,omitempty"`
+	Timestamp     int64               `protobuf:"varint,12,opt,name=timestamp,proto3" json:"timestamp,omitempty"` // unix timestamp of the socket table the event derived from
+}
+
+func (x *ConnectionEvent) Reset() {
+	*x = ConnectionEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_tcpmon_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConnectionEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConnectionEvent) ProtoMessage() {}
+
+func (x *ConnectionEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_tcpmon_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConnectionEvent.ProtoReflect.Descriptor instead.
+func (*ConnectionEvent) Descriptor() ([]byte, []int) {
+	return file_proto_tcpmon_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ConnectionEvent) GetType() ConnectionEventType {
+	if x != nil {
+		return x.Type
+	}
+	return ConnectionEventType_OPENED
+}
+
+func (x *ConnectionEvent) GetCookie() uint64 {
+	if x != nil {
+		return x.Cookie
+	}
+	return 0
+}
+
+func (x *ConnectionEvent) GetLocalAddr() string {
+	if x != nil {
+		return x.LocalAddr
+	}
+	return ""
+}
+
+func (x *ConnectionEvent) GetPeerAddr() string {
+	if x != nil {
+		return x.PeerAddr
+	}
+	return ""
+}
+
+func (x *ConnectionEvent) GetProcess() string {
+	if x != nil {
+		return x.Process
+	}
+	return ""
+}
+
+func (x *ConnectionEvent) GetState() SocketState {
+	if x != nil {
+		return x.State
+	}
+	return SocketState_TCP_ESTABLISHED
+}
+
+func (x *ConnectionEvent) GetPrevState() SocketState {
+	if x != nil {
+		return x.PrevState
+	}
+	return SocketState_TCP_ESTABLISHED
+}
+
+func (x *ConnectionEvent) GetFirstSeen() int64 {
+	if x != nil {
+		return x.FirstSeen
+	}
+	return 0
+}
+
+func (x *ConnectionEvent) GetDurationMs() int64 {
+	if x != nil {
+		return x.DurationMs
+	}
+	return 0
+}
+
+func (x *ConnectionEvent) GetBytesAcked() uint64 {
+	if x != nil {
+		return x.BytesAcked
+	}
+	return 0
+}
+
+func (x *ConnectionEvent) GetBytesReceived() uint64 {
+	if x != nil {
+		return x.BytesReceived
+	}
+	return 0
+}
+
+func (x *ConnectionEvent) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+// EventMetric contains the connection events derived from two consecutive socket tables
+type EventMetric struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// header
+	Timestamp int64      `protobuf:"varint,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Type      MetricType `protobuf:"varint,2,opt,name=type,proto3,enum=MetricType" json:"type,omitempty"`
+	// fields
+	Events []*ConnectionEvent `protobuf:"bytes,3,rep,name=events,proto3" json:"events,omitempty"`
+}
+
+func (x *EventMetric) Reset() {
+	*x = EventMetric{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_tcpmon_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventMetric) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventMetric) ProtoMessage() {}
+
+func (x *EventMetric) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_tcpmon_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EventMetric.ProtoReflect.Descriptor instead.
+func (*EventMetric) Descriptor() ([]byte, []int) {
+	return file_proto_tcpmon_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *EventMetric) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *EventMetric) GetType() MetricType {
+	if x != nil {
+		return x.Type
+	}
+	return MetricType_TCP
+}
+
+func (x *EventMetric) GetEvents() []*ConnectionEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+type TypeCount struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type  MetricType `protobuf:"varint,1,opt,name=type,proto3,enum=MetricType" json:"type,omitempty"`
+	Count uint32     `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *TypeCount) Reset() {
+	*x = TypeCount{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_tcpmon_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TypeCount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TypeCount) ProtoMessage() {}
+
+func (x *TypeCount) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_tcpmon_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TypeCount.ProtoReflect.Descriptor instead.
+func (*TypeCount) Descriptor() ([]byte, []int) {
+	return file_proto_tcpmon_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *TypeCount) GetType() MetricType {
+	if x != nil {
+		return x.Type
+	}
+	return MetricType_TCP
+}
+
+func (x *TypeCount) GetCount() uint32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+// FileIndex is the sidecar index of a data file, written when the data file is sealed. Offsets are in the
+// uncompressed data file, entries are in the order of records.
+type FileIndex struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MinTimestamp int64        `protobuf:"varint,1,opt,name=min_timestamp,json=minTimestamp,proto3" json:"min_timestamp,omitempty"`
+	MaxTimestamp int64        `protobuf:"varint,2,opt,name=max_timestamp,json=maxTimestamp,proto3" json:"max_timestamp,omitempty"`
+	Count        uint32       `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"` // records in the data file, including records not indexed
+	TypeCounts   []*TypeCount `protobuf:"bytes,4,rep,name=type_counts,json=typeCounts,proto3" json:"type_counts,omitempty"`
+	// entries, one for each metric record
+	Offsets        []uint64     `protobuf:"varint,5,rep,packed,name=offsets,proto3" json:"offsets,omitempty"`
+	Timestamps     []int64      `protobuf:"varint,6,rep,packed,name=timestamps,proto3" json:"timestamps,omitempty"`
+	Types          []MetricType `protobuf:"varint,7,rep,packed,name=types,proto3,enum=MetricType" json:"types,omitempty"`
+	CorruptOffsets []uint64     `protobuf:"varint,8,rep,packed,name=corrupt_offsets,json=corruptOffsets,proto3" json:"corrupt_offsets,omitempty"` // records failing their checksum, deltas after them can't be decoded
+}
+
+func (x *FileIndex) Reset() {
+	*x = FileIndex{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_tcpmon_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FileIndex) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FileIndex) ProtoMessage() {}
+
+func (x *FileIndex) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_tcpmon_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FileIndex.ProtoReflect.Descriptor instead.
+func (*FileIndex) Descriptor() ([]byte, []int) {
+	return file_proto_tcpmon_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *FileIndex) GetMinTimestamp() int64 {
+	if x != nil {
+		return x.MinTimestamp
+	}
+	return 0
+}
+
+func (x *FileIndex) GetMaxTimestamp() int64 {
+	if x != nil {
+		return x.MaxTimestamp
+	}
+	return 0
+}
+
+func (x *FileIndex) GetCount() uint32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *FileIndex) GetTypeCounts() []*TypeCount {
+	if x != nil {
+		return x.TypeCounts
+	}
+	return nil
+}
+
+func (x *FileIndex) GetOffsets() []uint64 {
+	if x != nil {
+		return x.Offsets
+	}
+	return nil
+}
+
+func (x *FileIndex) GetTimestamps() []int64 {
+	if x != nil {
+		return x.Timestamps
+	}
+	return nil
+}
+
+func (x *FileIndex) GetTypes() []MetricType {
+	if x != nil {
+		return x.Types
+	}
+	return nil
+}
+
+func (x *FileIndex) GetCorruptOffsets() []uint64 {
+	if x != nil {
+		return x.CorruptOffsets
+	}
+	return nil
+}
+
+// RollupField is the aggregation of a numeric field in a rollup window
+type RollupField struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string  `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"` // field name in the source metric, e.g. bytes_acked
+	Min  float64 `protobuf:"fixed64,2,opt,name=min,proto3" json:"min,omitempty"`
+	Max  float64 `protobuf:"fixed64,3,opt,name=max,proto3" json:"max,omitempty"`
+	Sum  float64 `protobuf:"fixed64,4,opt,name=sum,proto3" json:"sum,omitempty"` // avg = sum / samples of the group
+	Last float64 `protobuf:"fixed64,5,opt,name=last,proto3" json:"last,omitempty"`
+}
+
+func (x *RollupField) Reset() {
+	*x = RollupField{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_tcpmon_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RollupField) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RollupField) ProtoMessage() {}
+
+func (x *RollupField) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_tcpmon_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RollupField.ProtoReflect.Descriptor instead.
+func (*RollupField) Descriptor() ([]byte, []int) {
+	return file_proto_tcpmon_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *RollupField) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *RollupField) GetMin() float64 {
+	if x != nil {
+		return x.Min
+	}
+	return 0
+}
+
+func (x *RollupField) GetMax() float64 {
+	if x != nil {
+		return x.Max
+	}
+	return 0
+}
+
+func (x *RollupField) GetSum() float64 {
+	if x != nil {
+		return x.Sum
+	}
+	return 0
+}
+
+func (x *RollupField) GetLast() float64 {
+	if x != nil {
+		return x.Last
+	}
+	return 0
+}
+
+// RollupGroup aggregates a series in the source metric
+type RollupGroup struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key     string         `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"` // empty for the whole metric, the interface name for NIC, the connection key for sockets
+	Samples uint32         `protobuf:"varint,2,opt,name=samples,proto3" json:"samples,omitempty"`
+	Fields  []*RollupField `protobuf:"bytes,3,rep,name=fields,proto3" json:"fields,omitempty"`
+	// sockets only
+	LocalAddr string      `protobuf:"bytes,4,opt,name=local_addr,json=localAddr,proto3" json:"local_addr,omitempty"`
+	PeerAddr  string      `protobuf:"bytes,5,opt,name=peer_addr,json=peerAddr,proto3" json:"peer_addr,omitempty"`
+	State     SocketState `protobuf:"varint,6,opt,name=state,proto3,enum=SocketState" json:"state,omitempty"` // the last state
+}
+
+func (x *RollupGroup) Reset() {
+	*x = RollupGroup{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_tcpmon_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RollupGroup) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RollupGroup) ProtoMessage() {}
+
+func (x *RollupGroup) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_tcpmon_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RollupGroup.ProtoReflect.Descriptor instead.
+func (*RollupGroup) Descriptor() ([]byte, []int) {
+	return file_proto_tcpmon_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *RollupGroup) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *RollupGroup) GetSamples() uint32 {
+	if x != nil {
+		return x.Samples
+	}
+	return 0
+}
+
+func (x *RollupGroup) GetFields() []*RollupField {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+func (x *RollupGroup) GetLocalAddr() string {
+	if x != nil {
+		return x.LocalAddr
+	}
+	return ""
+}
+
+func (x *RollupGroup) GetPeerAddr() string {
+	if x != nil {
+		return x.PeerAddr
+	}
+	return ""
+}
+
+func (x *RollupGroup) GetState() SocketState {
+	if x != nil {
+		return x.State
+	}
+	return SocketState_TCP_ESTABLISHED
+}
+
+// RollupMetric summarizes metrics of a source type in a time window. TCP rollups have the socket table summary
+// (key is empty) and the top sockets by retransmissions and by bytes.
+type RollupMetric struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// header
+	Timestamp int64      `protobuf:"varint,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"` // start of the window
+	Type      MetricType `protobuf:"varint,2,opt,name=type,proto3,enum=MetricType" json:"type,omitempty"`
+	// fields
+	Resolution int64          `protobuf:"varint,3,opt,name=resolution,proto3" json:"resolution,omitempty"`         // length of the window in seconds
+	Source     MetricType     `protobuf:"varint,4,opt,name=source,proto3,enum=MetricType" json:"source,omitempty"` // TCP, NIC or NET
+	Samples    uint32         `protobuf:"varint,5,opt,name=samples,proto3" json:"samples,omitempty"`               // source metrics in the window
+	Groups     []*RollupGroup `protobuf:"bytes,6,rep,name=groups,proto3" json:"groups,omitempty"`
+}
+
+func (x *RollupMetric) Reset() {
+	*x = RollupMetric{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_tcpmon_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RollupMetric) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RollupMetric) ProtoMessage() {}
+
+func (x *RollupMetric) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_tcpmon_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RollupMetric.ProtoReflect.Descriptor instead.
+func (*RollupMetric) Descriptor() ([]byte, []int) {
+	return file_proto_tcpmon_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *RollupMetric) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *RollupMetric) GetType() MetricType {
+	if x != nil {
+		return x.Type
+	}
+	return MetricType_TCP
+}
+
+func (x *RollupMetric) GetResolution() int64 {
+	if x != nil {
+		return x.Resolution
+	}
+	return 0
+}
+
+func (x *RollupMetric) GetSource() MetricType {
+	if x != nil {
+		return x.Source
+	}
+	return MetricType_TCP
+}
+
+func (x *RollupMetric) GetSamples() uint32 {
+	if x != nil {
+		return x.Samples
+	}
+	return 0
+}
+
+func (x *RollupMetric) GetGroups() []*RollupGroup {
+	if x != nil {
+		return x.Groups
+	}
+	return nil
+}
+
+// CollectorCost is the cost of a collector since tcpmon started
+type CollectorCost struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name             string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"` // socket, nic, net or host
+	Runs             uint64 `protobuf:"varint,2,opt,name=runs,proto3" json:"runs,omitempty"`
+	Failures         uint64 `protobuf:"varint,3,opt,name=failures,proto3" json:"failures,omitempty"`
+	LastDurationUs   int64  `protobuf:"varint,4,opt,name=last_duration_us,json=lastDurationUs,proto3" json:"last_duration_us,omitempty"` // the last run, including the child process
+	MaxDurationUs    int64  `protobuf:"varint,5,opt,name=max_duration_us,json=maxDurationUs,proto3" json:"max_duration_us,omitempty"`
+	TotalDurationUs  int64  `protobuf:"varint,6,opt,name=total_duration_us,json=totalDurationUs,proto3" json:"total_duration_us,omitempty"`
+	LastChildWallUs  int64  `protobuf:"varint,7,opt,name=last_child_wall_us,json=lastChildWallUs,proto3" json:"last_child_wall_us,omitempty"` // wall time of the child process in the last run, e.g. ss, from go-cmd status
+	TotalChildWallUs int64  `protobuf:"varint,8,opt,name=total_child_wall_us,json=totalChildWallUs,proto3" json:"total_child_wall_us,omitempty"`
+}
+
+func (x *CollectorCost) Reset() {
+	*x = CollectorCost{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_tcpmon_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CollectorCost) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CollectorCost) ProtoMessage() {}
+
+func (x *CollectorCost) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_tcpmon_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CollectorCost.ProtoReflect.Descriptor instead.
+func (*CollectorCost) Descriptor() ([]byte, []int) {
+	return file_proto_tcpmon_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *CollectorCost) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CollectorCost) GetRuns() uint64 {
+	if x != nil {
+		return x.Runs
+	}
+	return 0
+}
+
+func (x *CollectorCost) GetFailures() uint64 {
+	if x != nil {
+		return x.Failures
+	}
+	return 0
+}
+
+func (x *CollectorCost) GetLastDurationUs() int64 {
+	if x != nil {
+		return x.LastDurationUs
+	}
+	return 0
+}
+
+func (x *CollectorCost) GetMaxDurationUs() int64 {
+	if x != nil {
+		return x.MaxDurationUs
+	}
+	return 0
+}
+
+func (x *CollectorCost) GetTotalDurationUs() int64 {
+	if x != nil {
+		return x.TotalDurationUs
+	}
+	return 0
+}
+
+func (x *CollectorCost) GetLastChildWallUs() int64 {
+	if x != nil {
+		return x.LastChildWallUs
+	}
+	return 0
+}
+
+func (x *CollectorCost) GetTotalChildWallUs() int64 {
+	if x != nil {
+		return x.TotalChildWallUs
+	}
+	return 0
+}
+
+// SelfMetric is the cost of tcpmon itself. Counters and durations are totals since tcpmon started.
+type SelfMetric struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// header
+	Timestamp int64      `protobuf:"varint,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Type      MetricType `protobuf:"varint,2,opt,name=type,proto3,enum=MetricType" json:"type,omitempty"`
+	// fields
+	UptimeS          int64            `protobuf:"varint,3,opt,name=uptime_s,json=uptimeS,proto3" json:"uptime_s,omitempty"`
+	Collectors       []*CollectorCost `protobuf:"bytes,4,rep,name=collectors,proto3" json:"collectors,omitempty"`
+	UserCpuUs        int64            `protobuf:"varint,5,opt,name=user_cpu_us,json=userCpuUs,proto3" json:"user_cpu_us,omitempty"` // CPU time of tcpmon
+	SystemCpuUs      int64            `protobuf:"varint,6,opt,name=system_cpu_us,json=systemCpuUs,proto3" json:"system_cpu_us,omitempty"`
+	ChildUserCpuUs   int64            `protobuf:"varint,7,opt,name=child_user_cpu_us,json=childUserCpuUs,proto3" json:"child_user_cpu_us,omitempty"` // CPU time of the exited child processes
+	ChildSystemCpuUs int64            `protobuf:"varint,8,opt,name=child_system_cpu_us,json=childSystemCpuUs,proto3" json:"child_system_cpu_us,omitempty"`
+	MaxRssKb         int64            `protobuf:"varint,9,opt,name=max_rss_kb,json=maxRssKb,proto3" json:"max_rss_kb,omitempty"`
+	// storage
+	RecordsWritten uint64 `protobuf:"varint,10,opt,name=records_written,json=recordsWritten,proto3" json:"records_written,omitempty"`
+	BytesWritten   uint64 `protobuf:"varint,11,opt,name=bytes_written,json=bytesWritten,proto3" json:"bytes_written,omitempty"` // records and their headers, before compression
+	Flushes        uint64 `protobuf:"varint,12,opt,name=flushes,proto3" json:"flushes,omitempty"`
+	FlushUs        int64  `protobuf:"varint,13,opt,name=flush_us,json=flushUs,proto3" json:"flush_us,omitempty"`
+	Seals          uint64 `protobuf:"varint,14,opt,name=seals,proto3" json:"seals,omitempty"`                                      // files compressed
+	CompressionUs  int64  `protobuf:"varint,15,opt,name=compression_us,json=compressionUs,proto3" json:"compression_us,omitempty"` // compressing sealed files only, not writing their indexes and segments
+	// go runtime
+	HeapAllocBytes uint64  `protobuf:"varint,16,opt,name=heap_alloc_bytes,json=heapAllocBytes,proto3" json:"heap_alloc_bytes,omitempty"`
+	HeapSysBytes   uint64  `protobuf:"varint,17,opt,name=heap_sys_bytes,json=heapSysBytes,proto3" json:"heap_sys_bytes,omitempty"`
+	SysBytes       uint64  `protobuf:"varint,18,opt,name=sys_bytes,json=sysBytes,proto3" json:"sys_bytes,omitempty"` // memory obtained from the OS
+	NumGc          uint32  `protobuf:"varint,19,opt,name=num_gc,json=numGc,proto3" json:"num_gc,omitempty"`
+	GcPauseTotalUs int64   `protobuf:"varint,20,opt,name=gc_pause_total_us,json=gcPauseTotalUs,proto3" json:"gc_pause_total_us,omitempty"`
+	GcCpuFraction  float64 `protobuf:"fixed64,21,opt,name=gc_cpu_fraction,json=gcCpuFraction,proto3" json:"gc_cpu_fraction,omitempty"`
+	Goroutines     uint32  `protobuf:"varint,22,opt,name=goroutines,proto3" json:"goroutines,omitempty"`
+}
+
+func (x *SelfMetric) Reset() {
+	*x = SelfMetric{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_tcpmon_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SelfMetric) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SelfMetric) ProtoMessage() {}
+
+func (x *SelfMetric) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_tcpmon_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SelfMetric.ProtoReflect.Descriptor instead.
+func (*SelfMetric) Descriptor() ([]byte, []int) {
+	return file_proto_tcpmon_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *SelfMetric) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *SelfMetric) GetType() MetricType {
+	if x != nil {
+		return x.Type
+	}
+	return MetricType_TCP
+}
+
+func (x *SelfMetric) GetUptimeS() int64 {
+	if x != nil {
+		return x.UptimeS
+	}
+	return 0
+}
+
+func (x *SelfMetric) GetCollectors() []*CollectorCost {
+	if x != nil {
+		return x.Collectors
+	}
+	return nil
+}
+
+func (x *SelfMetric) GetUserCpuUs() int64 {
+	if x != nil {
+		return x.UserCpuUs
+	}
+	return 0
+}
+
+func (x *SelfMetric) GetSystemCpuUs() int64 {
+	if x != nil {
+		return x.SystemCpuUs
+	}
+	return 0
+}
+
+func (x *SelfMetric) GetChildUserCpuUs() int64 {
+	if x != nil {
+		return x.ChildUserCpuUs
+	}
+	return 0
+}
+
+func (x *SelfMetric) GetChildSystemCpuUs() int64 {
+	if x != nil {
+		return x.ChildSystemCpuUs
+	}
+	return 0
+}
+
+func (x *SelfMetric) GetMaxRssKb() int64 {
+	if x != nil {
+		return x.MaxRssKb
+	}
+	return 0
+}
+
+func (x *SelfMetric) GetRecordsWritten() uint64 {
+	if x != nil {
+		return x.RecordsWritten
+	}
+	return 0
+}
+
+func (x *SelfMetric) GetBytesWritten() uint64 {
+	if x != nil {
+		return x.BytesWritten
+	}
+	return 0
+}
+
+func (x *SelfMetric) GetFlushes() uint64 {
+	if x != nil {
+		return x.Flushes
+	}
+	return 0
+}
+
+func (x *SelfMetric) GetFlushUs() int64 {
+	if x != nil {
+		return x.FlushUs
+	}
+	return 0
+}
+
+func (x *SelfMetric) GetSeals() uint64 {
+	if x != nil {
+		return x.Seals
+	}
+	return 0
+}
+
+func (x *SelfMetric) GetCompressionUs() int64 {
+	if x != nil {
+		return x.CompressionUs
+	}
+	return 0
+}
+
+func (x *SelfMetric) GetHeapAllocBytes() uint64 {
+	if x != nil {
+		return x.HeapAllocBytes
+	}
+	return 0
+}
+
+func (x *SelfMetric) GetHeapSysBytes() uint64 {
+	if x != nil {
+		return x.HeapSysBytes
+	}
+	return 0
+}
+
+func (x *SelfMetric) GetSysBytes() uint64 {
+	if x != nil {
+		return x.SysBytes
+	}
+	return 0
+}
+
+func (x *SelfMetric) GetNumGc() uint32 {
+	if x != nil {
+		return x.NumGc
+	}
+	return 0
+}
+
+func (x *SelfMetric) GetGcPauseTotalUs() int64 {
+	if x != nil {
+		return x.GcPauseTotalUs
+	}
+	return 0
+}
+
+func (x *SelfMetric) GetGcCpuFraction() float64 {
+	if x != nil {
+		return x.GcCpuFraction
+	}
+	return 0
+}
+
+func (x *SelfMetric) GetGoroutines() uint32 {
+	if x != nil {
+		return x.Goroutines
+	}
+	return 0
+}
+
+var File_proto_tcpmon_proto protoreflect.FileDescriptor
+
+var file_proto_tcpmon_proto_rawDesc = []byte{
+	0x0a, 0x12, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x74, 0x63, 0x70, 0x6d, 0x6f, 0x6e, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x22, 0xb1, 0x02, 0x0a, 0x06, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x12,
+	0x1e, 0x0a, 0x03, 0x74, 0x63, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x54,
+	0x63, 0x70, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x48, 0x00, 0x52, 0x03, 0x74, 0x63, 0x70, 0x12,
+	0x1e, 0x0a, 0x03, 0x6e, 0x69, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x4e,
+	0x69, 0x63, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x48, 0x00, 0x52, 0x03, 0x6e, 0x69, 0x63, 0x12,
+	0x22, 0x0a, 0x03, 0x6e, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x4e,
+	0x65, 0x74, 0x73, 0x74, 0x61, 0x74, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x48, 0x00, 0x52, 0x03,
+	0x6e, 0x65, 0x74, 0x12, 0x1f, 0x0a, 0x04, 0x68, 0x6f, 0x73, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x09, 0x2e, 0x48, 0x6f, 0x73, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x48, 0x00, 0x52, 0x04,
+	0x68, 0x6f, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x4d, 0x65, 0x74, 0x72, 0x69,
+	0x63, 0x48, 0x00, 0x52, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x28, 0x0a, 0x09, 0x74, 0x63,
+	0x70, 0x5f, 0x64, 0x65, 0x6c, 0x74, 0x61, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e,
+	0x54, 0x63, 0x70, 0x44, 0x65, 0x6c, 0x74, 0x61, 0x48, 0x00, 0x52, 0x08, 0x74, 0x63, 0x70, 0x44,
+	0x65, 0x6c, 0x74, 0x61, 0x12, 0x27, 0x0a, 0x06, 0x72, 0x6f, 0x6c, 0x6c, 0x75, 0x70, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x52, 0x6f, 0x6c, 0x6c, 0x75, 0x70, 0x4d, 0x65, 0x74,
+	0x72, 0x69, 0x63, 0x48, 0x00, 0x52, 0x06, 0x72, 0x6f, 0x6c, 0x6c, 0x75, 0x70, 0x12, 0x21, 0x0a,
+	0x04, 0x73, 0x65, 0x6c, 0x66, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x53, 0x65,
+	0x6c, 0x66, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x48, 0x00, 0x52, 0x04, 0x73, 0x65, 0x6c, 0x66,
+	0x42, 0x06, 0x0a, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x22, 0x92, 0x02, 0x0a, 0x11, 0x53, 0x6f, 0x63,
+	0x6b, 0x65, 0x74, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x55, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1d,
+	0x0a, 0x0a, 0x72, 0x6d, 0x65, 0x6d, 0x5f, 0x61, 0x6c, 0x6c, 0x6f, 0x63, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x09, 0x72, 0x6d, 0x65, 0x6d, 0x41, 0x6c, 0x6c, 0x6f, 0x63, 0x12, 0x17, 0x0a,
+	0x07, 0x72, 0x63, 0x76, 0x5f, 0x62, 0x75, 0x66, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06,
+	0x72, 0x63, 0x76, 0x42, 0x75, 0x66, 0x12, 0x1d, 0x0a, 0x0a, 0x77, 0x6d, 0x65, 0x6d, 0x5f, 0x61,
+	0x6c, 0x6c, 0x6f, 0x63, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x77, 0x6d, 0x65, 0x6d,
+	0x41, 0x6c, 0x6c, 0x6f, 0x63, 0x12, 0x17, 0x0a, 0x07, 0x73, 0x6e, 0x64, 0x5f, 0x62, 0x75, 0x66,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x73, 0x6e, 0x64, 0x42, 0x75, 0x66, 0x12, 0x1b,
+	0x0a, 0x09, 0x66, 0x77, 0x64, 0x5f, 0x61, 0x6c, 0x6c, 0x6f, 0x63, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x08, 0x66, 0x77, 0x64, 0x41, 0x6c, 0x6c, 0x6f, 0x63, 0x12, 0x1f, 0x0a, 0x0b, 0x77,
+	0x6d, 0x65, 0x6d, 0x5f, 0x71, 0x75, 0x65, 0x75, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x0a, 0x77, 0x6d, 0x65, 0x6d, 0x51, 0x75, 0x65, 0x75, 0x65, 0x64, 0x12, 0x17, 0x0a, 0x07,
+	0x6f, 0x70, 0x74, 0x5f, 0x6d, 0x65, 0x6d, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x6f,
+	0x70, 0x74, 0x4d, 0x65, 0x6d, 0x12, 0x19, 0x0a, 0x08, 0x62, 0x61, 0x63, 0x6b, 0x5f, 0x6c, 0x6f,
+	0x67, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x62, 0x61, 0x63, 0x6b, 0x4c, 0x6f, 0x67,
+	0x12, 0x1b, 0x0a, 0x09, 0x73, 0x6f, 0x63, 0x6b, 0x5f, 0x64, 0x72, 0x6f, 0x70, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x08, 0x73, 0x6f, 0x63, 0x6b, 0x44, 0x72, 0x6f, 0x70, 0x22, 0x5f, 0x0a,
+	0x09, 0x54, 0x69, 0x6d, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x24,
+	0x0a, 0x0e, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x75, 0x73,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x54, 0x69,
+	0x6d, 0x65, 0x55, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x72, 0x65, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x22, 0x43,
+	0x0a, 0x0b, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x10, 0x0a, 0x03, 0x70, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03,
+	0x70, 0x69, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x66, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x02, 0x66, 0x64, 0x22, 0xad, 0x0b, 0x0a, 0x0c, 0x53, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x4d, 0x65,
+	0x74, 0x72, 0x69, 0x63, 0x12, 0x22, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x0c, 0x2e, 0x53, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74,
+	0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x15, 0x0a, 0x06, 0x72, 0x65, 0x63, 0x76,
+	0x5f, 0x71, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x72, 0x65, 0x63, 0x76, 0x51, 0x12,
+	0x15, 0x0a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x5f, 0x71, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x05, 0x73, 0x65, 0x6e, 0x64, 0x51, 0x12, 0x1d, 0x0a, 0x0a, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f,
+	0x61, 0x64, 0x64, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6c, 0x6f, 0x63, 0x61,
+	0x6c, 0x41, 0x64, 0x64, 0x72, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x65, 0x65, 0x72, 0x5f, 0x61, 0x64,
+	0x64, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x65, 0x65, 0x72, 0x41, 0x64,
+	0x64, 0x72, 0x12, 0x2a, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x65, 0x73, 0x18,
+	0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x49,
+	0x6e, 0x66, 0x6f, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x65, 0x73, 0x12, 0x22,
+	0x0a, 0x06, 0x74, 0x69, 0x6d, 0x65, 0x72, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0a,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x06, 0x74, 0x69, 0x6d, 0x65,
+	0x72, 0x73, 0x12, 0x28, 0x0a, 0x05, 0x73, 0x6b, 0x6d, 0x65, 0x6d, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x12, 0x2e, 0x53, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79,
+	0x55, 0x73, 0x61, 0x67, 0x65, 0x52, 0x05, 0x73, 0x6b, 0x6d, 0x65, 0x6d, 0x12, 0x0e, 0x0a, 0x02,
+	0x74, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x02, 0x74, 0x73, 0x12, 0x12, 0x0a, 0x04,
+	0x73, 0x61, 0x63, 0x6b, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x73, 0x61, 0x63, 0x6b,
+	0x12, 0x14, 0x0a, 0x05, 0x63, 0x75, 0x62, 0x69, 0x63, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x05, 0x63, 0x75, 0x62, 0x69, 0x63, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x70, 0x70, 0x5f, 0x6c, 0x69,
+	0x6d, 0x69, 0x74, 0x65, 0x64, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x61, 0x70, 0x70,
+	0x4c, 0x69, 0x6d, 0x69, 0x74, 0x65, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x61, 0x63, 0x69, 0x6e,
+	0x67, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x70, 0x61,
+	0x63, 0x69, 0x6e, 0x67, 0x52, 0x61, 0x74, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x64, 0x65, 0x6c, 0x69,
+	0x76, 0x65, 0x72, 0x79, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x10, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x0c, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x52, 0x61, 0x74, 0x65, 0x12, 0x12, 0x0a,
+	0x04, 0x73, 0x65, 0x6e, 0x64, 0x18, 0x11, 0x20, 0x01, 0x28, 0x01, 0x52, 0x04, 0x73, 0x65, 0x6e,
+	0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x6e, 0x64, 0x5f, 0x77, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x18,
+	0x12, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x73, 0x6e, 0x64, 0x57, 0x73, 0x63, 0x61, 0x6c, 0x65,
+	0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x63, 0x76, 0x5f, 0x77, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x18, 0x13,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x72, 0x63, 0x76, 0x57, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x12,
+	0x10, 0x0a, 0x03, 0x72, 0x74, 0x6f, 0x18, 0x14, 0x20, 0x01, 0x28, 0x01, 0x52, 0x03, 0x72, 0x74,
+	0x6f, 0x12, 0x10, 0x0a, 0x03, 0x72, 0x74, 0x74, 0x18, 0x15, 0x20, 0x01, 0x28, 0x01, 0x52, 0x03,
+	0x72, 0x74, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x74, 0x74, 0x76, 0x61, 0x72, 0x18, 0x16, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x06, 0x72, 0x74, 0x74, 0x76, 0x61, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x6d,
+	0x69, 0x6e, 0x72, 0x74, 0x74, 0x18, 0x17, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x6d, 0x69, 0x6e,
+	0x72, 0x74, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x72, 0x63, 0x76, 0x5f, 0x72, 0x74, 0x74, 0x18, 0x18,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x72, 0x63, 0x76, 0x52, 0x74, 0x74, 0x12, 0x1f, 0x0a, 0x0b,
+	0x72, 0x65, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x5f, 0x6e, 0x6f, 0x77, 0x18, 0x19, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x0a, 0x72, 0x65, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x4e, 0x6f, 0x77, 0x12, 0x23, 0x0a,
+	0x0d, 0x72, 0x65, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x1a,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x72, 0x65, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x54, 0x6f, 0x74,
+	0x61, 0x6c, 0x12, 0x10, 0x0a, 0x03, 0x61, 0x74, 0x6f, 0x18, 0x1e, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x03, 0x61, 0x74, 0x6f, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x73, 0x73, 0x18, 0x1f, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x03, 0x6d, 0x73, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x6d, 0x74, 0x75, 0x18, 0x20,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x70, 0x6d, 0x74, 0x75, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x63,
+	0x76, 0x6d, 0x73, 0x73, 0x18, 0x21, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x72, 0x63, 0x76, 0x6d,
+	0x73, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x64, 0x76, 0x6d, 0x73, 0x73, 0x18, 0x22, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x06, 0x61, 0x64, 0x76, 0x6d, 0x73, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x77,
+	0x6e, 0x64, 0x18, 0x23, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x63, 0x77, 0x6e, 0x64, 0x12, 0x17,
+	0x0a, 0x07, 0x73, 0x6e, 0x64, 0x5f, 0x77, 0x6e, 0x64, 0x18, 0x24, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x06, 0x73, 0x6e, 0x64, 0x57, 0x6e, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x79, 0x74, 0x65, 0x73,
+	0x5f, 0x73, 0x65, 0x6e, 0x74, 0x18, 0x25, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x62, 0x79, 0x74,
+	0x65, 0x73, 0x53, 0x65, 0x6e, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x62, 0x79, 0x74, 0x65, 0x73, 0x5f,
+	0x61, 0x63, 0x6b, 0x65, 0x64, 0x18, 0x26, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x62, 0x79, 0x74,
+	0x65, 0x73, 0x41, 0x63, 0x6b, 0x65, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x62, 0x79, 0x74, 0x65, 0x73,
+	0x5f, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x18, 0x27, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0d, 0x62, 0x79, 0x74, 0x65, 0x73, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x12, 0x19,
+	0x0a, 0x08, 0x73, 0x65, 0x67, 0x73, 0x5f, 0x6f, 0x75, 0x74, 0x18, 0x28, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x07, 0x73, 0x65, 0x67, 0x73, 0x4f, 0x75, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x73, 0x65, 0x67,
+	0x73, 0x5f, 0x69, 0x6e, 0x18, 0x29, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x73, 0x65, 0x67, 0x73,
+	0x49, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x6c, 0x61, 0x73, 0x74, 0x73, 0x6e, 0x64, 0x18, 0x2a, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x07, 0x6c, 0x61, 0x73, 0x74, 0x73, 0x6e, 0x64, 0x12, 0x18, 0x0a, 0x07,
+	0x6c, 0x61, 0x73, 0x74, 0x72, 0x63, 0x76, 0x18, 0x2b, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x6c,
+	0x61, 0x73, 0x74, 0x72, 0x63, 0x76, 0x12, 0x18, 0x0a, 0x07, 0x6c, 0x61, 0x73, 0x74, 0x61, 0x63,
+	0x6b, 0x18, 0x2c, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x6c, 0x61, 0x73, 0x74, 0x61, 0x63, 0x6b,
+	0x12, 0x1c, 0x0a, 0x09, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x65, 0x64, 0x18, 0x2d, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x09, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x65, 0x64, 0x12, 0x17,
+	0x0a, 0x07, 0x62, 0x75, 0x73, 0x79, 0x5f, 0x6d, 0x73, 0x18, 0x2e, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x06, 0x62, 0x75, 0x73, 0x79, 0x4d, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x63, 0x76, 0x5f, 0x73,
+	0x70, 0x61, 0x63, 0x65, 0x18, 0x2f, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x72, 0x63, 0x76, 0x53,
+	0x70, 0x61, 0x63, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x63, 0x76, 0x5f, 0x73, 0x73, 0x74, 0x68,
+	0x72, 0x65, 0x73, 0x68, 0x18, 0x30, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x72, 0x63, 0x76, 0x53,
+	0x73, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x12, 0x22, 0x0a, 0x0d, 0x64, 0x61, 0x74, 0x61, 0x5f,
+	0x73, 0x65, 0x67, 0x73, 0x5f, 0x6f, 0x75, 0x74, 0x18, 0x31, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b,
+	0x64, 0x61, 0x74, 0x61, 0x53, 0x65, 0x67, 0x73, 0x4f, 0x75, 0x74, 0x12, 0x20, 0x0a, 0x0c, 0x64,
+	0x61, 0x74, 0x61, 0x5f, 0x73, 0x65, 0x67, 0x73, 0x5f, 0x69, 0x6e, 0x18, 0x32, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x0a, 0x64, 0x61, 0x74, 0x61, 0x53, 0x65, 0x67, 0x73, 0x49, 0x6e, 0x12, 0x21, 0x0a,
+	0x0c, 0x72, 0x77, 0x6e, 0x64, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x65, 0x64, 0x18, 0x33, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x0b, 0x72, 0x77, 0x6e, 0x64, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x65, 0x64,
+	0x12, 0x25, 0x0a, 0x0e, 0x73, 0x6e, 0x64, 0x62, 0x75, 0x66, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74,
+	0x65, 0x64, 0x18, 0x34, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x73, 0x6e, 0x64, 0x62, 0x75, 0x66,
+	0x4c, 0x69, 0x6d, 0x69, 0x74, 0x65, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x65, 0x63, 0x6e, 0x18, 0x35,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x03, 0x65, 0x63, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x63, 0x6e,
+	0x73, 0x65, 0x65, 0x6e, 0x18, 0x36, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x63, 0x6e, 0x73,
+	0x65, 0x65, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x6f, 0x6f, 0x6b, 0x69, 0x65, 0x18, 0x37, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x06, 0x63, 0x6f, 0x6f, 0x6b, 0x69, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x69,
+	0x6e, 0x6f, 0x64, 0x65, 0x18, 0x38, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x69, 0x6e, 0x6f, 0x64,
+	0x65, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x39, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03,
+	0x75, 0x69, 0x64, 0x22, 0x73, 0x0a, 0x09, 0x54, 0x63, 0x70, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63,
+	0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x1f,
+	0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0b, 0x2e, 0x4d,
+	0x65, 0x74, 0x72, 0x69, 0x63, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12,
+	0x27, 0x0a, 0x07, 0x73, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x0d, 0x2e, 0x53, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x52,
+	0x07, 0x73, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x22, 0xa5, 0x01, 0x0a, 0x0b, 0x53, 0x6f, 0x63,
+	0x6b, 0x65, 0x74, 0x44, 0x65, 0x6c, 0x74, 0x61, 0x12, 0x10, 0x0a, 0x03, 0x6e, 0x65, 0x77, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x03, 0x6e, 0x65, 0x77, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x6b,
+	0x69, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x11, 0x52, 0x04, 0x73, 0x6b, 0x69, 0x70, 0x12, 0x27,
+	0x0a, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0d, 0x2e, 0x53, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x52, 0x07,
+	0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x12, 0x2d, 0x0a, 0x0a, 0x69, 0x6e, 0x63, 0x72, 0x65,
+	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x53, 0x6f,
+	0x63, 0x6b, 0x65, 0x74, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x52, 0x0a, 0x69, 0x6e, 0x63, 0x72,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6c, 0x65, 0x61, 0x72, 0x65,
+	0x64, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0d, 0x52, 0x07, 0x63, 0x6c, 0x65, 0x61, 0x72, 0x65, 0x64,
+	0x22, 0xaa, 0x01, 0x0a, 0x08, 0x54, 0x63, 0x70, 0x44, 0x65, 0x6c, 0x74, 0x61, 0x12, 0x1c, 0x0a,
+	0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x1f, 0x0a, 0x04, 0x74,
+	0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0b, 0x2e, 0x4d, 0x65, 0x74, 0x72,
+	0x69, 0x63, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x26, 0x0a, 0x07,
+	0x73, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e,
+	0x53, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x44, 0x65, 0x6c, 0x74, 0x61, 0x52, 0x07, 0x73, 0x6f, 0x63,
+	0x6b, 0x65, 0x74, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x74, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x62, 0x61,
+	0x73, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x10, 0x0a, 0x03, 0x73,
+	0x65, 0x71, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x73, 0x65, 0x71, 0x22, 0xba, 0x02,
+	0x0a, 0x0b, 0x49, 0x66, 0x61, 0x63, 0x65, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x78, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x72, 0x78, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x12, 0x1d,
+	0x0a, 0x0a, 0x72, 0x78, 0x5f, 0x64, 0x72, 0x6f, 0x70, 0x70, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x09, 0x72, 0x78, 0x44, 0x72, 0x6f, 0x70, 0x70, 0x65, 0x64, 0x12, 0x1f, 0x0a,
+	0x0b, 0x72, 0x78, 0x5f, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x75, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0a, 0x72, 0x78, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x75, 0x6e, 0x73, 0x12, 0x19,
+	0x0a, 0x08, 0x72, 0x78, 0x5f, 0x66, 0x72, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x07, 0x72, 0x78, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x78, 0x5f,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x74, 0x78,
+	0x45, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x78, 0x5f, 0x64, 0x72, 0x6f,
+	0x70, 0x70, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x74, 0x78, 0x44, 0x72,
+	0x6f, 0x70, 0x70, 0x65, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x78, 0x5f, 0x6f, 0x76, 0x65, 0x72,
+	0x72, 0x75, 0x6e, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x74, 0x78, 0x4f, 0x76,
+	0x65, 0x72, 0x72, 0x75, 0x6e, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x78, 0x5f, 0x63, 0x61, 0x72,
+	0x72, 0x69, 0x65, 0x72, 0x18, 0x09, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x74, 0x78, 0x43, 0x61,
+	0x72, 0x72, 0x69, 0x65, 0x72, 0x12, 0x23, 0x0a, 0x0d, 0x74, 0x78, 0x5f, 0x63, 0x6f, 0x6c, 0x6c,
+	0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x74, 0x78,
+	0x43, 0x6f, 0x6c, 0x6c, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x70, 0x0a, 0x09, 0x4e, 0x69,
+	0x63, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x1f, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x0b, 0x2e, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x54, 0x79, 0x70, 0x65,
+	0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x24, 0x0a, 0x06, 0x69, 0x66, 0x61, 0x63, 0x65, 0x73,
+	0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x49, 0x66, 0x61, 0x63, 0x65, 0x4d, 0x65,
+	0x74, 0x72, 0x69, 0x63, 0x52, 0x06, 0x69, 0x66, 0x61, 0x63, 0x65, 0x73, 0x22, 0xf2, 0x4d, 0x0a,
+	0x0d, 0x4e, 0x65, 0x74, 0x73, 0x74, 0x61, 0x74, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x12, 0x1c,
+	0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x1f, 0x0a, 0x04,
+	0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0b, 0x2e, 0x4d, 0x65, 0x74,
+	0x72, 0x69, 0x63, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x23, 0x0a,
+	0x0d, 0x69, 0x70, 0x5f, 0x66, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x64,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x69, 0x70, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x69,
+	0x6e, 0x67, 0x12, 0x24, 0x0a, 0x0e, 0x69, 0x70, 0x5f, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74,
+	0x5f, 0x74, 0x74, 0x6c, 0x18, 0x65, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x69, 0x70, 0x44, 0x65,
+	0x66, 0x61, 0x75, 0x6c, 0x74, 0x54, 0x74, 0x6c, 0x12, 0x24, 0x0a, 0x0e, 0x69, 0x70, 0x5f, 0x69,
+	0x6e, 0x5f, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x73, 0x18, 0x66, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0c, 0x69, 0x70, 0x49, 0x6e, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x73, 0x12, 0x27,
+	0x0a, 0x10, 0x69, 0x70, 0x5f, 0x69, 0x6e, 0x5f, 0x68, 0x64, 0x72, 0x5f, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x73, 0x18, 0x67, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x69, 0x70, 0x49, 0x6e, 0x48, 0x64,
+	0x72, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x12, 0x29, 0x0a, 0x11, 0x69, 0x70, 0x5f, 0x69, 0x6e,
+	0x5f, 0x61, 0x64, 0x64, 0x72, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x68, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0e, 0x69, 0x70, 0x49, 0x6e, 0x41, 0x64, 0x64, 0x72, 0x45, 0x72, 0x72, 0x6f,
+	0x72, 0x73, 0x12, 0x2a, 0x0a, 0x11, 0x69, 0x70, 0x5f, 0x66, 0x6f, 0x72, 0x77, 0x5f, 0x64, 0x61,
+	0x74, 0x61, 0x67, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x69, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x69,
+	0x70, 0x46, 0x6f, 0x72, 0x77, 0x44, 0x61, 0x74, 0x61, 0x67, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x2f,
+	0x0a, 0x14, 0x69, 0x70, 0x5f, 0x69, 0x6e, 0x5f, 0x75, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x5f,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x18, 0x6a, 0x20, 0x01, 0x28, 0x04, 0x52, 0x11, 0x69, 0x70,
+	0x49, 0x6e, 0x55, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x12,
+	0x24, 0x0a, 0x0e, 0x69, 0x70, 0x5f, 0x69, 0x6e, 0x5f, 0x64, 0x69, 0x73, 0x63, 0x61, 0x72, 0x64,
+	0x73, 0x18, 0x6b, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x69, 0x70, 0x49, 0x6e, 0x44, 0x69, 0x73,
+	0x63, 0x61, 0x72, 0x64, 0x73, 0x12, 0x24, 0x0a, 0x0e, 0x69, 0x70, 0x5f, 0x69, 0x6e, 0x5f, 0x64,
+	0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x73, 0x18, 0x6c, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x69,
+	0x70, 0x49, 0x6e, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x69,
+	0x70, 0x5f, 0x6f, 0x75, 0x74, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x18, 0x6d,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x69, 0x70, 0x4f, 0x75, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x69, 0x70, 0x5f, 0x6f, 0x75, 0x74, 0x5f, 0x64, 0x69,
+	0x73, 0x63, 0x61, 0x72, 0x64, 0x73, 0x18, 0x6e, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x69, 0x70,
+	0x4f, 0x75, 0x74, 0x44, 0x69, 0x73, 0x63, 0x61, 0x72, 0x64, 0x73, 0x12, 0x27, 0x0a, 0x10, 0x69,
+	0x70, 0x5f, 0x6f, 0x75, 0x74, 0x5f, 0x6e, 0x6f, 0x5f, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x18,
+	0x6f, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x69, 0x70, 0x4f, 0x75, 0x74, 0x4e, 0x6f, 0x52, 0x6f,
+	0x75, 0x74, 0x65, 0x73, 0x12, 0x28, 0x0a, 0x10, 0x69, 0x70, 0x5f, 0x72, 0x65, 0x61, 0x73, 0x6d,
+	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x70, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e,
+	0x69, 0x70, 0x52, 0x65, 0x61, 0x73, 0x6d, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x24,
+	0x0a, 0x0e, 0x69, 0x70, 0x5f, 0x72, 0x65, 0x61, 0x73, 0x6d, 0x5f, 0x72, 0x65, 0x71, 0x64, 0x73,
+	0x18, 0x71, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x69, 0x70, 0x52, 0x65, 0x61, 0x73, 0x6d, 0x52,
+	0x65, 0x71, 0x64, 0x73, 0x12, 0x20, 0x0a, 0x0c, 0x69, 0x70, 0x5f, 0x72, 0x65, 0x61, 0x73, 0x6d,
+	0x5f, 0x6f, 0x6b, 0x73, 0x18, 0x72, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x69, 0x70, 0x52, 0x65,
+	0x61, 0x73, 0x6d, 0x4f, 0x6b, 0x73, 0x12, 0x24, 0x0a, 0x0e, 0x69, 0x70, 0x5f, 0x72, 0x65, 0x61,
+	0x73, 0x6d, 0x5f, 0x66, 0x61, 0x69, 0x6c, 0x73, 0x18, 0x73, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c,
+	0x69, 0x70, 0x52, 0x65, 0x61, 0x73, 0x6d, 0x46, 0x61, 0x69, 0x6c, 0x73, 0x12, 0x1e, 0x0a, 0x0b,
+	0x69, 0x70, 0x5f, 0x66, 0x72, 0x61, 0x67, 0x5f, 0x6f, 0x6b, 0x73, 0x18, 0x74, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x09, 0x69, 0x70, 0x46, 0x72, 0x61, 0x67, 0x4f, 0x6b, 0x73, 0x12, 0x22, 0x0a, 0x0d,
+	0x69, 0x70, 0x5f, 0x66, 0x72, 0x61, 0x67, 0x5f, 0x66, 0x61, 0x69, 0x6c, 0x73, 0x18, 0x75, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0b, 0x69, 0x70, 0x46, 0x72, 0x61, 0x67, 0x46, 0x61, 0x69, 0x6c, 0x73,
+	0x12, 0x26, 0x0a, 0x0f, 0x69, 0x70, 0x5f, 0x66, 0x72, 0x61, 0x67, 0x5f, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x73, 0x18, 0x76, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x69, 0x70, 0x46, 0x72, 0x61,
+	0x67, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x73, 0x12, 0x28, 0x0a, 0x10, 0x69, 0x70, 0x5f, 0x6f,
+	0x75, 0x74, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x6d, 0x69, 0x74, 0x73, 0x18, 0x77, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0e, 0x69, 0x70, 0x4f, 0x75, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6d, 0x69,
+	0x74, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x69, 0x70, 0x5f, 0x69, 0x6e, 0x5f, 0x6e, 0x6f, 0x5f, 0x72,
+	0x6f, 0x75, 0x74, 0x65, 0x73, 0x18, 0xd8, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x69, 0x70,
+	0x49, 0x6e, 0x4e, 0x6f, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x12, 0x30, 0x0a, 0x14, 0x69, 0x70,
+	0x5f, 0x69, 0x6e, 0x5f, 0x74, 0x72, 0x75, 0x6e, 0x63, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x70, 0x6b,
+	0x74, 0x73, 0x18, 0xd9, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x11, 0x69, 0x70, 0x49, 0x6e, 0x54,
+	0x72, 0x75, 0x6e, 0x63, 0x61, 0x74, 0x65, 0x64, 0x50, 0x6b, 0x74, 0x73, 0x12, 0x28, 0x0a, 0x10,
+	0x69, 0x70, 0x5f, 0x69, 0x6e, 0x5f, 0x6d, 0x63, 0x61, 0x73, 0x74, 0x5f, 0x70, 0x6b, 0x74, 0x73,
+	0x18, 0xda, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x69, 0x70, 0x49, 0x6e, 0x4d, 0x63, 0x61,
+	0x73, 0x74, 0x50, 0x6b, 0x74, 0x73, 0x12, 0x2a, 0x0a, 0x11, 0x69, 0x70, 0x5f, 0x6f, 0x75, 0x74,
+	0x5f, 0x6d, 0x63, 0x61, 0x73, 0x74, 0x5f, 0x70, 0x6b, 0x74, 0x73, 0x18, 0xdb, 0x04, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0e, 0x69, 0x70, 0x4f, 0x75, 0x74, 0x4d, 0x63, 0x61, 0x73, 0x74, 0x50, 0x6b,
+	0x74, 0x73, 0x12, 0x28, 0x0a, 0x10, 0x69, 0x70, 0x5f, 0x69, 0x6e, 0x5f, 0x62, 0x63, 0x61, 0x73,
+	0x74, 0x5f, 0x70, 0x6b, 0x74, 0x73, 0x18, 0xdc, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x69,
+	0x70, 0x49, 0x6e, 0x42, 0x63, 0x61, 0x73, 0x74, 0x50, 0x6b, 0x74, 0x73, 0x12, 0x2a, 0x0a, 0x11,
+	0x69, 0x70, 0x5f, 0x6f, 0x75, 0x74, 0x5f, 0x62, 0x63, 0x61, 0x73, 0x74, 0x5f, 0x70, 0x6b, 0x74,
+	0x73, 0x18, 0xdd, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x69, 0x70, 0x4f, 0x75, 0x74, 0x42,
+	0x63, 0x61, 0x73, 0x74, 0x50, 0x6b, 0x74, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x69, 0x70, 0x5f, 0x69,
+	0x6e, 0x5f, 0x6f, 0x63, 0x74, 0x65, 0x74, 0x73, 0x18, 0xde, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0a, 0x69, 0x70, 0x49, 0x6e, 0x4f, 0x63, 0x74, 0x65, 0x74, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x69,
+	0x70, 0x5f, 0x6f, 0x75, 0x74, 0x5f, 0x6f, 0x63, 0x74, 0x65, 0x74, 0x73, 0x18, 0xdf, 0x04, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0b, 0x69, 0x70, 0x4f, 0x75, 0x74, 0x4f, 0x63, 0x74, 0x65, 0x74, 0x73,
+	0x12, 0x2c, 0x0a, 0x12, 0x69, 0x70, 0x5f, 0x69, 0x6e, 0x5f, 0x6d, 0x63, 0x61, 0x73, 0x74, 0x5f,
+	0x6f, 0x63, 0x74, 0x65, 0x74, 0x73, 0x18, 0xe0, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x69,
+	0x70, 0x49, 0x6e, 0x4d, 0x63, 0x61, 0x73, 0x74, 0x4f, 0x63, 0x74, 0x65, 0x74, 0x73, 0x12, 0x2e,
+	0x0a, 0x13, 0x69, 0x70, 0x5f, 0x6f, 0x75, 0x74, 0x5f, 0x6d, 0x63, 0x61, 0x73, 0x74, 0x5f, 0x6f,
+	0x63, 0x74, 0x65, 0x74, 0x73, 0x18, 0xe1, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x69, 0x70,
+	0x4f, 0x75, 0x74, 0x4d, 0x63, 0x61, 0x73, 0x74, 0x4f, 0x63, 0x74, 0x65, 0x74, 0x73, 0x12, 0x2c,
+	0x0a, 0x12, 0x69, 0x70, 0x5f, 0x69, 0x6e, 0x5f, 0x62, 0x63, 0x61, 0x73, 0x74, 0x5f, 0x6f, 0x63,
+	0x74, 0x65, 0x74, 0x73, 0x18, 0xe2, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x69, 0x70, 0x49,
+	0x6e, 0x42, 0x63, 0x61, 0x73, 0x74, 0x4f, 0x63, 0x74, 0x65, 0x74, 0x73, 0x12, 0x2e, 0x0a, 0x13,
+	0x69, 0x70, 0x5f, 0x6f, 0x75, 0x74, 0x5f, 0x62, 0x63, 0x61, 0x73, 0x74, 0x5f, 0x6f, 0x63, 0x74,
+	0x65, 0x74, 0x73, 0x18, 0xe3, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x69, 0x70, 0x4f, 0x75,
+	0x74, 0x42, 0x63, 0x61, 0x73, 0x74, 0x4f, 0x63, 0x74, 0x65, 0x74, 0x73, 0x12, 0x2a, 0x0a, 0x11,
+	0x69, 0x70, 0x5f, 0x69, 0x6e, 0x5f, 0x63, 0x73, 0x75, 0x6d, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x73, 0x18, 0xe4, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x69, 0x70, 0x49, 0x6e, 0x43, 0x73,
+	0x75, 0x6d, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x12, 0x29, 0x0a, 0x11, 0x69, 0x70, 0x5f, 0x69,
+	0x6e, 0x5f, 0x6e, 0x6f, 0x5f, 0x65, 0x63, 0x74, 0x5f, 0x70, 0x6b, 0x74, 0x73, 0x18, 0xe5, 0x04,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x69, 0x70, 0x49, 0x6e, 0x4e, 0x6f, 0x45, 0x63, 0x74, 0x50,
+	0x6b, 0x74, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x69, 0x70, 0x5f, 0x69, 0x6e, 0x5f, 0x65, 0x63, 0x74,
+	0x31, 0x5f, 0x70, 0x6b, 0x74, 0x73, 0x18, 0xe6, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x69,
+	0x70, 0x49, 0x6e, 0x45, 0x63, 0x74, 0x31, 0x50, 0x6b, 0x74, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x69,
+	0x70, 0x5f, 0x69, 0x6e, 0x5f, 0x65, 0x63, 0x74, 0x30, 0x5f, 0x70, 0x6b, 0x74, 0x73, 0x18, 0xe7,
+	0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x69, 0x70, 0x49, 0x6e, 0x45, 0x63, 0x74, 0x30, 0x50,
+	0x6b, 0x74, 0x73, 0x12, 0x22, 0x0a, 0x0d, 0x69, 0x70, 0x5f, 0x69, 0x6e, 0x5f, 0x63, 0x65, 0x5f,
+	0x70, 0x6b, 0x74, 0x73, 0x18, 0xe8, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x69, 0x70, 0x49,
+	0x6e, 0x43, 0x65, 0x50, 0x6b, 0x74, 0x73, 0x12, 0x2b, 0x0a, 0x11, 0x69, 0x70, 0x5f, 0x72, 0x65,
+	0x61, 0x73, 0x6d, 0x5f, 0x6f, 0x76, 0x65, 0x72, 0x6c, 0x61, 0x70, 0x73, 0x18, 0xe9, 0x04, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0f, 0x69, 0x70, 0x52, 0x65, 0x61, 0x73, 0x6d, 0x4f, 0x76, 0x65, 0x72,
+	0x6c, 0x61, 0x70, 0x73, 0x12, 0x29, 0x0a, 0x10, 0x75, 0x64, 0x70, 0x5f, 0x69, 0x6e, 0x5f, 0x64,
+	0x61, 0x74, 0x61, 0x67, 0x72, 0x61, 0x6d, 0x73, 0x18, 0xc8, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0e, 0x75, 0x64, 0x70, 0x49, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x67, 0x72, 0x61, 0x6d, 0x73, 0x12,
+	0x21, 0x0a, 0x0c, 0x75, 0x64, 0x70, 0x5f, 0x6e, 0x6f, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x18,
+	0xc9, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x75, 0x64, 0x70, 0x4e, 0x6f, 0x50, 0x6f, 0x72,
+	0x74, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x75, 0x64, 0x70, 0x5f, 0x69, 0x6e, 0x5f, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x73, 0x18, 0xca, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x75, 0x64, 0x70, 0x49,
+	0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x12, 0x2b, 0x0a, 0x11, 0x75, 0x64, 0x70, 0x5f, 0x6f,
+	0x75, 0x74, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x67, 0x72, 0x61, 0x6d, 0x73, 0x18, 0xcb, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0f, 0x75, 0x64, 0x70, 0x4f, 0x75, 0x74, 0x44, 0x61, 0x74, 0x61, 0x67,
+	0x72, 0x61, 0x6d, 0x73, 0x12, 0x2b, 0x0a, 0x11, 0x75, 0x64, 0x70, 0x5f, 0x72, 0x63, 0x76, 0x62,
+	0x75, 0x66, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0xcc, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0f, 0x75, 0x64, 0x70, 0x52, 0x63, 0x76, 0x62, 0x75, 0x66, 0x45, 0x72, 0x72, 0x6f, 0x72,
+	0x73, 0x12, 0x2b, 0x0a, 0x11, 0x75, 0x64, 0x70, 0x5f, 0x73, 0x6e, 0x64, 0x62, 0x75, 0x66, 0x5f,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0xcd, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x75,
+	0x64, 0x70, 0x53, 0x6e, 0x64, 0x62, 0x75, 0x66, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x12, 0x2c,
+	0x0a, 0x12, 0x75, 0x64, 0x70, 0x5f, 0x69, 0x6e, 0x5f, 0x63, 0x73, 0x75, 0x6d, 0x5f, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x73, 0x18, 0xce, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x75, 0x64, 0x70,
+	0x49, 0x6e, 0x43, 0x73, 0x75, 0x6d, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x12, 0x2b, 0x0a, 0x11,
+	0x75, 0x64, 0x70, 0x5f, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x64, 0x5f, 0x6d, 0x75, 0x6c, 0x74,
+	0x69, 0x18, 0xcf, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x75, 0x64, 0x70, 0x49, 0x67, 0x6e,
+	0x6f, 0x72, 0x65, 0x64, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x12, 0x25, 0x0a, 0x0e, 0x75, 0x64, 0x70,
+	0x5f, 0x6d, 0x65, 0x6d, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0xd0, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0c, 0x75, 0x64, 0x70, 0x4d, 0x65, 0x6d, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x73,
+	0x12, 0x2b, 0x0a, 0x11, 0x74, 0x63, 0x70, 0x5f, 0x72, 0x74, 0x6f, 0x5f, 0x61, 0x6c, 0x67, 0x6f,
+	0x72, 0x69, 0x74, 0x68, 0x6d, 0x18, 0xac, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x74, 0x63,
+	0x70, 0x52, 0x74, 0x6f, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x12, 0x1f, 0x0a,
+	0x0b, 0x74, 0x63, 0x70, 0x5f, 0x72, 0x74, 0x6f, 0x5f, 0x6d, 0x69, 0x6e, 0x18, 0xad, 0x02, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x09, 0x74, 0x63, 0x70, 0x52, 0x74, 0x6f, 0x4d, 0x69, 0x6e, 0x12, 0x1f,
+	0x0a, 0x0b, 0x74, 0x63, 0x70, 0x5f, 0x72, 0x74, 0x6f, 0x5f, 0x6d, 0x61, 0x78, 0x18, 0xae, 0x02,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x74, 0x63, 0x70, 0x52, 0x74, 0x6f, 0x4d, 0x61, 0x78, 0x12,
+	0x21, 0x0a, 0x0c, 0x74, 0x63, 0x70, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x63, 0x6f, 0x6e, 0x6e, 0x18,
+	0xaf, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x74, 0x63, 0x70, 0x4d, 0x61, 0x78, 0x43, 0x6f,
+	0x6e, 0x6e, 0x12, 0x29, 0x0a, 0x10, 0x74, 0x63, 0x70, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65,
+	0x5f, 0x6f, 0x70, 0x65, 0x6e, 0x73, 0x18, 0xb0, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x74,
+	0x63, 0x70, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x4f, 0x70, 0x65, 0x6e, 0x73, 0x12, 0x2b, 0x0a,
+	0x11, 0x74, 0x63, 0x70, 0x5f, 0x70, 0x61, 0x73, 0x73, 0x69, 0x76, 0x65, 0x5f, 0x6f, 0x70, 0x65,
+	0x6e, 0x73, 0x18, 0xb1, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x74, 0x63, 0x70, 0x50, 0x61,
+	0x73, 0x73, 0x69, 0x76, 0x65, 0x4f, 0x70, 0x65, 0x6e, 0x73, 0x12, 0x2b, 0x0a, 0x11, 0x74, 0x63,
+	0x70, 0x5f, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x5f, 0x66, 0x61, 0x69, 0x6c, 0x73, 0x18,
+	0xb2, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x74, 0x63, 0x70, 0x41, 0x74, 0x74, 0x65, 0x6d,
+	0x70, 0x74, 0x46, 0x61, 0x69, 0x6c, 0x73, 0x12, 0x29, 0x0a, 0x10, 0x74, 0x63, 0x70, 0x5f, 0x65,
+	0x73, 0x74, 0x61, 0x62, 0x5f, 0x72, 0x65, 0x73, 0x65, 0x74, 0x73, 0x18, 0xb3, 0x02, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0e, 0x74, 0x63, 0x70, 0x45, 0x73, 0x74, 0x61, 0x62, 0x52, 0x65, 0x73, 0x65,
+	0x74, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x74, 0x63, 0x70, 0x5f, 0x63, 0x75, 0x72, 0x72, 0x5f, 0x65,
+	0x73, 0x74, 0x61, 0x62, 0x18, 0xb4, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x74, 0x63, 0x70,
+	0x43, 0x75, 0x72, 0x72, 0x45, 0x73, 0x74, 0x61, 0x62, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x63, 0x70,
+	0x5f, 0x69, 0x6e, 0x5f, 0x73, 0x65, 0x67, 0x73, 0x18, 0xb5, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x09, 0x74, 0x63, 0x70, 0x49, 0x6e, 0x53, 0x65, 0x67, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x74, 0x63,
+	0x70, 0x5f, 0x6f, 0x75, 0x74, 0x5f, 0x73, 0x65, 0x67, 0x73, 0x18, 0xb6, 0x02, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0a, 0x74, 0x63, 0x70, 0x4f, 0x75, 0x74, 0x53, 0x65, 0x67, 0x73, 0x12, 0x29, 0x0a,
+	0x10, 0x74, 0x63, 0x70, 0x5f, 0x72, 0x65, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x5f, 0x73, 0x65, 0x67,
+	0x73, 0x18, 0xb7, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x74, 0x63, 0x70, 0x52, 0x65, 0x74,
+	0x72, 0x61, 0x6e, 0x73, 0x53, 0x65, 0x67, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x63, 0x70, 0x5f,
+	0x69, 0x6e, 0x5f, 0x65, 0x72, 0x72, 0x73, 0x18, 0xb8, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09,
+	0x74, 0x63, 0x70, 0x49, 0x6e, 0x45, 0x72, 0x72, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x74, 0x63, 0x70,
+	0x5f, 0x6f, 0x75, 0x74, 0x5f, 0x72, 0x73, 0x74, 0x73, 0x18, 0xb9, 0x02, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0a, 0x74, 0x63, 0x70, 0x4f, 0x75, 0x74, 0x52, 0x73, 0x74, 0x73, 0x12, 0x2c, 0x0a, 0x12,
+	0x74, 0x63, 0x70, 0x5f, 0x69, 0x6e, 0x5f, 0x63, 0x73, 0x75, 0x6d, 0x5f, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x73, 0x18, 0xba, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x74, 0x63, 0x70, 0x49, 0x6e,
+	0x43, 0x73, 0x75, 0x6d, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x12, 0x2f, 0x0a, 0x13, 0x74, 0x63,
+	0x70, 0x5f, 0x73, 0x79, 0x6e, 0x63, 0x6f, 0x6f, 0x6b, 0x69, 0x65, 0x73, 0x5f, 0x73, 0x65, 0x6e,
+	0x74, 0x18, 0x90, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x11, 0x74, 0x63, 0x70, 0x53, 0x79, 0x6e,
+	0x63, 0x6f, 0x6f, 0x6b, 0x69, 0x65, 0x73, 0x53, 0x65, 0x6e, 0x74, 0x12, 0x2f, 0x0a, 0x13, 0x74,
+	0x63, 0x70, 0x5f, 0x73, 0x79, 0x6e, 0x63, 0x6f, 0x6f, 0x6b, 0x69, 0x65, 0x73, 0x5f, 0x72, 0x65,
+	0x63, 0x76, 0x18, 0x91, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x11, 0x74, 0x63, 0x70, 0x53, 0x79,
+	0x6e, 0x63, 0x6f, 0x6f, 0x6b, 0x69, 0x65, 0x73, 0x52, 0x65, 0x63, 0x76, 0x12, 0x33, 0x0a, 0x15,
+	0x74, 0x63, 0x70, 0x5f, 0x73, 0x79, 0x6e, 0x63, 0x6f, 0x6f, 0x6b, 0x69, 0x65, 0x73, 0x5f, 0x66,
+	0x61, 0x69, 0x6c, 0x65, 0x64, 0x18, 0x92, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x13, 0x74, 0x63,
+	0x70, 0x53, 0x79, 0x6e, 0x63, 0x6f, 0x6f, 0x6b, 0x69, 0x65, 0x73, 0x46, 0x61, 0x69, 0x6c, 0x65,
+	0x64, 0x12, 0x2d, 0x0a, 0x12, 0x74, 0x63, 0x70, 0x5f, 0x65, 0x6d, 0x62, 0x72, 0x79, 0x6f, 0x6e,
+	0x69, 0x63, 0x5f, 0x72, 0x73, 0x74, 0x73, 0x18, 0x93, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10,
+	0x74, 0x63, 0x70, 0x45, 0x6d, 0x62, 0x72, 0x79, 0x6f, 0x6e, 0x69, 0x63, 0x52, 0x73, 0x74, 0x73,
+	0x12, 0x29, 0x0a, 0x10, 0x74, 0x63, 0x70, 0x5f, 0x70, 0x72, 0x75, 0x6e, 0x65, 0x5f, 0x63, 0x61,
+	0x6c, 0x6c, 0x65, 0x64, 0x18, 0x94, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x74, 0x63, 0x70,
+	0x50, 0x72, 0x75, 0x6e, 0x65, 0x43, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x74,
+	0x63, 0x70, 0x5f, 0x72, 0x63, 0x76, 0x5f, 0x70, 0x72, 0x75, 0x6e, 0x65, 0x64, 0x18, 0x95, 0x03,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x74, 0x63, 0x70, 0x52, 0x63, 0x76, 0x50, 0x72, 0x75, 0x6e,
+	0x65, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x74, 0x63, 0x70, 0x5f, 0x6f, 0x66, 0x6f, 0x5f, 0x70, 0x72,
+	0x75, 0x6e, 0x65, 0x64, 0x18, 0x96, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x74, 0x63, 0x70,
+	0x4f, 0x66, 0x6f, 0x50, 0x72, 0x75, 0x6e, 0x65, 0x64, 0x12, 0x35, 0x0a, 0x17, 0x74, 0x63, 0x70,
+	0x5f, 0x6f, 0x75, 0x74, 0x5f, 0x6f, 0x66, 0x5f, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x5f, 0x69,
+	0x63, 0x6d, 0x70, 0x73, 0x18, 0x97, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x13, 0x74, 0x63, 0x70,
+	0x4f, 0x75, 0x74, 0x4f, 0x66, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x49, 0x63, 0x6d, 0x70, 0x73,
+	0x12, 0x34, 0x0a, 0x16, 0x74, 0x63, 0x70, 0x5f, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x64, 0x72, 0x6f,
+	0x70, 0x70, 0x65, 0x64, 0x5f, 0x69, 0x63, 0x6d, 0x70, 0x73, 0x18, 0x98, 0x03, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x13, 0x74, 0x63, 0x70, 0x4c, 0x6f, 0x63, 0x6b, 0x44, 0x72, 0x6f, 0x70, 0x70, 0x65,
+	0x64, 0x49, 0x63, 0x6d, 0x70, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x74, 0x63, 0x70, 0x5f, 0x61, 0x72,
+	0x70, 0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x99, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0c, 0x74, 0x63, 0x70, 0x41, 0x72, 0x70, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x16, 0x0a,
+	0x06, 0x74, 0x63, 0x70, 0x5f, 0x74, 0x77, 0x18, 0x9a, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05,
+	0x74, 0x63, 0x70, 0x54, 0x77, 0x12, 0x27, 0x0a, 0x0f, 0x74, 0x63, 0x70, 0x5f, 0x74, 0x77, 0x5f,
+	0x72, 0x65, 0x63, 0x79, 0x63, 0x6c, 0x65, 0x64, 0x18, 0x9b, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0d, 0x74, 0x63, 0x70, 0x54, 0x77, 0x52, 0x65, 0x63, 0x79, 0x63, 0x6c, 0x65, 0x64, 0x12, 0x23,
+	0x0a, 0x0d, 0x74, 0x63, 0x70, 0x5f, 0x74, 0x77, 0x5f, 0x6b, 0x69, 0x6c, 0x6c, 0x65, 0x64, 0x18,
+	0x9c, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x74, 0x63, 0x70, 0x54, 0x77, 0x4b, 0x69, 0x6c,
+	0x6c, 0x65, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x74, 0x63, 0x70, 0x5f, 0x70, 0x61, 0x77, 0x73, 0x5f,
+	0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x9d, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x74,
+	0x63, 0x70, 0x50, 0x61, 0x77, 0x73, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x12, 0x25, 0x0a, 0x0e,
+	0x74, 0x63, 0x70, 0x5f, 0x70, 0x61, 0x77, 0x73, 0x5f, 0x65, 0x73, 0x74, 0x61, 0x62, 0x18, 0x9e,
+	0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x74, 0x63, 0x70, 0x50, 0x61, 0x77, 0x73, 0x45, 0x73,
+	0x74, 0x61, 0x62, 0x12, 0x29, 0x0a, 0x10, 0x74, 0x63, 0x70, 0x5f, 0x64, 0x65, 0x6c, 0x61, 0x79,
+	0x65, 0x64, 0x5f, 0x61, 0x63, 0x6b, 0x73, 0x18, 0x9f, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e,
+	0x74, 0x63, 0x70, 0x44, 0x65, 0x6c, 0x61, 0x79, 0x65, 0x64, 0x41, 0x63, 0x6b, 0x73, 0x12, 0x34,
+	0x0a, 0x16, 0x74, 0x63, 0x70, 0x5f, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x65, 0x64, 0x5f, 0x61, 0x63,
+	0x6b, 0x5f, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x18, 0xa0, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x13, 0x74, 0x63, 0x70, 0x44, 0x65, 0x6c, 0x61, 0x79, 0x65, 0x64, 0x41, 0x63, 0x6b, 0x4c, 0x6f,
+	0x63, 0x6b, 0x65, 0x64, 0x12, 0x30, 0x0a, 0x14, 0x74, 0x63, 0x70, 0x5f, 0x64, 0x65, 0x6c, 0x61,
+	0x79, 0x65, 0x64, 0x5f, 0x61, 0x63, 0x6b, 0x5f, 0x6c, 0x6f, 0x73, 0x74, 0x18, 0xa1, 0x03, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x11, 0x74, 0x63, 0x70, 0x44, 0x65, 0x6c, 0x61, 0x79, 0x65, 0x64, 0x41,
+	0x63, 0x6b, 0x4c, 0x6f, 0x73, 0x74, 0x12, 0x31, 0x0a, 0x14, 0x74, 0x63, 0x70, 0x5f, 0x6c, 0x69,
+	0x73, 0x74, 0x65, 0x6e, 0x5f, 0x6f, 0x76, 0x65, 0x72, 0x66, 0x6c, 0x6f, 0x77, 0x73, 0x18, 0xa2,
+	0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x12, 0x74, 0x63, 0x70, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e,
+	0x4f, 0x76, 0x65, 0x72, 0x66, 0x6c, 0x6f, 0x77, 0x73, 0x12, 0x29, 0x0a, 0x10, 0x74, 0x63, 0x70,
+	0x5f, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x5f, 0x64, 0x72, 0x6f, 0x70, 0x73, 0x18, 0xa3, 0x03,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x74, 0x63, 0x70, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x44,
+	0x72, 0x6f, 0x70, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x63, 0x70, 0x5f, 0x68, 0x70, 0x5f, 0x68,
+	0x69, 0x74, 0x73, 0x18, 0xa4, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x74, 0x63, 0x70, 0x48,
+	0x70, 0x48, 0x69, 0x74, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x74, 0x63, 0x70, 0x5f, 0x70, 0x75, 0x72,
+	0x65, 0x5f, 0x61, 0x63, 0x6b, 0x73, 0x18, 0xa5, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x74,
+	0x63, 0x70, 0x50, 0x75, 0x72, 0x65, 0x41, 0x63, 0x6b, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x63,
+	0x70, 0x5f, 0x68, 0x70, 0x5f, 0x61, 0x63, 0x6b, 0x73, 0x18, 0xa6, 0x03, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x09, 0x74, 0x63, 0x70, 0x48, 0x70, 0x41, 0x63, 0x6b, 0x73, 0x12, 0x2b, 0x0a, 0x11, 0x74,
+	0x63, 0x70, 0x5f, 0x72, 0x65, 0x6e, 0x6f, 0x5f, 0x72, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79,
+	0x18, 0xa7, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x74, 0x63, 0x70, 0x52, 0x65, 0x6e, 0x6f,
+	0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x12, 0x2b, 0x0a, 0x11, 0x74, 0x63, 0x70, 0x5f,
+	0x73, 0x61, 0x63, 0x6b, 0x5f, 0x72, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x18, 0xa8, 0x03,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x74, 0x63, 0x70, 0x53, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x63,
+	0x6f, 0x76, 0x65, 0x72, 0x79, 0x12, 0x2b, 0x0a, 0x11, 0x74, 0x63, 0x70, 0x5f, 0x73, 0x61, 0x63,
+	0x6b, 0x5f, 0x72, 0x65, 0x6e, 0x65, 0x67, 0x69, 0x6e, 0x67, 0x18, 0xa9, 0x03, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0f, 0x74, 0x63, 0x70, 0x53, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x6e, 0x65, 0x67, 0x69,
+	0x6e, 0x67, 0x12, 0x29, 0x0a, 0x10, 0x74, 0x63, 0x70, 0x5f, 0x73, 0x61, 0x63, 0x6b, 0x5f, 0x72,
+	0x65, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x18, 0xaa, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x74,
+	0x63, 0x70, 0x53, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x29, 0x0a,
+	0x10, 0x74, 0x63, 0x70, 0x5f, 0x72, 0x65, 0x6e, 0x6f, 0x5f, 0x72, 0x65, 0x6f, 0x72, 0x64, 0x65,
+	0x72, 0x18, 0xab, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x74, 0x63, 0x70, 0x52, 0x65, 0x6e,
+	0x6f, 0x52, 0x65, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x25, 0x0a, 0x0e, 0x74, 0x63, 0x70, 0x5f,
+	0x74, 0x73, 0x5f, 0x72, 0x65, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x18, 0xac, 0x03, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0c, 0x74, 0x63, 0x70, 0x54, 0x73, 0x52, 0x65, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x12,
+	0x23, 0x0a, 0x0d, 0x74, 0x63, 0x70, 0x5f, 0x66, 0x75, 0x6c, 0x6c, 0x5f, 0x75, 0x6e, 0x64, 0x6f,
+	0x18, 0xad, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x74, 0x63, 0x70, 0x46, 0x75, 0x6c, 0x6c,
+	0x55, 0x6e, 0x64, 0x6f, 0x12, 0x29, 0x0a, 0x10, 0x74, 0x63, 0x70, 0x5f, 0x70, 0x61, 0x72, 0x74,
+	0x69, 0x61, 0x6c, 0x5f, 0x75, 0x6e, 0x64, 0x6f, 0x18, 0xae, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0e, 0x74, 0x63, 0x70, 0x50, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x55, 0x6e, 0x64, 0x6f, 0x12,
+	0x25, 0x0a, 0x0e, 0x74, 0x63, 0x70, 0x5f, 0x64, 0x73, 0x61, 0x63, 0x6b, 0x5f, 0x75, 0x6e, 0x64,
+	0x6f, 0x18, 0xaf, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x74, 0x63, 0x70, 0x44, 0x73, 0x61,
+	0x63, 0x6b, 0x55, 0x6e, 0x64, 0x6f, 0x12, 0x23, 0x0a, 0x0d, 0x74, 0x63, 0x70, 0x5f, 0x6c, 0x6f,
+	0x73, 0x73, 0x5f, 0x75, 0x6e, 0x64, 0x6f, 0x18, 0xb0, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b,
+	0x74, 0x63, 0x70, 0x4c, 0x6f, 0x73, 0x73, 0x55, 0x6e, 0x64, 0x6f, 0x12, 0x2f, 0x0a, 0x13, 0x74,
+	0x63, 0x70, 0x5f, 0x6c, 0x6f, 0x73, 0x74, 0x5f, 0x72, 0x65, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x6d,
+	0x69, 0x74, 0x18, 0xb1, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x11, 0x74, 0x63, 0x70, 0x4c, 0x6f,
+	0x73, 0x74, 0x52, 0x65, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x6d, 0x69, 0x74, 0x12, 0x2b, 0x0a, 0x11,
+	0x74, 0x63, 0x70, 0x5f, 0x72, 0x65, 0x6e, 0x6f, 0x5f, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65,
+	0x73, 0x18, 0xb2, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x74, 0x63, 0x70, 0x52, 0x65, 0x6e,
+	0x6f, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x73, 0x12, 0x2b, 0x0a, 0x11, 0x74, 0x63, 0x70,
+	0x5f, 0x73, 0x61, 0x63, 0x6b, 0x5f, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x73, 0x18, 0xb3,
+	0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x74, 0x63, 0x70, 0x53, 0x61, 0x63, 0x6b, 0x46, 0x61,
+	0x69, 0x6c, 0x75, 0x72, 0x65, 0x73, 0x12, 0x2b, 0x0a, 0x11, 0x74, 0x63, 0x70, 0x5f, 0x6c, 0x6f,
+	0x73, 0x73, 0x5f, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x73, 0x18, 0xb4, 0x03, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0f, 0x74, 0x63, 0x70, 0x4c, 0x6f, 0x73, 0x73, 0x46, 0x61, 0x69, 0x6c, 0x75,
+	0x72, 0x65, 0x73, 0x12, 0x29, 0x0a, 0x10, 0x74, 0x63, 0x70, 0x5f, 0x66, 0x61, 0x73, 0x74, 0x5f,
+	0x72, 0x65, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x18, 0xb5, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e,
+	0x74, 0x63, 0x70, 0x46, 0x61, 0x73, 0x74, 0x52, 0x65, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x12, 0x34,
+	0x0a, 0x16, 0x74, 0x63, 0x70, 0x5f, 0x73, 0x6c, 0x6f, 0x77, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74,
+	0x5f, 0x72, 0x65, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x18, 0xb6, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x13, 0x74, 0x63, 0x70, 0x53, 0x6c, 0x6f, 0x77, 0x53, 0x74, 0x61, 0x72, 0x74, 0x52, 0x65, 0x74,
+	0x72, 0x61, 0x6e, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x74, 0x63, 0x70, 0x5f, 0x74, 0x69, 0x6d, 0x65,
+	0x6f, 0x75, 0x74, 0x73, 0x18, 0xb7, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x74, 0x63, 0x70,
+	0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x74, 0x63, 0x70, 0x5f,
+	0x6c, 0x6f, 0x73, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x73, 0x18, 0xb8, 0x03, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0d, 0x74, 0x63, 0x70, 0x4c, 0x6f, 0x73, 0x73, 0x50, 0x72, 0x6f, 0x62, 0x65,
+	0x73, 0x12, 0x36, 0x0a, 0x17, 0x74, 0x63, 0x70, 0x5f, 0x6c, 0x6f, 0x73, 0x73, 0x5f, 0x70, 0x72,
+	0x6f, 0x62, 0x65, 0x5f, 0x72, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x18, 0xb9, 0x03, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x14, 0x74, 0x63, 0x70, 0x4c, 0x6f, 0x73, 0x73, 0x50, 0x72, 0x6f, 0x62,
+	0x65, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x12, 0x34, 0x0a, 0x16, 0x74, 0x63, 0x70,
+	0x5f, 0x72, 0x65, 0x6e, 0x6f, 0x5f, 0x72, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x5f, 0x66,
+	0x61, 0x69, 0x6c, 0x18, 0xba, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x13, 0x74, 0x63, 0x70, 0x52,
+	0x65, 0x6e, 0x6f, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x46, 0x61, 0x69, 0x6c, 0x12,
+	0x34, 0x0a, 0x16, 0x74, 0x63, 0x70, 0x5f, 0x73, 0x61, 0x63, 0x6b, 0x5f, 0x72, 0x65, 0x63, 0x6f,
+	0x76, 0x65, 0x72, 0x79, 0x5f, 0x66, 0x61, 0x69, 0x6c, 0x18, 0xbb, 0x03, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x13, 0x74, 0x63, 0x70, 0x53, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72,
+	0x79, 0x46, 0x61, 0x69, 0x6c, 0x12, 0x2b, 0x0a, 0x11, 0x74, 0x63, 0x70, 0x5f, 0x72, 0x63, 0x76,
+	0x5f, 0x63, 0x6f, 0x6c, 0x6c, 0x61, 0x70, 0x73, 0x65, 0x64, 0x18, 0xbc, 0x03, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0f, 0x74, 0x63, 0x70, 0x52, 0x63, 0x76, 0x43, 0x6f, 0x6c, 0x6c, 0x61, 0x70, 0x73,
+	0x65, 0x64, 0x12, 0x31, 0x0a, 0x14, 0x74, 0x63, 0x70, 0x5f, 0x62, 0x61, 0x63, 0x6b, 0x6c, 0x6f,
+	0x67, 0x5f, 0x63, 0x6f, 0x61, 0x6c, 0x65, 0x73, 0x63, 0x65, 0x18, 0xbd, 0x03, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x12, 0x74, 0x63, 0x70, 0x42, 0x61, 0x63, 0x6b, 0x6c, 0x6f, 0x67, 0x43, 0x6f, 0x61,
+	0x6c, 0x65, 0x73, 0x63, 0x65, 0x12, 0x2c, 0x0a, 0x12, 0x74, 0x63, 0x70, 0x5f, 0x64, 0x73, 0x61,
+	0x63, 0x6b, 0x5f, 0x6f, 0x6c, 0x64, 0x5f, 0x73, 0x65, 0x6e, 0x74, 0x18, 0xbe, 0x03, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0f, 0x74, 0x63, 0x70, 0x44, 0x73, 0x61, 0x63, 0x6b, 0x4f, 0x6c, 0x64, 0x53,
+	0x65, 0x6e, 0x74, 0x12, 0x2c, 0x0a, 0x12, 0x74, 0x63, 0x70, 0x5f, 0x64, 0x73, 0x61, 0x63, 0x6b,
+	0x5f, 0x6f, 0x66, 0x6f, 0x5f, 0x73, 0x65, 0x6e, 0x74, 0x18, 0xbf, 0x03, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0f, 0x74, 0x63, 0x70, 0x44, 0x73, 0x61, 0x63, 0x6b, 0x4f, 0x66, 0x6f, 0x53, 0x65, 0x6e,
+	0x74, 0x12, 0x25, 0x0a, 0x0e, 0x74, 0x63, 0x70, 0x5f, 0x64, 0x73, 0x61, 0x63, 0x6b, 0x5f, 0x72,
+	0x65, 0x63, 0x76, 0x18, 0xc0, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x74, 0x63, 0x70, 0x44,
+	0x73, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x63, 0x76, 0x12, 0x2c, 0x0a, 0x12, 0x74, 0x63, 0x70, 0x5f,
+	0x64, 0x73, 0x61, 0x63, 0x6b, 0x5f, 0x6f, 0x66, 0x6f, 0x5f, 0x72, 0x65, 0x63, 0x76, 0x18, 0xc1,
+	0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x74, 0x63, 0x70, 0x44, 0x73, 0x61, 0x63, 0x6b, 0x4f,
+	0x66, 0x6f, 0x52, 0x65, 0x63, 0x76, 0x12, 0x2a, 0x0a, 0x11, 0x74, 0x63, 0x70, 0x5f, 0x61, 0x62,
+	0x6f, 0x72, 0x74, 0x5f, 0x6f, 0x6e, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0xc2, 0x03, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0e, 0x74, 0x63, 0x70, 0x41, 0x62, 0x6f, 0x72, 0x74, 0x4f, 0x6e, 0x44, 0x61,
+	0x74, 0x61, 0x12, 0x2c, 0x0a, 0x12, 0x74, 0x63, 0x70, 0x5f, 0x61, 0x62, 0x6f, 0x72, 0x74, 0x5f,
+	0x6f, 0x6e, 0x5f, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x18, 0xc3, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0f, 0x74, 0x63, 0x70, 0x41, 0x62, 0x6f, 0x72, 0x74, 0x4f, 0x6e, 0x43, 0x6c, 0x6f, 0x73, 0x65,
+	0x12, 0x2e, 0x0a, 0x13, 0x74, 0x63, 0x70, 0x5f, 0x61, 0x62, 0x6f, 0x72, 0x74, 0x5f, 0x6f, 0x6e,
+	0x5f, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x18, 0xc4, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10,
+	0x74, 0x63, 0x70, 0x41, 0x62, 0x6f, 0x72, 0x74, 0x4f, 0x6e, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79,
+	0x12, 0x30, 0x0a, 0x14, 0x74, 0x63, 0x70, 0x5f, 0x61, 0x62, 0x6f, 0x72, 0x74, 0x5f, 0x6f, 0x6e,
+	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0xc5, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x11, 0x74, 0x63, 0x70, 0x41, 0x62, 0x6f, 0x72, 0x74, 0x4f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x6f,
+	0x75, 0x74, 0x12, 0x2e, 0x0a, 0x13, 0x74, 0x63, 0x70, 0x5f, 0x61, 0x62, 0x6f, 0x72, 0x74, 0x5f,
+	0x6f, 0x6e, 0x5f, 0x6c, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x18, 0xc6, 0x03, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x10, 0x74, 0x63, 0x70, 0x41, 0x62, 0x6f, 0x72, 0x74, 0x4f, 0x6e, 0x4c, 0x69, 0x6e, 0x67,
+	0x65, 0x72, 0x12, 0x29, 0x0a, 0x10, 0x74, 0x63, 0x70, 0x5f, 0x61, 0x62, 0x6f, 0x72, 0x74, 0x5f,
+	0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x18, 0xc7, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x74,
+	0x63, 0x70, 0x41, 0x62, 0x6f, 0x72, 0x74, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x12, 0x31, 0x0a,
+	0x14, 0x74, 0x63, 0x70, 0x5f, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x5f, 0x70, 0x72, 0x65, 0x73,
+	0x73, 0x75, 0x72, 0x65, 0x73, 0x18, 0xc8, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x12, 0x74, 0x63,
+	0x70, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x50, 0x72, 0x65, 0x73, 0x73, 0x75, 0x72, 0x65, 0x73,
+	0x12, 0x3e, 0x0a, 0x1b, 0x74, 0x63, 0x70, 0x5f, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x5f, 0x70,
+	0x72, 0x65, 0x73, 0x73, 0x75, 0x72, 0x65, 0x73, 0x5f, 0x63, 0x68, 0x72, 0x6f, 0x6e, 0x6f, 0x18,
+	0xc9, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x18, 0x74, 0x63, 0x70, 0x4d, 0x65, 0x6d, 0x6f, 0x72,
+	0x79, 0x50, 0x72, 0x65, 0x73, 0x73, 0x75, 0x72, 0x65, 0x73, 0x43, 0x68, 0x72, 0x6f, 0x6e, 0x6f,
+	0x12, 0x29, 0x0a, 0x10, 0x74, 0x63, 0x70, 0x5f, 0x73, 0x61, 0x63, 0x6b, 0x5f, 0x64, 0x69, 0x73,
+	0x63, 0x61, 0x72, 0x64, 0x18, 0xca, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x74, 0x63, 0x70,
+	0x53, 0x61, 0x63, 0x6b, 0x44, 0x69, 0x73, 0x63, 0x61, 0x72, 0x64, 0x12, 0x32, 0x0a, 0x15, 0x74,
+	0x63, 0x70, 0x5f, 0x64, 0x73, 0x61, 0x63, 0x6b, 0x5f, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x64,
+	0x5f, 0x6f, 0x6c, 0x64, 0x18, 0xcb, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x12, 0x74, 0x63, 0x70,
+	0x44, 0x73, 0x61, 0x63, 0x6b, 0x49, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x64, 0x4f, 0x6c, 0x64, 0x12,
+	0x39, 0x0a, 0x19, 0x74, 0x63, 0x70, 0x5f, 0x64, 0x73, 0x61, 0x63, 0x6b, 0x5f, 0x69, 0x67, 0x6e,
+	0x6f, 0x72, 0x65, 0x64, 0x5f, 0x6e, 0x6f, 0x5f, 0x75, 0x6e, 0x64, 0x6f, 0x18, 0xcc, 0x03, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x15, 0x74, 0x63, 0x70, 0x44, 0x73, 0x61, 0x63, 0x6b, 0x49, 0x67, 0x6e,
+	0x6f, 0x72, 0x65, 0x64, 0x4e, 0x6f, 0x55, 0x6e, 0x64, 0x6f, 0x12, 0x2b, 0x0a, 0x11, 0x74, 0x63,
+	0x70, 0x5f, 0x73, 0x70, 0x75, 0x72, 0x69, 0x6f, 0x75, 0x73, 0x5f, 0x72, 0x74, 0x6f, 0x73, 0x18,
+	0xcd, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x74, 0x63, 0x70, 0x53, 0x70, 0x75, 0x72, 0x69,
+	0x6f, 0x75, 0x73, 0x52, 0x74, 0x6f, 0x73, 0x12, 0x2a, 0x0a, 0x11, 0x74, 0x63, 0x70, 0x5f, 0x6d,
+	0x64, 0x35, 0x5f, 0x6e, 0x6f, 0x74, 0x5f, 0x66, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0xce, 0x03, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0e, 0x74, 0x63, 0x70, 0x4d, 0x64, 0x35, 0x4e, 0x6f, 0x74, 0x46, 0x6f,
+	0x75, 0x6e, 0x64, 0x12, 0x2d, 0x0a, 0x12, 0x74, 0x63, 0x70, 0x5f, 0x6d, 0x64, 0x35, 0x5f, 0x75,
+	0x6e, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x18, 0xcf, 0x03, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x10, 0x74, 0x63, 0x70, 0x4d, 0x64, 0x35, 0x55, 0x6e, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74,
+	0x65, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x74, 0x63, 0x70, 0x5f, 0x6d, 0x64, 0x35, 0x5f, 0x66, 0x61,
+	0x69, 0x6c, 0x75, 0x72, 0x65, 0x18, 0xd0, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x74, 0x63,
+	0x70, 0x4d, 0x64, 0x35, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x12, 0x29, 0x0a, 0x10, 0x74,
+	0x63, 0x70, 0x5f, 0x73, 0x61, 0x63, 0x6b, 0x5f, 0x73, 0x68, 0x69, 0x66, 0x74, 0x65, 0x64, 0x18,
+	0xd1, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x74, 0x63, 0x70, 0x53, 0x61, 0x63, 0x6b, 0x53,
+	0x68, 0x69, 0x66, 0x74, 0x65, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x74, 0x63, 0x70, 0x5f, 0x73, 0x61,
+	0x63, 0x6b, 0x5f, 0x6d, 0x65, 0x72, 0x67, 0x65, 0x64, 0x18, 0xd2, 0x03, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0d, 0x74, 0x63, 0x70, 0x53, 0x61, 0x63, 0x6b, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x64, 0x12,
+	0x36, 0x0a, 0x17, 0x74, 0x63, 0x70, 0x5f, 0x73, 0x61, 0x63, 0x6b, 0x5f, 0x73, 0x68, 0x69, 0x66,
+	0x74, 0x5f, 0x66, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x18, 0xd3, 0x03, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x14, 0x74, 0x63, 0x70, 0x53, 0x61, 0x63, 0x6b, 0x53, 0x68, 0x69, 0x66, 0x74, 0x46,
+	0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x12, 0x29, 0x0a, 0x10, 0x74, 0x63, 0x70, 0x5f, 0x62,
+	0x61, 0x63, 0x6b, 0x6c, 0x6f, 0x67, 0x5f, 0x64, 0x72, 0x6f, 0x70, 0x18, 0xd4, 0x03, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0e, 0x74, 0x63, 0x70, 0x42, 0x61, 0x63, 0x6b, 0x6c, 0x6f, 0x67, 0x44, 0x72,
+	0x6f, 0x70, 0x12, 0x30, 0x0a, 0x14, 0x74, 0x63, 0x70, 0x5f, 0x70, 0x66, 0x5f, 0x6d, 0x65, 0x6d,
+	0x61, 0x6c, 0x6c, 0x6f, 0x63, 0x5f, 0x64, 0x72, 0x6f, 0x70, 0x18, 0xd5, 0x03, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x11, 0x74, 0x63, 0x70, 0x50, 0x66, 0x4d, 0x65, 0x6d, 0x61, 0x6c, 0x6c, 0x6f, 0x63,
+	0x44, 0x72, 0x6f, 0x70, 0x12, 0x28, 0x0a, 0x10, 0x74, 0x63, 0x70, 0x5f, 0x6d, 0x69, 0x6e, 0x5f,
+	0x74, 0x74, 0x6c, 0x5f, 0x64, 0x72, 0x6f, 0x70, 0x18, 0xd6, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0d, 0x74, 0x63, 0x70, 0x4d, 0x69, 0x6e, 0x54, 0x74, 0x6c, 0x44, 0x72, 0x6f, 0x70, 0x12, 0x32,
+	0x0a, 0x15, 0x74, 0x63, 0x70, 0x5f, 0x64, 0x65, 0x66, 0x65, 0x72, 0x5f, 0x61, 0x63, 0x63, 0x65,
+	0x70, 0x74, 0x5f, 0x64, 0x72, 0x6f, 0x70, 0x18, 0xd7, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x12,
+	0x74, 0x63, 0x70, 0x44, 0x65, 0x66, 0x65, 0x72, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x44, 0x72,
+	0x6f, 0x70, 0x12, 0x3b, 0x0a, 0x1a, 0x74, 0x63, 0x70, 0x5f, 0x69, 0x70, 0x5f, 0x72, 0x65, 0x76,
+	0x65, 0x72, 0x73, 0x65, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72,
+	0x18, 0xd8, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x16, 0x74, 0x63, 0x70, 0x49, 0x70, 0x52, 0x65,
+	0x76, 0x65, 0x72, 0x73, 0x65, 0x50, 0x61, 0x74, 0x68, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12,
+	0x34, 0x0a, 0x16, 0x74, 0x63, 0x70, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x77, 0x61, 0x69, 0x74,
+	0x5f, 0x6f, 0x76, 0x65, 0x72, 0x66, 0x6c, 0x6f, 0x77, 0x18, 0xd9, 0x03, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x13, 0x74, 0x63, 0x70, 0x54, 0x69, 0x6d, 0x65, 0x57, 0x61, 0x69, 0x74, 0x4f, 0x76, 0x65,
+	0x72, 0x66, 0x6c, 0x6f, 0x77, 0x12, 0x38, 0x0a, 0x19, 0x74, 0x63, 0x70, 0x5f, 0x72, 0x65, 0x71,
+	0x5f, 0x71, 0x5f, 0x66, 0x75, 0x6c, 0x6c, 0x5f, 0x64, 0x6f, 0x5f, 0x63, 0x6f, 0x6f, 0x6b, 0x69,
+	0x65, 0x73, 0x18, 0xda, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x14, 0x74, 0x63, 0x70, 0x52, 0x65,
+	0x71, 0x51, 0x46, 0x75, 0x6c, 0x6c, 0x44, 0x6f, 0x43, 0x6f, 0x6f, 0x6b, 0x69, 0x65, 0x73, 0x12,
+	0x2d, 0x0a, 0x13, 0x74, 0x63, 0x70, 0x5f, 0x72, 0x65, 0x71, 0x5f, 0x71, 0x5f, 0x66, 0x75, 0x6c,
+	0x6c, 0x5f, 0x64, 0x72, 0x6f, 0x70, 0x18, 0xdb, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x74,
+	0x63, 0x70, 0x52, 0x65, 0x71, 0x51, 0x46, 0x75, 0x6c, 0x6c, 0x44, 0x72, 0x6f, 0x70, 0x12, 0x29,
+	0x0a, 0x10, 0x74, 0x63, 0x70, 0x5f, 0x72, 0x65, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x5f, 0x66, 0x61,
+	0x69, 0x6c, 0x18, 0xdc, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x74, 0x63, 0x70, 0x52, 0x65,
+	0x74, 0x72, 0x61, 0x6e, 0x73, 0x46, 0x61, 0x69, 0x6c, 0x12, 0x29, 0x0a, 0x10, 0x74, 0x63, 0x70,
+	0x5f, 0x72, 0x63, 0x76, 0x5f, 0x63, 0x6f, 0x61, 0x6c, 0x65, 0x73, 0x63, 0x65, 0x18, 0xdd, 0x03,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x74, 0x63, 0x70, 0x52, 0x63, 0x76, 0x43, 0x6f, 0x61, 0x6c,
+	0x65, 0x73, 0x63, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x74, 0x63, 0x70, 0x5f, 0x6f, 0x66, 0x6f, 0x5f,
+	0x71, 0x75, 0x65, 0x75, 0x65, 0x18, 0xde, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x74, 0x63,
+	0x70, 0x4f, 0x66, 0x6f, 0x51, 0x75, 0x65, 0x75, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x74, 0x63, 0x70,
+	0x5f, 0x6f, 0x66, 0x6f, 0x5f, 0x64, 0x72, 0x6f, 0x70, 0x18, 0xdf, 0x03, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0a, 0x74, 0x63, 0x70, 0x4f, 0x66, 0x6f, 0x44, 0x72, 0x6f, 0x70, 0x12, 0x23, 0x0a, 0x0d,
+	0x74, 0x63, 0x70, 0x5f, 0x6f, 0x66, 0x6f, 0x5f, 0x6d, 0x65, 0x72, 0x67, 0x65, 0x18, 0xe0, 0x03,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x74, 0x63, 0x70, 0x4f, 0x66, 0x6f, 0x4d, 0x65, 0x72, 0x67,
+	0x65, 0x12, 0x2b, 0x0a, 0x11, 0x74, 0x63, 0x70, 0x5f, 0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e,
+	0x67, 0x65, 0x5f, 0x61, 0x63, 0x6b, 0x18, 0xe1, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x74,
+	0x63, 0x70, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x41, 0x63, 0x6b, 0x12, 0x2b,
+	0x0a, 0x11, 0x74, 0x63, 0x70, 0x5f, 0x73, 0x79, 0x6e, 0x5f, 0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65,
+	0x6e, 0x67, 0x65, 0x18, 0xe2, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x74, 0x63, 0x70, 0x53,
+	0x79, 0x6e, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x12, 0x30, 0x0a, 0x14, 0x74,
+	0x63, 0x70, 0x5f, 0x66, 0x61, 0x73, 0x74, 0x5f, 0x6f, 0x70, 0x65, 0x6e, 0x5f, 0x61, 0x63, 0x74,
+	0x69, 0x76, 0x65, 0x18, 0xe3, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x11, 0x74, 0x63, 0x70, 0x46,
+	0x61, 0x73, 0x74, 0x4f, 0x70, 0x65, 0x6e, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x12, 0x39, 0x0a,
+	0x19, 0x74, 0x63, 0x70, 0x5f, 0x66, 0x61, 0x73, 0x74, 0x5f, 0x6f, 0x70, 0x65, 0x6e, 0x5f, 0x61,
+	0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x66, 0x61, 0x69, 0x6c, 0x18, 0xe4, 0x03, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x15, 0x74, 0x63, 0x70, 0x46, 0x61, 0x73, 0x74, 0x4f, 0x70, 0x65, 0x6e, 0x41, 0x63,
+	0x74, 0x69, 0x76, 0x65, 0x46, 0x61, 0x69, 0x6c, 0x12, 0x32, 0x0a, 0x15, 0x74, 0x63, 0x70, 0x5f,
+	0x66, 0x61, 0x73, 0x74, 0x5f, 0x6f, 0x70, 0x65, 0x6e, 0x5f, 0x70, 0x61, 0x73, 0x73, 0x69, 0x76,
+	0x65, 0x18, 0xe5, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x12, 0x74, 0x63, 0x70, 0x46, 0x61, 0x73,
+	0x74, 0x4f, 0x70, 0x65, 0x6e, 0x50, 0x61, 0x73, 0x73, 0x69, 0x76, 0x65, 0x12, 0x3b, 0x0a, 0x1a,
+	0x74, 0x63, 0x70, 0x5f, 0x66, 0x61, 0x73, 0x74, 0x5f, 0x6f, 0x70, 0x65, 0x6e, 0x5f, 0x70, 0x61,
+	0x73, 0x73, 0x69, 0x76, 0x65, 0x5f, 0x66, 0x61, 0x69, 0x6c, 0x18, 0xe6, 0x03, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x16, 0x74, 0x63, 0x70, 0x46, 0x61, 0x73, 0x74, 0x4f, 0x70, 0x65, 0x6e, 0x50, 0x61,
+	0x73, 0x73, 0x69, 0x76, 0x65, 0x46, 0x61, 0x69, 0x6c, 0x12, 0x41, 0x0a, 0x1d, 0x74, 0x63, 0x70,
+	0x5f, 0x66, 0x61, 0x73, 0x74, 0x5f, 0x6f, 0x70, 0x65, 0x6e, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x65,
+	0x6e, 0x5f, 0x6f, 0x76, 0x65, 0x72, 0x66, 0x6c, 0x6f, 0x77, 0x18, 0xe7, 0x03, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x19, 0x74, 0x63, 0x70, 0x46, 0x61, 0x73, 0x74, 0x4f, 0x70, 0x65, 0x6e, 0x4c, 0x69,
+	0x73, 0x74, 0x65, 0x6e, 0x4f, 0x76, 0x65, 0x72, 0x66, 0x6c, 0x6f, 0x77, 0x12, 0x39, 0x0a, 0x19,
+	0x74, 0x63, 0x70, 0x5f, 0x66, 0x61, 0x73, 0x74, 0x5f, 0x6f, 0x70, 0x65, 0x6e, 0x5f, 0x63, 0x6f,
+	0x6f, 0x6b, 0x69, 0x65, 0x5f, 0x72, 0x65, 0x71, 0x64, 0x18, 0xe8, 0x03, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x15, 0x74, 0x63, 0x70, 0x46, 0x61, 0x73, 0x74, 0x4f, 0x70, 0x65, 0x6e, 0x43, 0x6f, 0x6f,
+	0x6b, 0x69, 0x65, 0x52, 0x65, 0x71, 0x64, 0x12, 0x36, 0x0a, 0x17, 0x74, 0x63, 0x70, 0x5f, 0x66,
+	0x61, 0x73, 0x74, 0x5f, 0x6f, 0x70, 0x65, 0x6e, 0x5f, 0x62, 0x6c, 0x61, 0x63, 0x6b, 0x68, 0x6f,
+	0x6c, 0x65, 0x18, 0xe9, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x14, 0x74, 0x63, 0x70, 0x46, 0x61,
+	0x73, 0x74, 0x4f, 0x70, 0x65, 0x6e, 0x42, 0x6c, 0x61, 0x63, 0x6b, 0x68, 0x6f, 0x6c, 0x65, 0x12,
+	0x3f, 0x0a, 0x1c, 0x74, 0x63, 0x70, 0x5f, 0x73, 0x70, 0x75, 0x72, 0x69, 0x6f, 0x75, 0x73, 0x5f,
+	0x72, 0x74, 0x78, 0x5f, 0x68, 0x6f, 0x73, 0x74, 0x5f, 0x71, 0x75, 0x65, 0x75, 0x65, 0x73, 0x18,
+	0xea, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x18, 0x74, 0x63, 0x70, 0x53, 0x70, 0x75, 0x72, 0x69,
+	0x6f, 0x75, 0x73, 0x52, 0x74, 0x78, 0x48, 0x6f, 0x73, 0x74, 0x51, 0x75, 0x65, 0x75, 0x65, 0x73,
+	0x12, 0x37, 0x0a, 0x18, 0x74, 0x63, 0x70, 0x5f, 0x62, 0x75, 0x73, 0x79, 0x5f, 0x70, 0x6f, 0x6c,
+	0x6c, 0x5f, 0x72, 0x78, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x18, 0xeb, 0x03, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x14, 0x74, 0x63, 0x70, 0x42, 0x75, 0x73, 0x79, 0x50, 0x6f, 0x6c, 0x6c,
+	0x52, 0x78, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x12, 0x29, 0x0a, 0x10, 0x74, 0x63, 0x70,
+	0x5f, 0x61, 0x75, 0x74, 0x6f, 0x5f, 0x63, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x18, 0xec, 0x03,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x74, 0x63, 0x70, 0x41, 0x75, 0x74, 0x6f, 0x43, 0x6f, 0x72,
+	0x6b, 0x69, 0x6e, 0x67, 0x12, 0x37, 0x0a, 0x18, 0x74, 0x63, 0x70, 0x5f, 0x66, 0x72, 0x6f, 0x6d,
+	0x5f, 0x7a, 0x65, 0x72, 0x6f, 0x5f, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x5f, 0x61, 0x64, 0x76,
+	0x18, 0xed, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x14, 0x74, 0x63, 0x70, 0x46, 0x72, 0x6f, 0x6d,
+	0x5a, 0x65, 0x72, 0x6f, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x41, 0x64, 0x76, 0x12, 0x33, 0x0a,
+	0x16, 0x74, 0x63, 0x70, 0x5f, 0x74, 0x6f, 0x5f, 0x7a, 0x65, 0x72, 0x6f, 0x5f, 0x77, 0x69, 0x6e,
+	0x64, 0x6f, 0x77, 0x5f, 0x61, 0x64, 0x76, 0x18, 0xee, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x12,
+	0x74, 0x63, 0x70, 0x54, 0x6f, 0x5a, 0x65, 0x72, 0x6f, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x41,
+	0x64, 0x76, 0x12, 0x37, 0x0a, 0x18, 0x74, 0x63, 0x70, 0x5f, 0x77, 0x61, 0x6e, 0x74, 0x5f, 0x7a,
+	0x65, 0x72, 0x6f, 0x5f, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x5f, 0x61, 0x64, 0x76, 0x18, 0xef,
+	0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x14, 0x74, 0x63, 0x70, 0x57, 0x61, 0x6e, 0x74, 0x5a, 0x65,
+	0x72, 0x6f, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x41, 0x64, 0x76, 0x12, 0x27, 0x0a, 0x0f, 0x74,
+	0x63, 0x70, 0x5f, 0x73, 0x79, 0x6e, 0x5f, 0x72, 0x65, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x18, 0xf0,
+	0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x74, 0x63, 0x70, 0x53, 0x79, 0x6e, 0x52, 0x65, 0x74,
+	0x72, 0x61, 0x6e, 0x73, 0x12, 0x2c, 0x0a, 0x12, 0x74, 0x63, 0x70, 0x5f, 0x6f, 0x72, 0x69, 0x67,
+	0x5f, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x73, 0x65, 0x6e, 0x74, 0x18, 0xf1, 0x03, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0f, 0x74, 0x63, 0x70, 0x4f, 0x72, 0x69, 0x67, 0x44, 0x61, 0x74, 0x61, 0x53, 0x65,
+	0x6e, 0x74, 0x12, 0x38, 0x0a, 0x18, 0x74, 0x63, 0x70, 0x5f, 0x68, 0x79, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x5f, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x5f, 0x64, 0x65, 0x74, 0x65, 0x63, 0x74, 0x18, 0xf2,
+	0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x15, 0x74, 0x63, 0x70, 0x48, 0x79, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x54, 0x72, 0x61, 0x69, 0x6e, 0x44, 0x65, 0x74, 0x65, 0x63, 0x74, 0x12, 0x34, 0x0a, 0x16,
+	0x74, 0x63, 0x70, 0x5f, 0x68, 0x79, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x72, 0x61, 0x69,
+	0x6e, 0x5f, 0x63, 0x77, 0x6e, 0x64, 0x18, 0xf3, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x13, 0x74,
+	0x63, 0x70, 0x48, 0x79, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x72, 0x61, 0x69, 0x6e, 0x43, 0x77,
+	0x6e, 0x64, 0x12, 0x38, 0x0a, 0x18, 0x74, 0x63, 0x70, 0x5f, 0x68, 0x79, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x5f, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x5f, 0x64, 0x65, 0x74, 0x65, 0x63, 0x74, 0x18, 0xf4,
+	0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x15, 0x74, 0x63, 0x70, 0x48, 0x79, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x44, 0x65, 0x6c, 0x61, 0x79, 0x44, 0x65, 0x74, 0x65, 0x63, 0x74, 0x12, 0x34, 0x0a, 0x16,
+	0x74, 0x63, 0x70, 0x5f, 0x68, 0x79, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x64, 0x65, 0x6c, 0x61,
+	0x79, 0x5f, 0x63, 0x77, 0x6e, 0x64, 0x18, 0xf5, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x13, 0x74,
+	0x63, 0x70, 0x48, 0x79, 0x73, 0x74, 0x61, 0x72, 0x74, 0x44, 0x65, 0x6c, 0x61, 0x79, 0x43, 0x77,
+	0x6e, 0x64, 0x12, 0x37, 0x0a, 0x18, 0x74, 0x63, 0x70, 0x5f, 0x61, 0x63, 0x6b, 0x5f, 0x73, 0x6b,
+	0x69, 0x70, 0x70, 0x65, 0x64, 0x5f, 0x73, 0x79, 0x6e, 0x5f, 0x72, 0x65, 0x63, 0x76, 0x18, 0xf6,
+	0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x14, 0x74, 0x63, 0x70, 0x41, 0x63, 0x6b, 0x53, 0x6b, 0x69,
+	0x70, 0x70, 0x65, 0x64, 0x53, 0x79, 0x6e, 0x52, 0x65, 0x63, 0x76, 0x12, 0x30, 0x0a, 0x14, 0x74,
+	0x63, 0x70, 0x5f, 0x61, 0x63, 0x6b, 0x5f, 0x73, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x5f, 0x70,
+	0x61, 0x77, 0x73, 0x18, 0xf7, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x11, 0x74, 0x63, 0x70, 0x41,
+	0x63, 0x6b, 0x53, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x50, 0x61, 0x77, 0x73, 0x12, 0x2e, 0x0a,
+	0x13, 0x74, 0x63, 0x70, 0x5f, 0x61, 0x63, 0x6b, 0x5f, 0x73, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64,
+	0x5f, 0x73, 0x65, 0x71, 0x18, 0xf8, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x74, 0x63, 0x70,
+	0x41, 0x63, 0x6b, 0x53, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x53, 0x65, 0x71, 0x12, 0x39, 0x0a,
+	0x19, 0x74, 0x63, 0x70, 0x5f, 0x61, 0x63, 0x6b, 0x5f, 0x73, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64,
+	0x5f, 0x66, 0x69, 0x6e, 0x5f, 0x77, 0x61, 0x69, 0x74, 0x32, 0x18, 0xf9, 0x03, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x15, 0x74, 0x63, 0x70, 0x41, 0x63, 0x6b, 0x53, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64,
+	0x46, 0x69, 0x6e, 0x57, 0x61, 0x69, 0x74, 0x32, 0x12, 0x39, 0x0a, 0x19, 0x74, 0x63, 0x70, 0x5f,
+	0x61, 0x63, 0x6b, 0x5f, 0x73, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65,
+	0x5f, 0x77, 0x61, 0x69, 0x74, 0x18, 0xfa, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x15, 0x74, 0x63,
+	0x70, 0x41, 0x63, 0x6b, 0x53, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x57,
+	0x61, 0x69, 0x74, 0x12, 0x3a, 0x0a, 0x19, 0x74, 0x63, 0x70, 0x5f, 0x61, 0x63, 0x6b, 0x5f, 0x73,
+	0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x5f, 0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65,
+	0x18, 0xfb, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x16, 0x74, 0x63, 0x70, 0x41, 0x63, 0x6b, 0x53,
+	0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x12,
+	0x23, 0x0a, 0x0d, 0x74, 0x63, 0x70, 0x5f, 0x77, 0x69, 0x6e, 0x5f, 0x70, 0x72, 0x6f, 0x62, 0x65,
+	0x18, 0xfc, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x74, 0x63, 0x70, 0x57, 0x69, 0x6e, 0x50,
+	0x72, 0x6f, 0x62, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x74, 0x63, 0x70, 0x5f, 0x6b, 0x65, 0x65, 0x70,
+	0x5f, 0x61, 0x6c, 0x69, 0x76, 0x65, 0x18, 0xfd, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x74,
+	0x63, 0x70, 0x4b, 0x65, 0x65, 0x70, 0x41, 0x6c, 0x69, 0x76, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x74,
+	0x63, 0x70, 0x5f, 0x6d, 0x74, 0x75, 0x70, 0x5f, 0x66, 0x61, 0x69, 0x6c, 0x18, 0xfe, 0x03, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0b, 0x74, 0x63, 0x70, 0x4d, 0x74, 0x75, 0x70, 0x46, 0x61, 0x69, 0x6c,
+	0x12, 0x29, 0x0a, 0x10, 0x74, 0x63, 0x70, 0x5f, 0x6d, 0x74, 0x75, 0x70, 0x5f, 0x73, 0x75, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x18, 0xff, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x74, 0x63, 0x70,
+	0x4d, 0x74, 0x75, 0x70, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x74,
+	0x63, 0x70, 0x5f, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x65, 0x64, 0x18, 0x80, 0x04, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0c, 0x74, 0x63, 0x70, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x65,
+	0x64, 0x12, 0x29, 0x0a, 0x10, 0x74, 0x63, 0x70, 0x5f, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72,
+	0x65, 0x64, 0x5f, 0x63, 0x65, 0x18, 0x81, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x74, 0x63,
+	0x70, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x65, 0x64, 0x43, 0x65, 0x12, 0x2d, 0x0a, 0x12,
+	0x74, 0x63, 0x70, 0x5f, 0x61, 0x63, 0x6b, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73,
+	0x65, 0x64, 0x18, 0x82, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x74, 0x63, 0x70, 0x41, 0x63,
+	0x6b, 0x43, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x65, 0x64, 0x12, 0x30, 0x0a, 0x14, 0x74,
+	0x63, 0x70, 0x5f, 0x7a, 0x65, 0x72, 0x6f, 0x5f, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x5f, 0x64,
+	0x72, 0x6f, 0x70, 0x18, 0x83, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x11, 0x74, 0x63, 0x70, 0x5a,
+	0x65, 0x72, 0x6f, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x44, 0x72, 0x6f, 0x70, 0x12, 0x24, 0x0a,
+	0x0e, 0x74, 0x63, 0x70, 0x5f, 0x72, 0x63, 0x76, 0x5f, 0x71, 0x5f, 0x64, 0x72, 0x6f, 0x70, 0x18,
+	0x84, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x74, 0x63, 0x70, 0x52, 0x63, 0x76, 0x51, 0x44,
+	0x72, 0x6f, 0x70, 0x12, 0x2c, 0x0a, 0x12, 0x74, 0x63, 0x70, 0x5f, 0x77, 0x71, 0x75, 0x65, 0x75,
+	0x65, 0x5f, 0x74, 0x6f, 0x6f, 0x5f, 0x62, 0x69, 0x67, 0x18, 0x85, 0x04, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0f, 0x74, 0x63, 0x70, 0x57, 0x71, 0x75, 0x65, 0x75, 0x65, 0x54, 0x6f, 0x6f, 0x42, 0x69,
+	0x67, 0x12, 0x40, 0x0a, 0x1d, 0x74, 0x63, 0x70, 0x5f, 0x66, 0x61, 0x73, 0x74, 0x5f, 0x6f, 0x70,
+	0x65, 0x6e, 0x5f, 0x70, 0x61, 0x73, 0x73, 0x69, 0x76, 0x65, 0x5f, 0x61, 0x6c, 0x74, 0x5f, 0x6b,
+	0x65, 0x79, 0x18, 0x86, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x18, 0x74, 0x63, 0x70, 0x46, 0x61,
+	0x73, 0x74, 0x4f, 0x70, 0x65, 0x6e, 0x50, 0x61, 0x73, 0x73, 0x69, 0x76, 0x65, 0x41, 0x6c, 0x74,
+	0x4b, 0x65, 0x79, 0x12, 0x2d, 0x0a, 0x12, 0x74, 0x63, 0x70, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f,
+	0x75, 0x74, 0x5f, 0x72, 0x65, 0x68, 0x61, 0x73, 0x68, 0x18, 0x87, 0x04, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x10, 0x74, 0x63, 0x70, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x52, 0x65, 0x68, 0x61,
+	0x73, 0x68, 0x12, 0x3a, 0x0a, 0x19, 0x74, 0x63, 0x70, 0x5f, 0x64, 0x75, 0x70, 0x6c, 0x69, 0x63,
+	0x61, 0x74, 0x65, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x72, 0x65, 0x68, 0x61, 0x73, 0x68, 0x18,
+	0x88, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x16, 0x74, 0x63, 0x70, 0x44, 0x75, 0x70, 0x6c, 0x69,
+	0x63, 0x61, 0x74, 0x65, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x68, 0x61, 0x73, 0x68, 0x12, 0x2e,
+	0x0a, 0x13, 0x74, 0x63, 0x70, 0x5f, 0x64, 0x73, 0x61, 0x63, 0x6b, 0x5f, 0x72, 0x65, 0x63, 0x76,
+	0x5f, 0x73, 0x65, 0x67, 0x73, 0x18, 0x89, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x74, 0x63,
+	0x70, 0x44, 0x73, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x63, 0x76, 0x53, 0x65, 0x67, 0x73, 0x12, 0x3a,
+	0x0a, 0x19, 0x74, 0x63, 0x70, 0x5f, 0x64, 0x73, 0x61, 0x63, 0x6b, 0x5f, 0x69, 0x67, 0x6e, 0x6f,
+	0x72, 0x65, 0x64, 0x5f, 0x64, 0x75, 0x62, 0x69, 0x6f, 0x75, 0x73, 0x18, 0x8a, 0x04, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x16, 0x74, 0x63, 0x70, 0x44, 0x73, 0x61, 0x63, 0x6b, 0x49, 0x67, 0x6e, 0x6f,
+	0x72, 0x65, 0x64, 0x44, 0x75, 0x62, 0x69, 0x6f, 0x75, 0x73, 0x12, 0x36, 0x0a, 0x17, 0x74, 0x63,
+	0x70, 0x5f, 0x6d, 0x69, 0x67, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x72, 0x65, 0x71, 0x5f, 0x73, 0x75,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x8b, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x14, 0x74, 0x63,
+	0x70, 0x4d, 0x69, 0x67, 0x72, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x53, 0x75, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x12, 0x36, 0x0a, 0x17, 0x74, 0x63, 0x70, 0x5f, 0x6d, 0x69, 0x67, 0x72, 0x61, 0x74,
+	0x65, 0x5f, 0x72, 0x65, 0x71, 0x5f, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x18, 0x8c, 0x04,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x14, 0x74, 0x63, 0x70, 0x4d, 0x69, 0x67, 0x72, 0x61, 0x74, 0x65,
+	0x52, 0x65, 0x71, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x74, 0x63,
+	0x70, 0x5f, 0x70, 0x6c, 0x62, 0x5f, 0x72, 0x65, 0x68, 0x61, 0x73, 0x68, 0x18, 0x8d, 0x04, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0c, 0x74, 0x63, 0x70, 0x50, 0x6c, 0x62, 0x52, 0x65, 0x68, 0x61, 0x73,
+	0x68, 0x12, 0x21, 0x0a, 0x0c, 0x69, 0x63, 0x6d, 0x70, 0x5f, 0x69, 0x6e, 0x5f, 0x6d, 0x73, 0x67,
+	0x73, 0x18, 0xbc, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x69, 0x63, 0x6d, 0x70, 0x49, 0x6e,
+	0x4d, 0x73, 0x67, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x69, 0x63, 0x6d, 0x70, 0x5f, 0x69, 0x6e, 0x5f,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0xbd, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x69,
+	0x63, 0x6d, 0x70, 0x49, 0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x12, 0x2e, 0x0a, 0x13, 0x69,
+	0x63, 0x6d, 0x70, 0x5f, 0x69, 0x6e, 0x5f, 0x63, 0x73, 0x75, 0x6d, 0x5f, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x73, 0x18, 0xbe, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x69, 0x63, 0x6d, 0x70, 0x49,
+	0x6e, 0x43, 0x73, 0x75, 0x6d, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x12, 0x32, 0x0a, 0x15, 0x69,
+	0x63, 0x6d, 0x70, 0x5f, 0x69, 0x6e, 0x5f, 0x64, 0x65, 0x73, 0x74, 0x5f, 0x75, 0x6e, 0x72, 0x65,
+	0x61, 0x63, 0x68, 0x73, 0x18, 0xbf, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x12, 0x69, 0x63, 0x6d,
+	0x70, 0x49, 0x6e, 0x44, 0x65, 0x73, 0x74, 0x55, 0x6e, 0x72, 0x65, 0x61, 0x63, 0x68, 0x73, 0x12,
+	0x2c, 0x0a, 0x12, 0x69, 0x63, 0x6d, 0x70, 0x5f, 0x69, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f,
+	0x65, 0x78, 0x63, 0x64, 0x73, 0x18, 0xc0, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x69, 0x63,
+	0x6d, 0x70, 0x49, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x45, 0x78, 0x63, 0x64, 0x73, 0x12, 0x2c, 0x0a,
+	0x12, 0x69, 0x63, 0x6d, 0x70, 0x5f, 0x69, 0x6e, 0x5f, 0x70, 0x61, 0x72, 0x6d, 0x5f, 0x70, 0x72,
+	0x6f, 0x62, 0x73, 0x18, 0xc1, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x69, 0x63, 0x6d, 0x70,
+	0x49, 0x6e, 0x50, 0x61, 0x72, 0x6d, 0x50, 0x72, 0x6f, 0x62, 0x73, 0x12, 0x2e, 0x0a, 0x13, 0x69,
+	0x63, 0x6d, 0x70, 0x5f, 0x69, 0x6e, 0x5f, 0x73, 0x72, 0x63, 0x5f, 0x71, 0x75, 0x65, 0x6e, 0x63,
+	0x68, 0x73, 0x18, 0xc2, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x69, 0x63, 0x6d, 0x70, 0x49,
+	0x6e, 0x53, 0x72, 0x63, 0x51, 0x75, 0x65, 0x6e, 0x63, 0x68, 0x73, 0x12, 0x2b, 0x0a, 0x11, 0x69,
+	0x63, 0x6d, 0x70, 0x5f, 0x69, 0x6e, 0x5f, 0x72, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x73,
+	0x18, 0xc3, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x69, 0x63, 0x6d, 0x70, 0x49, 0x6e, 0x52,
+	0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x69, 0x63, 0x6d, 0x70,
+	0x5f, 0x69, 0x6e, 0x5f, 0x65, 0x63, 0x68, 0x6f, 0x73, 0x18, 0xc4, 0x05, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0b, 0x69, 0x63, 0x6d, 0x70, 0x49, 0x6e, 0x45, 0x63, 0x68, 0x6f, 0x73, 0x12, 0x2a, 0x0a,
+	0x11, 0x69, 0x63, 0x6d, 0x70, 0x5f, 0x69, 0x6e, 0x5f, 0x65, 0x63, 0x68, 0x6f, 0x5f, 0x72, 0x65,
+	0x70, 0x73, 0x18, 0xc5, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x69, 0x63, 0x6d, 0x70, 0x49,
+	0x6e, 0x45, 0x63, 0x68, 0x6f, 0x52, 0x65, 0x70, 0x73, 0x12, 0x2d, 0x0a, 0x12, 0x69, 0x63, 0x6d,
+	0x70, 0x5f, 0x69, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x73, 0x18,
+	0xc6, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x69, 0x63, 0x6d, 0x70, 0x49, 0x6e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x73, 0x12, 0x34, 0x0a, 0x16, 0x69, 0x63, 0x6d, 0x70,
+	0x5f, 0x69, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x5f, 0x72, 0x65,
+	0x70, 0x73, 0x18, 0xc7, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x13, 0x69, 0x63, 0x6d, 0x70, 0x49,
+	0x6e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x65, 0x70, 0x73, 0x12, 0x2c,
+	0x0a, 0x12, 0x69, 0x63, 0x6d, 0x70, 0x5f, 0x69, 0x6e, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x5f, 0x6d,
+	0x61, 0x73, 0x6b, 0x73, 0x18, 0xc8, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x69, 0x63, 0x6d,
+	0x70, 0x49, 0x6e, 0x41, 0x64, 0x64, 0x72, 0x4d, 0x61, 0x73, 0x6b, 0x73, 0x12, 0x33, 0x0a, 0x16,
+	0x69, 0x63, 0x6d, 0x70, 0x5f, 0x69, 0x6e, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x5f, 0x6d, 0x61, 0x73,
+	0x6b, 0x5f, 0x72, 0x65, 0x70, 0x73, 0x18, 0xc9, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x12, 0x69,
+	0x63, 0x6d, 0x70, 0x49, 0x6e, 0x41, 0x64, 0x64, 0x72, 0x4d, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x70,
+	0x73, 0x12, 0x23, 0x0a, 0x0d, 0x69, 0x63, 0x6d, 0x70, 0x5f, 0x6f, 0x75, 0x74, 0x5f, 0x6d, 0x73,
+	0x67, 0x73, 0x18, 0xca, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x69, 0x63, 0x6d, 0x70, 0x4f,
+	0x75, 0x74, 0x4d, 0x73, 0x67, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x69, 0x63, 0x6d, 0x70, 0x5f, 0x6f,
+	0x75, 0x74, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0xcb, 0x05, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0d, 0x69, 0x63, 0x6d, 0x70, 0x4f, 0x75, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x12,
+	0x3b, 0x0a, 0x1a, 0x69, 0x63, 0x6d, 0x70, 0x5f, 0x6f, 0x75, 0x74, 0x5f, 0x72, 0x61, 0x74, 0x65,
+	0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x5f, 0x67, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x18, 0xcc, 0x05,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x16, 0x69, 0x63, 0x6d, 0x70, 0x4f, 0x75, 0x74, 0x52, 0x61, 0x74,
+	0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x47, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x12, 0x37, 0x0a, 0x18,
+	0x69, 0x63, 0x6d, 0x70, 0x5f, 0x6f, 0x75, 0x74, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x6c, 0x69,
+	0x6d, 0x69, 0x74, 0x5f, 0x68, 0x6f, 0x73, 0x74, 0x18, 0xcd, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x14, 0x69, 0x63, 0x6d, 0x70, 0x4f, 0x75, 0x74, 0x52, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69,
+	0x74, 0x48, 0x6f, 0x73, 0x74, 0x12, 0x34, 0x0a, 0x16, 0x69, 0x63, 0x6d, 0x70, 0x5f, 0x6f, 0x75,
+	0x74, 0x5f, 0x64, 0x65, 0x73, 0x74, 0x5f, 0x75, 0x6e, 0x72, 0x65, 0x61, 0x63, 0x68, 0x73, 0x18,
+	0xce, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x13, 0x69, 0x63, 0x6d, 0x70, 0x4f, 0x75, 0x74, 0x44,
+	0x65, 0x73, 0x74, 0x55, 0x6e, 0x72, 0x65, 0x61, 0x63, 0x68, 0x73, 0x12, 0x2e, 0x0a, 0x13, 0x69,
+	0x63, 0x6d, 0x70, 0x5f, 0x6f, 0x75, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x65, 0x78, 0x63,
+	0x64, 0x73, 0x18, 0xcf, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x69, 0x63, 0x6d, 0x70, 0x4f,
+	0x75, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x45, 0x78, 0x63, 0x64, 0x73, 0x12, 0x2e, 0x0a, 0x13, 0x69,
+	0x63, 0x6d, 0x70, 0x5f, 0x6f, 0x75, 0x74, 0x5f, 0x70, 0x61, 0x72, 0x6d, 0x5f, 0x70, 0x72, 0x6f,
+	0x62, 0x73, 0x18, 0xd0, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x69, 0x63, 0x6d, 0x70, 0x4f,
+	0x75, 0x74, 0x50, 0x61, 0x72, 0x6d, 0x50, 0x72, 0x6f, 0x62, 0x73, 0x12, 0x30, 0x0a, 0x14, 0x69,
+	0x63, 0x6d, 0x70, 0x5f, 0x6f, 0x75, 0x74, 0x5f, 0x73, 0x72, 0x63, 0x5f, 0x71, 0x75, 0x65, 0x6e,
+	0x63, 0x68, 0x73, 0x18, 0xd1, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x11, 0x69, 0x63, 0x6d, 0x70,
+	0x4f, 0x75, 0x74, 0x53, 0x72, 0x63, 0x51, 0x75, 0x65, 0x6e, 0x63, 0x68, 0x73, 0x12, 0x2d, 0x0a,
+	0x12, 0x69, 0x63, 0x6d, 0x70, 0x5f, 0x6f, 0x75, 0x74, 0x5f, 0x72, 0x65, 0x64, 0x69, 0x72, 0x65,
+	0x63, 0x74, 0x73, 0x18, 0xd2, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x69, 0x63, 0x6d, 0x70,
+	0x4f, 0x75, 0x74, 0x52, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x73, 0x12, 0x25, 0x0a, 0x0e,
+	0x69, 0x63, 0x6d, 0x70, 0x5f, 0x6f, 0x75, 0x74, 0x5f, 0x65, 0x63, 0x68, 0x6f, 0x73, 0x18, 0xd3,
+	0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x69, 0x63, 0x6d, 0x70, 0x4f, 0x75, 0x74, 0x45, 0x63,
+	0x68, 0x6f, 0x73, 0x12, 0x2c, 0x0a, 0x12, 0x69, 0x63, 0x6d, 0x70, 0x5f, 0x6f, 0x75, 0x74, 0x5f,
+	0x65, 0x63, 0x68, 0x6f, 0x5f, 0x72, 0x65, 0x70, 0x73, 0x18, 0xd4, 0x05, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0f, 0x69, 0x63, 0x6d, 0x70, 0x4f, 0x75, 0x74, 0x45, 0x63, 0x68, 0x6f, 0x52, 0x65, 0x70,
+	0x73, 0x12, 0x2f, 0x0a, 0x13, 0x69, 0x63, 0x6d, 0x70, 0x5f, 0x6f, 0x75, 0x74, 0x5f, 0x74, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x73, 0x18, 0xd5, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x11, 0x69, 0x63, 0x6d, 0x70, 0x4f, 0x75, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x73, 0x12, 0x36, 0x0a, 0x17, 0x69, 0x63, 0x6d, 0x70, 0x5f, 0x6f, 0x75, 0x74, 0x5f, 0x74,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x5f, 0x72, 0x65, 0x70, 0x73, 0x18, 0xd6, 0x05,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x14, 0x69, 0x63, 0x6d, 0x70, 0x4f, 0x75, 0x74, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x65, 0x70, 0x73, 0x12, 0x2e, 0x0a, 0x13, 0x69, 0x63,
+	0x6d, 0x70, 0x5f, 0x6f, 0x75, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x5f, 0x6d, 0x61, 0x73, 0x6b,
+	0x73, 0x18, 0xd7, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x69, 0x63, 0x6d, 0x70, 0x4f, 0x75,
+	0x74, 0x41, 0x64, 0x64, 0x72, 0x4d, 0x61, 0x73, 0x6b, 0x73, 0x12, 0x35, 0x0a, 0x17, 0x69, 0x63,
+	0x6d, 0x70, 0x5f, 0x6f, 0x75, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x5f, 0x6d, 0x61, 0x73, 0x6b,
+	0x5f, 0x72, 0x65, 0x70, 0x73, 0x18, 0xd8, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x13, 0x69, 0x63,
+	0x6d, 0x70, 0x4f, 0x75, 0x74, 0x41, 0x64, 0x64, 0x72, 0x4d, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x70,
+	0x73, 0x22, 0xec, 0x02, 0x0a, 0x08, 0x48, 0x6f, 0x73, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1c,
+	0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x1f, 0x0a, 0x04,
+	0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0b, 0x2e, 0x4d, 0x65, 0x74,
+	0x72, 0x69, 0x63, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a,
+	0x08, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x61, 0x63,
+	0x68, 0x69, 0x6e, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6d,
+	0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x62, 0x6f, 0x6f, 0x74,
+	0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x62, 0x6f, 0x6f, 0x74, 0x49,
+	0x64, 0x12, 0x25, 0x0a, 0x0e, 0x6b, 0x65, 0x72, 0x6e, 0x65, 0x6c, 0x5f, 0x72, 0x65, 0x6c, 0x65,
+	0x61, 0x73, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6b, 0x65, 0x72, 0x6e, 0x65,
+	0x6c, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x70, 0x75, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x63, 0x70, 0x75, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x1e, 0x0a, 0x0a, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x18, 0x09, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x12,
+	0x2e, 0x0a, 0x13, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72,
+	0x76, 0x61, 0x6c, 0x5f, 0x6d, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x11, 0x63, 0x6f,
+	0x6c, 0x6c, 0x65, 0x63, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x4d, 0x73, 0x12,
+	0x1f, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x0b,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x48, 0x61, 0x73, 0x68,
+	0x22, 0xa0, 0x03, 0x0a, 0x0f, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x12, 0x28, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x14, 0x2e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x16,
+	0x0a, 0x06, 0x63, 0x6f, 0x6f, 0x6b, 0x69, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06,
+	0x63, 0x6f, 0x6f, 0x6b, 0x69, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f,
+	0x61, 0x64, 0x64, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6c, 0x6f, 0x63, 0x61,
+	0x6c, 0x41, 0x64, 0x64, 0x72, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x65, 0x65, 0x72, 0x5f, 0x61, 0x64,
+	0x64, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x65, 0x65, 0x72, 0x41, 0x64,
+	0x64, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x12, 0x22, 0x0a, 0x05,
+	0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0c, 0x2e, 0x53, 0x6f,
+	0x63, 0x6b, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65,
+	0x12, 0x2b, 0x0a, 0x0a, 0x70, 0x72, 0x65, 0x76, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x0c, 0x2e, 0x53, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x53, 0x74, 0x61,
+	0x74, 0x65, 0x52, 0x09, 0x70, 0x72, 0x65, 0x76, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x1d, 0x0a,
+	0x0a, 0x66, 0x69, 0x72, 0x73, 0x74, 0x5f, 0x73, 0x65, 0x65, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x09, 0x66, 0x69, 0x72, 0x73, 0x74, 0x53, 0x65, 0x65, 0x6e, 0x12, 0x1f, 0x0a, 0x0b,
+	0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0a, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x12, 0x1f, 0x0a,
+	0x0b, 0x62, 0x79, 0x74, 0x65, 0x73, 0x5f, 0x61, 0x63, 0x6b, 0x65, 0x64, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0a, 0x62, 0x79, 0x74, 0x65, 0x73, 0x41, 0x63, 0x6b, 0x65, 0x64, 0x12, 0x25,
+	0x0a, 0x0e, 0x62, 0x79, 0x74, 0x65, 0x73, 0x5f, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64,
+	0x18, 0x0b, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x62, 0x79, 0x74, 0x65, 0x73, 0x52, 0x65, 0x63,
+	0x65, 0x69, 0x76, 0x65, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x22, 0x76, 0x0a, 0x0b, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x4d, 0x65, 0x74, 0x72,
+	0x69, 0x63, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x12, 0x1f, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0b,
+	0x2e, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70,
+	0x65, 0x12, 0x28, 0x0a, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x10, 0x2e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x52, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x42, 0x0a, 0x09, 0x54,
+	0x79, 0x70, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1f, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0b, 0x2e, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x54,
+	0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22,
+	0x9e, 0x02, 0x0a, 0x09, 0x46, 0x69, 0x6c, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x23, 0x0a,
+	0x0d, 0x6d, 0x69, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x6d, 0x69, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x12, 0x23, 0x0a, 0x0d, 0x6d, 0x61, 0x78, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x6d, 0x61, 0x78, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2b, 0x0a,
+	0x0b, 0x74, 0x79, 0x70, 0x65, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x18, 0x04, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x0a,
+	0x74, 0x79, 0x70, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6f, 0x66,
+	0x66, 0x73, 0x65, 0x74, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x04, 0x52, 0x07, 0x6f, 0x66, 0x66,
+	0x73, 0x65, 0x74, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x03, 0x52, 0x0a, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x73, 0x12, 0x21, 0x0a, 0x05, 0x74, 0x79, 0x70, 0x65, 0x73, 0x18, 0x07, 0x20,
+	0x03, 0x28, 0x0e, 0x32, 0x0b, 0x2e, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x54, 0x79, 0x70, 0x65,
+	0x52, 0x05, 0x74, 0x79, 0x70, 0x65, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x63, 0x6f, 0x72, 0x72, 0x75,
+	0x70, 0x74, 0x5f, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x04,
+	0x52, 0x0e, 0x63, 0x6f, 0x72, 0x72, 0x75, 0x70, 0x74, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x73,
+	0x22, 0x6b, 0x0a, 0x0b, 0x52, 0x6f, 0x6c, 0x6c, 0x75, 0x70, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x03, 0x6d, 0x69, 0x6e, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x61, 0x78, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x03, 0x6d, 0x61, 0x78, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x75, 0x6d, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x03, 0x73, 0x75, 0x6d, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x61, 0x73,
+	0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x04, 0x6c, 0x61, 0x73, 0x74, 0x22, 0xbf, 0x01,
+	0x0a, 0x0b, 0x52, 0x6f, 0x6c, 0x6c, 0x75, 0x70, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x10, 0x0a,
+	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
+	0x18, 0x0a, 0x07, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x07, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x12, 0x24, 0x0a, 0x06, 0x66, 0x69, 0x65,
+	0x6c, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x52, 0x6f, 0x6c, 0x6c,
+	0x75, 0x70, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x52, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x12,
+	0x1d, 0x0a, 0x0a, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x41, 0x64, 0x64, 0x72, 0x12, 0x1b,
+	0x0a, 0x09, 0x70, 0x65, 0x65, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x70, 0x65, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x12, 0x22, 0x0a, 0x05, 0x73,
+	0x74, 0x61, 0x74, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0c, 0x2e, 0x53, 0x6f, 0x63,
+	0x6b, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x22,
+	0xd2, 0x01, 0x0a, 0x0c, 0x52, 0x6f, 0x6c, 0x6c, 0x75, 0x70, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63,
+	0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x1f,
+	0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0b, 0x2e, 0x4d,
+	0x65, 0x74, 0x72, 0x69, 0x63, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12,
+	0x1e, 0x0a, 0x0a, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0a, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x23, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x0b, 0x2e, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x54, 0x79, 0x70, 0x65, 0x52, 0x06, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x12, 0x24,
+	0x0a, 0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c,
+	0x2e, 0x52, 0x6f, 0x6c, 0x6c, 0x75, 0x70, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x06, 0x67, 0x72,
+	0x6f, 0x75, 0x70, 0x73, 0x22, 0xad, 0x02, 0x0a, 0x0d, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74,
+	0x6f, 0x72, 0x43, 0x6f, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x75,
+	0x6e, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x72, 0x75, 0x6e, 0x73, 0x12, 0x1a,
+	0x0a, 0x08, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x08, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x73, 0x12, 0x28, 0x0a, 0x10, 0x6c, 0x61,
+	0x73, 0x74, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x75, 0x73, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x6c, 0x61, 0x73, 0x74, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x55, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6d, 0x61, 0x78, 0x5f, 0x64, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x75, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x6d,
+	0x61, 0x78, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x55, 0x73, 0x12, 0x2a, 0x0a, 0x11,
+	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x75,
+	0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x44, 0x75,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x55, 0x73, 0x12, 0x2b, 0x0a, 0x12, 0x6c, 0x61, 0x73, 0x74,
+	0x5f, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x5f, 0x77, 0x61, 0x6c, 0x6c, 0x5f, 0x75, 0x73, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x6c, 0x61, 0x73, 0x74, 0x43, 0x68, 0x69, 0x6c, 0x64, 0x57,
+	0x61, 0x6c, 0x6c, 0x55, 0x73, 0x12, 0x2d, 0x0a, 0x13, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x63,
+	0x68, 0x69, 0x6c, 0x64, 0x5f, 0x77, 0x61, 0x6c, 0x6c, 0x5f, 0x75, 0x73, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x10, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x43, 0x68, 0x69, 0x6c, 0x64, 0x57, 0x61,
+	0x6c, 0x6c, 0x55, 0x73, 0x22, 0x89, 0x06, 0x0a, 0x0a, 0x53, 0x65, 0x6c, 0x66, 0x4d, 0x65, 0x74,
+	0x72, 0x69, 0x63, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x12, 0x1f, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x0b, 0x2e, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79,
+	0x70, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x75, 0x70, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x73, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x75, 0x70, 0x74, 0x69, 0x6d, 0x65, 0x53, 0x12, 0x2e, 0x0a,
+	0x0a, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x0e, 0x2e, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x43, 0x6f, 0x73,
+	0x74, 0x52, 0x0a, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x1e, 0x0a,
+	0x0b, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x63, 0x70, 0x75, 0x5f, 0x75, 0x73, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x09, 0x75, 0x73, 0x65, 0x72, 0x43, 0x70, 0x75, 0x55, 0x73, 0x12, 0x22, 0x0a,
+	0x0d, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x5f, 0x63, 0x70, 0x75, 0x5f, 0x75, 0x73, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x43, 0x70, 0x75, 0x55,
+	0x73, 0x12, 0x29, 0x0a, 0x11, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x5f,
+	0x63, 0x70, 0x75, 0x5f, 0x75, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x63, 0x68,
+	0x69, 0x6c, 0x64, 0x55, 0x73, 0x65, 0x72, 0x43, 0x70, 0x75, 0x55, 0x73, 0x12, 0x2d, 0x0a, 0x13,
+	0x63, 0x68, 0x69, 0x6c, 0x64, 0x5f, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x5f, 0x63, 0x70, 0x75,
+	0x5f, 0x75, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x63, 0x68, 0x69, 0x6c, 0x64,
+	0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x43, 0x70, 0x75, 0x55, 0x73, 0x12, 0x1c, 0x0a, 0x0a, 0x6d,
+	0x61, 0x78, 0x5f, 0x72, 0x73, 0x73, 0x5f, 0x6b, 0x62, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x08, 0x6d, 0x61, 0x78, 0x52, 0x73, 0x73, 0x4b, 0x62, 0x12, 0x27, 0x0a, 0x0f, 0x72, 0x65, 0x63,
+	0x6f, 0x72, 0x64, 0x73, 0x5f, 0x77, 0x72, 0x69, 0x74, 0x74, 0x65, 0x6e, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0e, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x57, 0x72, 0x69, 0x74, 0x74,
+	0x65, 0x6e, 0x12, 0x23, 0x0a, 0x0d, 0x62, 0x79, 0x74, 0x65, 0x73, 0x5f, 0x77, 0x72, 0x69, 0x74,
+	0x74, 0x65, 0x6e, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x62, 0x79, 0x74, 0x65, 0x73,
+	0x57, 0x72, 0x69, 0x74, 0x74, 0x65, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x66, 0x6c, 0x75, 0x73, 0x68,
+	0x65, 0x73, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x66, 0x6c, 0x75, 0x73, 0x68, 0x65,
+	0x73, 0x12, 0x19, 0x0a, 0x08, 0x66, 0x6c, 0x75, 0x73, 0x68, 0x5f, 0x75, 0x73, 0x18, 0x0d, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x07, 0x66, 0x6c, 0x75, 0x73, 0x68, 0x55, 0x73, 0x12, 0x14, 0x0a, 0x05,
+	0x73, 0x65, 0x61, 0x6c, 0x73, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x73, 0x65, 0x61,
+	0x6c, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x5f, 0x75, 0x73, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x63, 0x6f, 0x6d, 0x70,
+	0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x55, 0x73, 0x12, 0x28, 0x0a, 0x10, 0x68, 0x65, 0x61,
+	0x70, 0x5f, 0x61, 0x6c, 0x6c, 0x6f, 0x63, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x10, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0e, 0x68, 0x65, 0x61, 0x70, 0x41, 0x6c, 0x6c, 0x6f, 0x63, 0x42, 0x79,
+	0x74, 0x65, 0x73, 0x12, 0x24, 0x0a, 0x0e, 0x68, 0x65, 0x61, 0x70, 0x5f, 0x73, 0x79, 0x73, 0x5f,
+	0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x11, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x68, 0x65, 0x61,
+	0x70, 0x53, 0x79, 0x73, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x79, 0x73,
+	0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x12, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x73, 0x79,
+	0x73, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x15, 0x0a, 0x06, 0x6e, 0x75, 0x6d, 0x5f, 0x67, 0x63,
+	0x18, 0x13, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x6e, 0x75, 0x6d, 0x47, 0x63, 0x12, 0x29, 0x0a,
+	0x11, 0x67, 0x63, 0x5f, 0x70, 0x61, 0x75, 0x73, 0x65, 0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f,
+	0x75, 0x73, 0x18, 0x14, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x67, 0x63, 0x50, 0x61, 0x75, 0x73,
+	0x65, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x55, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x67, 0x63, 0x5f, 0x63,
+	0x70, 0x75, 0x5f, 0x66, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x15, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x0d, 0x67, 0x63, 0x43, 0x70, 0x75, 0x46, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x1e, 0x0a, 0x0a, 0x67, 0x6f, 0x72, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x65, 0x73, 0x18, 0x16,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x67, 0x6f, 0x72, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x65, 0x73,
+	0x2a, 0x61, 0x0a, 0x0a, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x54, 0x79, 0x70, 0x65, 0x12, 0x07,
+	0x0a, 0x03, 0x54, 0x43, 0x50, 0x10, 0x00, 0x12, 0x07, 0x0a, 0x03, 0x4e, 0x49, 0x43, 0x10, 0x01,
+	0x12, 0x07, 0x0a, 0x03, 0x4e, 0x45, 0x54, 0x10, 0x02, 0x12, 0x08, 0x0a, 0x04, 0x48, 0x4f, 0x53,
+	0x54, 0x10, 0x03, 0x12, 0x09, 0x0a, 0x05, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x10, 0x04, 0x12, 0x0d,
+	0x0a, 0x09, 0x54, 0x43, 0x50, 0x5f, 0x44, 0x45, 0x4c, 0x54, 0x41, 0x10, 0x05, 0x12, 0x0a, 0x0a,
+	0x06, 0x52, 0x4f, 0x4c, 0x4c, 0x55, 0x50, 0x10, 0x06, 0x12, 0x08, 0x0a, 0x04, 0x53, 0x45, 0x4c,
+	0x46, 0x10, 0x07, 0x2a, 0xeb, 0x01, 0x0a, 0x0b, 0x53, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x53, 0x74,
+	0x61, 0x74, 0x65, 0x12, 0x13, 0x0a, 0x0f, 0x54, 0x43, 0x50, 0x5f, 0x45, 0x53, 0x54, 0x41, 0x42,
+	0x4c, 0x49, 0x53, 0x48, 0x45, 0x44, 0x10, 0x00, 0x12, 0x10, 0x0a, 0x0c, 0x54, 0x43, 0x50, 0x5f,
+	0x53, 0x59, 0x4e, 0x5f, 0x53, 0x45, 0x4e, 0x54, 0x10, 0x01, 0x12, 0x10, 0x0a, 0x0c, 0x54, 0x43,
+	0x50, 0x5f, 0x53, 0x59, 0x4e, 0x5f, 0x52, 0x45, 0x43, 0x56, 0x10, 0x02, 0x12, 0x11, 0x0a, 0x0d,
+	0x54, 0x43, 0x50, 0x5f, 0x46, 0x49, 0x4e, 0x5f, 0x57, 0x41, 0x49, 0x54, 0x31, 0x10, 0x03, 0x12,
+	0x11, 0x0a, 0x0d, 0x54, 0x43, 0x50, 0x5f, 0x46, 0x49, 0x4e, 0x5f, 0x57, 0x41, 0x49, 0x54, 0x32,
+	0x10, 0x04, 0x12, 0x11, 0x0a, 0x0d, 0x54, 0x43, 0x50, 0x5f, 0x54, 0x49, 0x4d, 0x45, 0x5f, 0x57,
+	0x41, 0x49, 0x54, 0x10, 0x05, 0x12, 0x0d, 0x0a, 0x09, 0x54, 0x43, 0x50, 0x5f, 0x43, 0x4c, 0x4f,
+	0x53, 0x45, 0x10, 0x06, 0x12, 0x12, 0x0a, 0x0e, 0x54, 0x43, 0x50, 0x5f, 0x43, 0x4c, 0x4f, 0x53,
+	0x45, 0x5f, 0x57, 0x41, 0x49, 0x54, 0x10, 0x07, 0x12, 0x10, 0x0a, 0x0c, 0x54, 0x43, 0x50, 0x5f,
+	0x4c, 0x41, 0x53, 0x54, 0x5f, 0x41, 0x43, 0x4b, 0x10, 0x08, 0x12, 0x0e, 0x0a, 0x0a, 0x54, 0x43,
+	0x50, 0x5f, 0x4c, 0x49, 0x53, 0x54, 0x45, 0x4e, 0x10, 0x09, 0x12, 0x0f, 0x0a, 0x0b, 0x54, 0x43,
+	0x50, 0x5f, 0x43, 0x4c, 0x4f, 0x53, 0x49, 0x4e, 0x47, 0x10, 0x0a, 0x12, 0x14, 0x0a, 0x10, 0x54,
+	0x43, 0x50, 0x5f, 0x4e, 0x45, 0x57, 0x5f, 0x53, 0x59, 0x4e, 0x5f, 0x52, 0x45, 0x43, 0x56, 0x10,
+	0x0b, 0x2a, 0x5c, 0x0a, 0x13, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0a, 0x0a, 0x06, 0x4f, 0x50, 0x45, 0x4e,
+	0x45, 0x44, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x43, 0x4c, 0x4f, 0x53, 0x45, 0x44, 0x10, 0x01,
+	0x12, 0x11, 0x0a, 0x0d, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45,
+	0x44, 0x10, 0x02, 0x12, 0x0f, 0x0a, 0x0b, 0x44, 0x49, 0x53, 0x41, 0x50, 0x50, 0x45, 0x41, 0x52,
+	0x45, 0x44, 0x10, 0x03, 0x12, 0x09, 0x0a, 0x05, 0x53, 0x54, 0x55, 0x43, 0x4b, 0x10, 0x04, 0x62,
+	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_proto_tcpmon_proto_rawDescOnce sync.Once
+	file_proto_tcpmon_proto_rawDescData = file_proto_tcpmon_proto_rawDesc
+)
+
+func file_proto_tcpmon_proto_rawDescGZIP() []byte {
+	file_proto_tcpmon_proto_rawDescOnce.Do(func() {
+		file_proto_tcpmon_proto_rawDescData = protoimpl.X.CompressGZIP(file_proto_tcpmon_proto_rawDescData)
+	})
+	return file_proto_tcpmon_proto_rawDescData
+}
+
+var file_proto_tcpmon_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_proto_tcpmon_proto_msgTypes = make([]protoimpl.MessageInfo, 21)
+var file_proto_tcpmon_proto_goTypes = []interface{}{
+	(MetricType)(0),           // 0: MetricType
+	(SocketState)(0),          // 1: SocketState
+	(ConnectionEventType)(0),  // 2: ConnectionEventType
+	(*Metric)(nil),            // 3: Metric
+	(*SocketMemoryUsage)(nil), // 4: SocketMemoryUsage
+	(*TimerInfo)(nil),         // 5: TimerInfo
+	(*ProcessInfo)(nil),       // 6: ProcessInfo
+	(*SocketMetric)(nil),      // 7: SocketMetric
+	(*TcpMetric)(nil),         // 8: TcpMetric
+	(*SocketDelta)(nil),       // 9: SocketDelta
+	(*TcpDelta)(nil),          // 10: TcpDelta
+	(*IfaceMetric)(nil),       // 11: IfaceMetric
+	(*NicMetric)(nil),         // 12: NicMetric
+	(*NetstatMetric)(nil),     // 13: NetstatMetric
+	(*HostInfo)(nil),          // 14: HostInfo
+	(*ConnectionEvent)(nil),   // 15: ConnectionEvent
+	(*EventMetric)(nil),       // 16: EventMetric
+	(*TypeCount)(nil),         // 17: TypeCount
+	(*FileIndex)(nil),         // 18: FileIndex
+	(*RollupField)(nil),       // 19: RollupField
+	(*RollupGroup)(nil),       // 20: RollupGroup
+	(*RollupMetric)(nil),      // 21: RollupMetric
+	(*CollectorCost)(nil),     // 22: CollectorCost
+	(*SelfMetric)(nil),        // 23: SelfMetric
+}
+var file_proto_tcpmon_proto_depIdxs = []int32{
+	8,  // 0: Metric.tcp:type_name -> TcpMetric
+	12, // 1: Metric.nic:type_name -> NicMetric
+	13, // 2: Metric.net:type_name -> NetstatMetric
+	14, // 3: Metric.host:type_name -> HostInfo
+	16, // 4: Metric.event:type_name -> EventMetric
+	10, // 5: Metric.tcp_delta:type_name -> TcpDelta
+	21, // 6: Metric.rollup:type_name -> RollupMetric
+	23, // 7: Metric.self:type_name -> SelfMetric
+	1,  // 8: SocketMetric.state:type_name -> SocketState
+	6,  // 9: SocketMetric.processes:type_name -> ProcessInfo
+	5,  // 10: SocketMetric.timers:type_name -> TimerInfo
+	4,  // 11: SocketMetric.skmem:type_name -> SocketMemoryUsage
+	0,  // 12: TcpMetric.type:type_name -> MetricType
+	7,  // 13: TcpMetric.sockets:type_name -> SocketMetric
+	7,  // 14: SocketDelta.changed:type_name -> SocketMetric
+	7,  // 15: SocketDelta.increments:type_name -> SocketMetric
+	0,  // 16: TcpDelta.type:type_name -> MetricType
+	9,  // 17: TcpDelta.sockets:type_name -> SocketDelta
+	0,  // 18: NicMetric.type:type_name -> MetricType
+	11, // 19: NicMetric.ifaces:type_name -> IfaceMetric
+	0,  // 20: NetstatMetric.type:type_name -> MetricType
+	0,  // 21: HostInfo.type:type_name -> MetricType
+	2,  // 22: ConnectionEvent.type:type_name -> ConnectionEventType
+	1,  // 23: ConnectionEvent.state:type_name -> SocketState
+	1,  // 24: ConnectionEvent.prev_state:type_name -> SocketState
+	0,  // 25: EventMetric.type:type_name -> MetricType
+	15, // 26: EventMetric.events:type_name -> ConnectionEvent
+	0,  // 27: TypeCount.type:type_name -> MetricType
+	17, // 28: FileIndex.type_counts:type_name -> TypeCount
+	0,  // 29: FileIndex.types:type_name -> MetricType
+	19, // 30: RollupGroup.fields:type_name -> RollupField
+	1,  // 31: RollupGroup.state:type_name -> SocketState
+	0,  // 32: RollupMetric.type:type_name -> MetricType
+	0,  // 33: RollupMetric.source:type_name -> MetricType
+	20, // 34: RollupMetric.groups:type_name -> RollupGroup
+	0,  // 35: SelfMetric.type:type_name -> MetricType
+	22, // 36: SelfMetric.collectors:type_name -> CollectorCost
+	37, // [37:37] is the sub-list for method output_type
+	37, // [37:37] is the sub-list for method input_type
+	37, // [37:37] is the sub-list for extension type_name
+	37, // [37:37] is the sub-list for extension extendee
+	0,  // [0:37] is the sub-list for field type_name
 }
 
 func init() { file_proto_tcpmon_proto_init() }
@@ -3878,7 +5558,7 @@ func file_proto_tcpmon_proto_init() {
 			}
 		}
 		file_proto_tcpmon_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*IfaceMetric); i {
+			switch v := v.(*SocketDelta); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3890,7 +5570,7 @@ func file_proto_tcpmon_proto_init() {
 			}
 		}
 		file_proto_tcpmon_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*NicMetric); i {
+			switch v := v.(*TcpDelta); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3902,6 +5582,30 @@ func file_proto_tcpmon_proto_init() {
 			}
 		}
 		file_proto_tcpmon_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IfaceMetric); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_tcpmon_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NicMetric); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_tcpmon_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*NetstatMetric); i {
 			case 0:
 				return &v.state
@@ -3913,19 +5617,144 @@ func file_proto_tcpmon_proto_init() {
 				return nil
 			}
 		}
+		file_proto_tcpmon_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HostInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_tcpmon_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConnectionEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_tcpmon_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EventMetric); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_tcpmon_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TypeCount); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_tcpmon_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FileIndex); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_tcpmon_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RollupField); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_tcpmon_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RollupGroup); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_tcpmon_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RollupMetric); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_tcpmon_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CollectorCost); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_tcpmon_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SelfMetric); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	file_proto_tcpmon_proto_msgTypes[0].OneofWrappers = []interface{}{
 		(*Metric_Tcp)(nil),
 		(*Metric_Nic)(nil),
 		(*Metric_Net)(nil),
+		(*Metric_Host)(nil),
+		(*Metric_Event)(nil),
+		(*Metric_TcpDelta)(nil),
+		(*Metric_Rollup)(nil),
+		(*Metric_Self)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_proto_tcpmon_proto_rawDesc,
-			NumEnums:      2,
-			NumMessages:   9,
+			NumEnums:      3,
+			NumMessages:   21,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -3,11 +3,13 @@ package parsing
 import (
 	"strings"
 
+	"github.com/cockroachdb/errors"
+
 	"github.com/zperf/tcpmon/tcpmon/gproto"
 	"github.com/zperf/tcpmon/tcpmon/tutils"
 )
 
-func ParseIfconfigOutput(nics *gproto.NicMetric, out []string) {
+func ParseIfconfigOutput(nics *gproto.NicMetric, out []string) error {
 	r := &gproto.IfaceMetric{}
 	for _, line := range out {
 		if strings.Contains(line, ": flags=") {
@@ -18,12 +20,18 @@ func ParseIfconfigOutput(nics *gproto.NicMetric, out []string) {
 			r.Name = fields[0]
 		} else if strings.Contains(line, "RX errors ") {
 			fields := strings.Fields(line)
+			if len(fields) < 9 {
+				return errors.Newf("invalid RX errors line: %q", line)
+			}
 			r.RxErrors, _ = tutils.ParseUint64(fields[2])
 			r.RxDropped, _ = tutils.ParseUint64(fields[4])
 			r.RxOverruns, _ = tutils.ParseUint64(fields[6])
 			r.RxFrame, _ = tutils.ParseUint64(fields[8])
 		} else if strings.Contains(line, "TX errors ") {
 			fields := strings.Fields(line)
+			if len(fields) < 11 {
+				return errors.Newf("invalid TX errors line: %q", line)
+			}
 			r.TxErrors, _ = tutils.ParseUint64(fields[2])
 			r.TxDropped, _ = tutils.ParseUint64(fields[4])
 			r.TxOverruns, _ = tutils.ParseUint64(fields[6])
@@ -32,4 +40,6 @@ func ParseIfconfigOutput(nics *gproto.NicMetric, out []string) {
 			nics.Ifaces = append(nics.Ifaces, r)
 		}
 	}
+
+	return nil
 }
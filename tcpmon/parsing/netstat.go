@@ -3,10 +3,8 @@ package parsing
 import (
 	"io"
 	"strconv"
-	"strings"
 
 	"github.com/cockroachdb/errors"
-	"github.com/rs/zerolog/log"
 
 	"github.com/zperf/tcpmon/tcpmon/gproto"
 )
@@ -16,10 +14,9 @@ func ParseNetstat(r io.Reader, m *gproto.NetstatMetric) error {
 }
 
 func ParseNetstatLine(fieldStr string, valueStr string, m *gproto.NetstatMetric) error {
-	t := parseProcStatType(fieldStr)
-	if t != parseProcStatType(valueStr) {
-		log.Fatal().Str("title", fieldStr).Str("value", valueStr).
-			Msg("mismatched title and value")
+	t, fields, values, err := parseProcStatLines(fieldStr, valueStr)
+	if err != nil {
+		return err
 	}
 
 	if t == ProcNetStatMPTcpExt {
@@ -27,9 +24,6 @@ func ParseNetstatLine(fieldStr string, valueStr string, m *gproto.NetstatMetric)
 		return nil
 	}
 
-	fields := strings.Fields(fieldStr)
-	values := strings.Fields(valueStr)
-
 	for i := 1; i < len(fields); i++ {
 		field := fields[i]
 		value, err := strconv.ParseUint(values[i], 10, 64)
@@ -3,7 +3,6 @@ package parsing
 import (
 	"io"
 	"strconv"
-	"strings"
 
 	"github.com/cockroachdb/errors"
 	"github.com/rs/zerolog/log"
@@ -16,10 +15,9 @@ func ParseSnmp(r io.Reader, m *gproto.NetstatMetric) error {
 }
 
 func ParseSnmpLine(fieldStr string, valueStr string, m *gproto.NetstatMetric) error {
-	t := parseProcStatType(fieldStr)
-	if t != parseProcStatType(valueStr) {
-		log.Fatal().Str("title", fieldStr).Str("value", valueStr).
-			Msg("mismatched title and value")
+	t, fields, values, err := parseProcStatLines(fieldStr, valueStr)
+	if err != nil {
+		return err
 	}
 
 	if t == ProcNetStatIcmpMsg || t == ProcNetStatUdpLite {
@@ -27,9 +25,6 @@ func ParseSnmpLine(fieldStr string, valueStr string, m *gproto.NetstatMetric) er
 		return nil
 	}
 
-	fields := strings.Fields(fieldStr)
-	values := strings.Fields(valueStr)
-
 	for i := 1; i < len(fields); i++ {
 		field := fields[i]
 
@@ -165,6 +160,8 @@ func ParseSnmpLine(fieldStr string, valueStr string, m *gproto.NetstatMetric) er
 			m.IpFragFails = value
 		case "FragCreates":
 			m.IpFragCreates = value
+		case "OutTransmits":
+			m.IpOutTransmits = value
 
 		// tcp
 		case "RtoAlgorithm":
@@ -211,7 +208,8 @@ func ParseSnmpLine(fieldStr string, valueStr string, m *gproto.NetstatMetric) er
 			m.UdpMemErrors = value
 
 		default:
-			log.Fatal().Str("field", fields[i]).Msg("unrecognizable snmp field")
+			// newer kernels keep appending counters, skip what we don't know yet
+			log.Debug().Str("type", t).Str("field", field).Msg("unrecognizable snmp field")
 		}
 	}
 
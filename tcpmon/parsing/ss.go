@@ -1,6 +1,7 @@
 package parsing
 
 import (
+	"strconv"
 	"strings"
 
 	"github.com/cockroachdb/errors"
@@ -31,12 +32,12 @@ func init() {
 
 // ToPbState converts string to pb enum
 // From https://sourcegraph.com/github.com/shemminger/iproute2/-/blob/misc/ss.c?L1397
-func ToPbState(s string) gproto.SocketState {
+func ToPbState(s string) (gproto.SocketState, error) {
 	st, ok := socketStateMap[s]
 	if !ok {
-		log.Fatal().Err(errors.Newf("unknown socket state %v", s)).Msg("failed to convert str to socket state")
+		return st, errors.Newf("unknown socket state %v", s)
 	}
-	return st
+	return st, nil
 }
 
 func isRate(s string) bool {
@@ -68,7 +69,9 @@ func setRate(m *gproto.SocketMetric, field string, value float64) {
 func setMetric(m *gproto.SocketMetric, field string) {
 	p := strings.IndexRune(field, ':')
 	if p == -1 {
-		log.Fatal().Str("field", field).Msg("invalid field")
+		// bare words like the congestion control algorithm (bbr, dctcp, ...) or flags we don't know
+		log.Debug().Str("field", field).Msg("unrecognizable field")
+		return
 	}
 	key := field[:p]
 	valueStr := field[p+1:]
@@ -77,7 +80,8 @@ func setMetric(m *gproto.SocketMetric, field string) {
 	case "wscale":
 		q := strings.IndexRune(valueStr, ',')
 		if q == -1 {
-			log.Fatal().Str("field", valueStr).Msg("invalid wscale")
+			log.Warn().Str("field", valueStr).Msg("invalid wscale")
+			return
 		}
 		m.SndWscale, _ = tutils.ParseUint32(valueStr[:q])
 		m.RcvWscale, _ = tutils.ParseUint32(valueStr[q+1:])
@@ -88,7 +92,8 @@ func setMetric(m *gproto.SocketMetric, field string) {
 	case "rtt":
 		q := strings.IndexRune(valueStr, '/')
 		if q == -1 {
-			log.Fatal().Str("field", valueStr).Msg("invalid rtt/rttvar")
+			log.Warn().Str("field", valueStr).Msg("invalid rtt/rttvar")
+			return
 		}
 		m.Rtt, _ = tutils.ParseFloat64(valueStr[:q])
 		m.Rttvar, _ = tutils.ParseFloat64(valueStr[q+1:])
@@ -105,7 +110,8 @@ func setMetric(m *gproto.SocketMetric, field string) {
 	case "retrans":
 		q := strings.IndexRune(valueStr, '/')
 		if q == -1 {
-			log.Fatal().Str("field", valueStr).Msg("invalid retrans")
+			log.Warn().Str("field", valueStr).Msg("invalid retrans")
+			return
 		}
 		m.RetransNow, _ = tutils.ParseUint32(valueStr[:q])
 		m.RetransTotal, _ = tutils.ParseUint32(valueStr[q+1:])
@@ -172,10 +178,35 @@ func setMetric(m *gproto.SocketMetric, field string) {
 	}
 }
 
+// setIdentity parses the socket identity printed by 'ss -e', e.g. uid:1000 ino:26234 sk:5
+// sk is the socket cookie in hex. Old ss prints the kernel address of the socket instead, it's still unique
+// among living sockets.
+func setIdentity(m *gproto.SocketMetric, field string) {
+	key, value, ok := strings.Cut(field, ":")
+	if !ok {
+		return
+	}
+
+	switch key {
+	case "uid":
+		m.Uid, _ = tutils.ParseUint32(value)
+	case "ino":
+		m.Inode, _ = tutils.ParseUint64(value)
+	case "sk":
+		cookie, err := strconv.ParseUint(value, 16, 64)
+		if err != nil {
+			log.Warn().Str("field", field).Msg("invalid socket cookie")
+			return
+		}
+		m.Cookie = cookie
+	}
+}
+
 func parseInfos(m *gproto.SocketMetric, s string) {
 	p := strings.Index(s, ":(")
-	if p == -1 {
-		log.Fatal().Str("field", s).Msg("parse failed")
+	if p == -1 || !strings.HasSuffix(s, ")") || len(s) < p+3 {
+		log.Warn().Str("field", s).Msg("parse failed")
+		return
 	}
 
 	name := s[:p]
@@ -183,6 +214,10 @@ func parseInfos(m *gproto.SocketMetric, s string) {
 		fields := strings.FieldsFunc(s[p+2:len(s)-1], func(r rune) bool {
 			return ',' == r
 		})
+		if len(fields) < 8 {
+			log.Warn().Str("field", s).Msg("invalid skmem")
+			return
+		}
 		skmem := gproto.SocketMemoryUsage{}
 		skmem.RmemAlloc, _ = tutils.ParseUint32(strings.TrimPrefix(fields[0], "r"))
 		skmem.RcvBuf, _ = tutils.ParseUint32(strings.TrimPrefix(fields[1], "rb"))
@@ -200,11 +235,15 @@ func parseInfos(m *gproto.SocketMetric, s string) {
 		fields := strings.FieldsFunc(s[p+2:len(s)-1], func(r rune) bool {
 			return ',' == r
 		})
+		if len(fields) == 0 {
+			log.Warn().Str("field", s).Msg("invalid timer")
+			return
+		}
 		t := &gproto.TimerInfo{}
 		t.Name = fields[0]
 		if len(fields) == 3 {
 			if strings.Contains(fields[1], "min") && strings.HasSuffix(fields[1], "sec") {
-				ExpireTime := strings.Split(strings.TrimSuffix(fields[1], "sec"), "min")
+				ExpireTime := strings.SplitN(strings.TrimSuffix(fields[1], "sec"), "min", 2)
 				ExpireTimeMin, _ := tutils.ParseUint64(ExpireTime[0])
 				ExpireTimeSec, _ := tutils.ParseUint64(ExpireTime[1])
 				t.ExpireTimeUs = ExpireTimeMin*60000000 + ExpireTimeSec*1000000
@@ -222,10 +261,18 @@ func parseInfos(m *gproto.SocketMetric, s string) {
 		}
 		m.Timers = append(m.Timers, t)
 	} else if name == "users" {
+		if !strings.HasPrefix(s[p+2:], "(") || !strings.HasSuffix(s, "))") || len(s) < p+5 {
+			log.Warn().Str("field", s).Msg("invalid users")
+			return
+		}
 		fields := strings.Split(s[p+3:len(s)-2], "),(")
 		for _, field := range fields {
 			p := &gproto.ProcessInfo{}
 			f := strings.Split(field, ",")
+			if len(f) < 3 {
+				log.Warn().Str("field", field).Msg("invalid process")
+				continue
+			}
 			p.Name = strings.Trim(f[0], "\"")
 			p.Pid, _ = tutils.ParseUint32(strings.TrimPrefix(f[1], "pid="))
 			p.Fd, _ = tutils.ParseUint32(strings.TrimPrefix(f[2], "fd="))
@@ -234,10 +281,9 @@ func parseInfos(m *gproto.SocketMetric, s string) {
 	}
 }
 
-func ParseSS(t *gproto.TcpMetric, out []string) {
+func ParseSS(t *gproto.TcpMetric, out []string) error {
 	if len(out) == 0 {
-		log.Fatal().Msg("Command 'ss' outputs empty")
-		return
+		return errors.New("command 'ss' outputs empty")
 	}
 
 	header := out[0]
@@ -245,20 +291,22 @@ func ParseSS(t *gproto.TcpMetric, out []string) {
 		out = out[1:]
 	}
 
-	s := &gproto.SocketMetric{}
+	var s *gproto.SocketMetric
 	for _, line := range out {
 		fields := strings.Fields(line)
-
-		var exist bool
 		if len(fields) == 0 {
-			exist = false
-		} else {
-			_, exist = socketStateMap[fields[0]]
+			continue
 		}
 
-		if exist {
+		state, err := ToPbState(fields[0])
+		if err == nil {
+			if len(fields) < 5 {
+				return errors.Newf("invalid socket line: %q", line)
+			}
+
 			s = &gproto.SocketMetric{}
-			s.State = ToPbState(fields[0])
+			t.Sockets = append(t.Sockets, s)
+			s.State = state
 			m, _ := tutils.ParseUint32(fields[1])
 			s.RecvQ = m
 			n, _ := tutils.ParseInt64(fields[2])
@@ -269,9 +317,12 @@ func ParseSS(t *gproto.TcpMetric, out []string) {
 				if strings.Contains(field, ":(") {
 					// users and timer
 					parseInfos(s, field)
+				} else {
+					// uid, ino and sk
+					setIdentity(s, field)
 				}
 			}
-		} else {
+		} else if s != nil {
 			var lastRateName string
 			for _, field := range fields {
 				switch field {
@@ -325,9 +376,10 @@ func ParseSS(t *gproto.TcpMetric, out []string) {
 					}
 				}
 			}
-			t.Sockets = append(t.Sockets, s)
 		}
 	}
+
+	return nil
 }
 
 func getFirstNumberFromMess(s string) string {
@@ -3,7 +3,7 @@ package parsing
 import (
 	"strings"
 
-	"github.com/rs/zerolog/log"
+	"github.com/cockroachdb/errors"
 )
 
 type ProcStatType = string
@@ -28,18 +28,43 @@ var ProcStatTypes = []ProcStatType{
 	ProcNetStatUdp, ProcNetStatUdpLite, ProcNetStatIcmp, ProcNetStatIcmpMsg,
 }
 
-func parseProcStatType(line string) ProcStatType {
+func parseProcStatType(line string) (ProcStatType, error) {
 	p := strings.Index(line, ": ")
 	if p == -1 {
-		log.Fatal().Str("line", line).Msg("Unrecognizable line")
+		return "", errors.Newf("unrecognizable line: %q", line)
 	}
 	t := line[:p]
 	for _, s := range ProcStatTypes {
 		if t == s {
-			return s
+			return s, nil
 		}
 	}
 
-	log.Fatal().Str("type", t).Msg("Unrecognizable type")
-	return ""
+	return "", errors.Newf("unrecognizable type: %q", t)
+}
+
+// parseProcStatLines checks the title and value lines of /proc/net/snmp or /proc/net/netstat belong to the
+// same section and returns the section type with the split fields.
+func parseProcStatLines(fieldStr string, valueStr string) (ProcStatType, []string, []string, error) {
+	t, err := parseProcStatType(fieldStr)
+	if err != nil {
+		return "", nil, nil, err
+	}
+
+	v, err := parseProcStatType(valueStr)
+	if err != nil {
+		return "", nil, nil, err
+	}
+
+	if t != v {
+		return "", nil, nil, errors.Newf("mismatched title %q and value %q", t, v)
+	}
+
+	fields := strings.Fields(fieldStr)
+	values := strings.Fields(valueStr)
+	if len(fields) != len(values) {
+		return "", nil, nil, errors.Newf("%s: %d titles but %d values", t, len(fields), len(values))
+	}
+
+	return t, fields, values, nil
 }
@@ -0,0 +1,537 @@
+package query
+
+import (
+	"fmt"
+	"net"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"github.com/zperf/tcpmon/tcpmon/gproto"
+	"github.com/zperf/tcpmon/tcpmon/rollup"
+	"github.com/zperf/tcpmon/tcpmon/storage"
+)
+
+// Field is a tag or a field of a row, values are int64, uint64, float64, bool or string
+type Field struct {
+	Name  string
+	Value any
+}
+
+// Row is a socket, an interface, a connection event or a whole metric at the time
+type Row struct {
+	Timestamp int64
+	Tags      []Field // identify the row, e.g. the addresses of a socket
+	Fields    []Field
+}
+
+// Tag returns the value of the tag, empty if there is no such tag
+func (r *Row) Tag(name string) string {
+	for _, t := range r.Tags {
+		if t.Name == name {
+			return fmt.Sprint(t.Value)
+		}
+	}
+	return ""
+}
+
+// source describes how rows are taken from metrics of a type
+type source struct {
+	metricType  gproto.MetricType
+	measurement string
+	row         protoreflect.MessageDescriptor
+	tags        []protoreflect.Name // fields used as tags, process is the name of the first process
+	sockets     bool                // socket filters are supported
+	raw         *source             // the source rolled up, nil if rows are not rollups
+	rows        func(m *gproto.Metric) (int64, []protoreflect.Message)
+}
+
+var sources = map[string]*source{
+	"tcp": {
+		metricType:  gproto.MetricType_TCP,
+		measurement: "tcp",
+		row:         (&gproto.SocketMetric{}).ProtoReflect().Descriptor(),
+		tags:        []protoreflect.Name{"local_addr", "peer_addr", "state", "process"},
+		sockets:     true,
+		rows: func(m *gproto.Metric) (int64, []protoreflect.Message) {
+			rows := make([]protoreflect.Message, 0, len(m.GetTcp().GetSockets()))
+			for _, s := range m.GetTcp().GetSockets() {
+				rows = append(rows, s.ProtoReflect())
+			}
+			return m.GetTcp().GetTimestamp(), rows
+		},
+	},
+	"nic": {
+		metricType:  gproto.MetricType_NIC,
+		measurement: "nic",
+		row:         (&gproto.IfaceMetric{}).ProtoReflect().Descriptor(),
+		tags:        []protoreflect.Name{"name"},
+		rows: func(m *gproto.Metric) (int64, []protoreflect.Message) {
+			rows := make([]protoreflect.Message, 0, len(m.GetNic().GetIfaces()))
+			for _, iface := range m.GetNic().GetIfaces() {
+				rows = append(rows, iface.ProtoReflect())
+			}
+			return m.GetNic().GetTimestamp(), rows
+		},
+	},
+	"net": {
+		metricType:  gproto.MetricType_NET,
+		measurement: "net",
+		row:         (&gproto.NetstatMetric{}).ProtoReflect().Descriptor(),
+		rows: func(m *gproto.Metric) (int64, []protoreflect.Message) {
+			return m.GetNet().GetTimestamp(), []protoreflect.Message{m.GetNet().ProtoReflect()}
+		},
+	},
+	"event": {
+		metricType:  gproto.MetricType_EVENT,
+		measurement: "conn_event",
+		row:         (&gproto.ConnectionEvent{}).ProtoReflect().Descriptor(),
+		tags:        []protoreflect.Name{"type", "local_addr", "peer_addr", "state", "process"},
+		sockets:     true,
+		rows: func(m *gproto.Metric) (int64, []protoreflect.Message) {
+			rows := make([]protoreflect.Message, 0, len(m.GetEvent().GetEvents()))
+			for _, e := range m.GetEvent().GetEvents() {
+				rows = append(rows, e.ProtoReflect())
+			}
+			return m.GetEvent().GetTimestamp(), rows
+		},
+	},
+	"host": {
+		metricType:  gproto.MetricType_HOST,
+		measurement: "host",
+		row:         (&gproto.HostInfo{}).ProtoReflect().Descriptor(),
+		rows: func(m *gproto.Metric) (int64, []protoreflect.Message) {
+			return m.GetHost().GetTimestamp(), []protoreflect.Message{m.GetHost().ProtoReflect()}
+		},
+	},
+	"self": {
+		metricType:  gproto.MetricType_SELF,
+		measurement: "self",
+		row:         (&gproto.SelfMetric{}).ProtoReflect().Descriptor(),
+		rows: func(m *gproto.Metric) (int64, []protoreflect.Message) {
+			return m.GetSelf().GetTimestamp(), []protoreflect.Message{m.GetSelf().ProtoReflect()}
+		},
+	},
+	"self_collector": {
+		metricType:  gproto.MetricType_SELF,
+		measurement: "self_collector",
+		row:         (&gproto.CollectorCost{}).ProtoReflect().Descriptor(),
+		tags:        []protoreflect.Name{"name"},
+		rows: func(m *gproto.Metric) (int64, []protoreflect.Message) {
+			rows := make([]protoreflect.Message, 0, len(m.GetSelf().GetCollectors()))
+			for _, c := range m.GetSelf().GetCollectors() {
+				rows = append(rows, c.ProtoReflect())
+			}
+			return m.GetSelf().GetTimestamp(), rows
+		},
+	},
+}
+
+func init() {
+	sources["rollup_tcp"] = rollupSource(sources["tcp"], "local_addr", "peer_addr", "state")
+	sources["rollup_nic"] = rollupSource(sources["nic"])
+	sources["rollup_net"] = rollupSource(sources["net"])
+}
+
+// rollupSource returns the source of rollups of the raw source, a row for each group of a rollup. The key tag is
+// the interface name of NIC rollups and the connection of TCP rollups, it's empty for the socket table summary.
+func rollupSource(raw *source, tags ...protoreflect.Name) *source {
+	return &source{
+		metricType:  gproto.MetricType_ROLLUP,
+		measurement: "rollup_" + raw.measurement,
+		row:         (&gproto.RollupGroup{}).ProtoReflect().Descriptor(),
+		tags:        append([]protoreflect.Name{"key"}, tags...),
+		raw:         raw,
+		rows: func(m *gproto.Metric) (int64, []protoreflect.Message) {
+			r := m.GetRollup()
+			if r.GetSource() != raw.metricType {
+				return r.GetTimestamp(), nil
+			}
+			rows := make([]protoreflect.Message, 0, len(r.GetGroups()))
+			for _, g := range r.GetGroups() {
+				rows = append(rows, g.ProtoReflect())
+			}
+			return r.GetTimestamp(), rows
+		},
+	}
+}
+
+// header fields of metrics, they are not fields of rows
+var skippedFields = map[protoreflect.Name]bool{
+	"timestamp": true,
+	"type":      true,
+}
+
+// Types returns the metric types can be queried
+func Types() []string {
+	types := make([]string, 0, len(sources))
+	for t := range sources {
+		types = append(types, t)
+	}
+	sort.Strings(types)
+	return types
+}
+
+// Cursor is the position of the next page: rows before the timestamp and the first Skip rows at the timestamp
+// are returned by previous pages
+type Cursor struct {
+	Timestamp int64
+	Skip      int
+}
+
+func ParseCursor(s string) (Cursor, error) {
+	ts, skip, ok := strings.Cut(s, "-")
+	if !ok {
+		return Cursor{}, errors.Newf("invalid cursor %q", s)
+	}
+	var c Cursor
+	var err error
+	c.Timestamp, err = strconv.ParseInt(ts, 10, 64)
+	if err == nil {
+		c.Skip, err = strconv.Atoi(skip)
+	}
+	if err != nil {
+		return Cursor{}, errors.Wrapf(err, "invalid cursor %q", s)
+	}
+	return c, nil
+}
+
+func (c Cursor) String() string {
+	return fmt.Sprintf("%d-%d", c.Timestamp, c.Skip)
+}
+
+// Query selects rows of a metric type in the time range [From, To], zero From or To means unbounded
+type Query struct {
+	From   time.Time
+	To     time.Time
+	Type   string
+	Fields []string // empty for all fields, nested fields are joined by _, e.g. skmem_rmem_alloc
+
+	// Resolution selects the rollups of the level, for rollup types only and required by them. Fields of rollups
+	// are the min, max, avg and last of the source fields in the window, e.g. rtt_max if rtt is selected.
+	Resolution time.Duration
+
+	// socket filters, for tcp and event only
+	States  []gproto.SocketState
+	Local   string // ip, ip:port or :port
+	Peer    string
+	Process string // the name of a process contains it
+
+	Limit  int // rows of the page, 0 for no limit
+	Cursor *Cursor
+
+	source *source
+	fields map[string]bool // nil for all fields
+}
+
+// Validate checks the type, the fields and the filters of the query
+func (q *Query) Validate() error {
+	src, ok := sources[q.Type]
+	if !ok {
+		return errors.Newf("unknown type %q, expect one of %s", q.Type, strings.Join(Types(), ", "))
+	}
+	q.source = src
+
+	if !src.sockets && (len(q.States) != 0 || q.Local != "" || q.Peer != "" || q.Process != "") {
+		return errors.Newf("socket filters are not supported by %s", q.Type)
+	}
+	if src.raw == nil && q.Resolution != 0 {
+		return errors.Newf("resolution is not supported by %s", q.Type)
+	}
+	if src.raw != nil && (q.Resolution < time.Second || q.Resolution%time.Second != 0) {
+		return errors.Newf("resolution of whole seconds is required by %s, e.g. 1m", q.Type)
+	}
+
+	q.fields = nil
+	if len(q.Fields) != 0 {
+		known := make(map[string]bool)
+		for _, name := range FieldNames(q.Type) {
+			known[name] = true
+		}
+		q.fields = make(map[string]bool)
+		for _, name := range q.Fields {
+			if !known[name] {
+				return errors.Newf("unknown field %q of %s", name, q.Type)
+			}
+			q.fields[name] = true
+		}
+	}
+	return nil
+}
+
+// FieldNames returns the fields of rows of the type, tags are not included. Fields of rollups are the fields of
+// the source, the socket table summary of TCP rollups has the sockets of each state, e.g. sockets_established.
+func FieldNames(t string) []string {
+	src, ok := sources[t]
+	if !ok {
+		return nil
+	}
+	if src.raw != nil {
+		src = src.raw
+	}
+	names := make([]string, 0)
+	walkFields(src.row, "", src.tags, func(name string, _ protoreflect.FieldDescriptor) {
+		names = append(names, name)
+	})
+	if src.metricType == gproto.MetricType_TCP {
+		names = append(names, "sockets")
+		for i := 0; i < len(gproto.SocketState_name); i++ {
+			names = append(names, "sockets_"+StateName(gproto.SocketState(i)))
+		}
+	}
+	return names
+}
+
+// walkFields calls fn for each scalar field of the message except tags, nested messages are flattened
+func walkFields(desc protoreflect.MessageDescriptor, prefix string, tags []protoreflect.Name,
+	fn func(name string, fd protoreflect.FieldDescriptor)) {
+	fields := desc.Fields()
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		if skipField(fd, prefix, tags) {
+			continue
+		}
+		if fd.Kind() == protoreflect.MessageKind {
+			walkFields(fd.Message(), prefix+string(fd.Name())+"_", nil, fn)
+			continue
+		}
+		fn(prefix+string(fd.Name()), fd)
+	}
+}
+
+// skipField returns true for lists, and for headers and tags of the row message
+func skipField(fd protoreflect.FieldDescriptor, prefix string, tags []protoreflect.Name) bool {
+	if fd.IsList() || fd.IsMap() {
+		return true
+	}
+	return prefix == "" && (skippedFields[fd.Name()] || isTag(tags, fd.Name()))
+}
+
+func isTag(tags []protoreflect.Name, name protoreflect.Name) bool {
+	for _, t := range tags {
+		if t == name {
+			return true
+		}
+	}
+	return false
+}
+
+// newRow returns the row of the message with the selected fields
+func (q *Query) newRow(ts int64, m protoreflect.Message) *Row {
+	row := &Row{Timestamp: ts}
+	desc := m.Descriptor()
+	for _, tag := range q.source.tags {
+		if tag == "process" {
+			if fd := desc.Fields().ByName("processes"); fd != nil {
+				// the first process of the socket
+				name := ""
+				if list := m.Get(fd).List(); list.Len() != 0 {
+					name = list.Get(0).Message().Interface().(*gproto.ProcessInfo).GetName()
+				}
+				row.Tags = append(row.Tags, Field{Name: "process", Value: name})
+				continue
+			}
+		}
+		fd := desc.Fields().ByName(tag)
+		row.Tags = append(row.Tags, Field{Name: string(tag), Value: value(m.Get(fd), fd)})
+	}
+
+	if q.source.raw != nil {
+		q.appendRollupFields(row, m.Interface().(*gproto.RollupGroup))
+		return row
+	}
+	q.appendFields(row, m, "")
+	return row
+}
+
+// appendRollupFields appends the samples and the aggregations of the selected fields of the rollup group
+func (q *Query) appendRollupFields(row *Row, g *gproto.RollupGroup) {
+	row.Fields = append(row.Fields, Field{Name: "samples", Value: uint64(g.GetSamples())})
+	for _, f := range g.GetFields() {
+		if q.fields != nil && !q.fields[f.GetName()] {
+			continue
+		}
+		row.Fields = append(row.Fields,
+			Field{Name: f.GetName() + "_min", Value: f.GetMin()},
+			Field{Name: f.GetName() + "_max", Value: f.GetMax()},
+			Field{Name: f.GetName() + "_avg", Value: rollup.Avg(g, f)},
+			Field{Name: f.GetName() + "_last", Value: f.GetLast()})
+	}
+}
+
+func (q *Query) appendFields(row *Row, m protoreflect.Message, prefix string) {
+	fields := m.Descriptor().Fields()
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		if skipField(fd, prefix, q.source.tags) {
+			continue
+		}
+		name := prefix + string(fd.Name())
+		if fd.Kind() == protoreflect.MessageKind {
+			q.appendFields(row, m.Get(fd).Message(), name+"_")
+			continue
+		}
+		if q.fields != nil && !q.fields[name] {
+			continue
+		}
+		row.Fields = append(row.Fields, Field{Name: name, Value: value(m.Get(fd), fd)})
+	}
+}
+
+func value(v protoreflect.Value, fd protoreflect.FieldDescriptor) any {
+	switch fd.Kind() {
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind,
+		protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		return v.Int()
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind, protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		return v.Uint()
+	case protoreflect.FloatKind, protoreflect.DoubleKind:
+		return v.Float()
+	case protoreflect.BoolKind:
+		return v.Bool()
+	case protoreflect.EnumKind:
+		name := string(fd.Enum().Values().ByNumber(v.Enum()).Name())
+		return strings.ToLower(strings.TrimPrefix(name, "TCP_"))
+	}
+	return v.String()
+}
+
+// match returns whether the row passes the socket filters
+func (q *Query) match(row *Row) bool {
+	if len(q.States) != 0 {
+		state := row.Tag("state")
+		ok := false
+		for _, s := range q.States {
+			if StateName(s) == state {
+				ok = true
+				break
+			}
+		}
+		if !ok {
+			return false
+		}
+	}
+	if q.Local != "" && !MatchAddr(row.Tag("local_addr"), q.Local) {
+		return false
+	}
+	if q.Peer != "" && !MatchAddr(row.Tag("peer_addr"), q.Peer) {
+		return false
+	}
+	if q.Process != "" && !strings.Contains(row.Tag("process"), q.Process) {
+		return false
+	}
+	return true
+}
+
+// StateName returns the name of the state in rows and filters, e.g. established
+func StateName(state gproto.SocketState) string {
+	return strings.ToLower(strings.TrimPrefix(state.String(), "TCP_"))
+}
+
+// ParseState parses the name of a state, e.g. established, time_wait or TIME-WAIT as ss prints
+func ParseState(s string) (gproto.SocketState, error) {
+	name := "TCP_" + strings.ReplaceAll(strings.ToUpper(strings.TrimSpace(s)), "-", "_")
+	state, ok := gproto.SocketState_value[name]
+	if !ok {
+		return 0, errors.Newf("unknown state %q", s)
+	}
+	return gproto.SocketState(state), nil
+}
+
+// MatchAddr returns whether the address matches the filter: ip, ip:port or :port
+func MatchAddr(addr string, filter string) bool {
+	host, port, err := net.SplitHostPort(addr)
+	if err != nil {
+		return addr == filter
+	}
+
+	fHost, fPort, err := net.SplitHostPort(filter)
+	if err != nil {
+		// ip only
+		return host == strings.Trim(filter, "[]")
+	}
+	return (fHost == "" || fHost == host) && fPort == port
+}
+
+// Writer writes rows of the query in a format
+type Writer interface {
+	WriteRow(row *Row) error
+	// Close ends the result, next is the cursor of the next page, nil if there is no more rows. err is the error
+	// occurred after rows are written.
+	Close(rows int, next *Cursor, err error) error
+}
+
+// Run reads the rows of the query from the reader and writes them to w, in the order of records
+func Run(r *storage.Reader, q *Query, w Writer) error {
+	if q.source == nil {
+		err := q.Validate()
+		if err != nil {
+			return err
+		}
+	}
+
+	from := q.From
+	skip := 0
+	if q.Cursor != nil {
+		if from.IsZero() || q.Cursor.Timestamp > from.Unix() {
+			from = time.Unix(q.Cursor.Timestamp, 0)
+		}
+		skip = q.Cursor.Skip
+	}
+
+	rows := 0
+	var next *Cursor
+	var writeErr error
+	var current int64 // timestamp of the last row
+	atCurrent := 0    // rows at the timestamp, including rows skipped by the cursor
+	err := r.Range(from, q.To, func(buf []byte) error {
+		var m gproto.Metric
+		err := proto.Unmarshal(buf, &m)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		if m.GetRollup().GetResolution() != int64(q.Resolution/time.Second) {
+			return nil
+		}
+		ts, messages := q.source.rows(&m)
+		if len(messages) == 0 {
+			return nil
+		}
+
+		for _, message := range messages {
+			row := q.newRow(ts, message)
+			if !q.match(row) {
+				continue
+			}
+			if ts != current {
+				current = ts
+				atCurrent = 0
+			}
+			if q.Cursor != nil && ts == q.Cursor.Timestamp && atCurrent < skip {
+				atCurrent++
+				continue
+			}
+			if q.Limit > 0 && rows == q.Limit {
+				next = &Cursor{Timestamp: ts, Skip: atCurrent}
+				return storage.ErrStopRange
+			}
+
+			writeErr = w.WriteRow(row)
+			if writeErr != nil {
+				return storage.ErrStopRange
+			}
+			rows++
+			atCurrent++
+		}
+		return nil
+	}, q.source.metricType)
+	if writeErr != nil {
+		return writeErr
+	}
+	return w.Close(rows, next, err)
+}
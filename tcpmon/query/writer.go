@@ -0,0 +1,178 @@
+package query
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+)
+
+const (
+	FormatJSON = "json"
+	FormatLine = "line"
+)
+
+// NewWriter returns a writer of the format: json, or line for the InfluxDB line protocol tagged by the hostname
+func NewWriter(format string, w io.Writer, q *Query, hostname string) (Writer, error) {
+	switch format {
+	case FormatJSON, "":
+		return &jsonWriter{w: w}, nil
+	case FormatLine:
+		if q.source == nil {
+			err := q.Validate()
+			if err != nil {
+				return nil, err
+			}
+		}
+		return &lineWriter{w: w, measurement: q.source.measurement, hostname: hostname}, nil
+	}
+	return nil, errors.Newf("unknown format %q, expect json or line", format)
+}
+
+// ContentType returns the content type of the format
+func ContentType(format string) string {
+	if format == FormatLine {
+		return "text/plain; charset=utf-8"
+	}
+	return "application/json; charset=utf-8"
+}
+
+// jsonWriter writes {"rows":[...],"len":N,"next":"cursor"}, rows are objects of the timestamp, tags and fields.
+// next is omitted on the last page, the error occurred after rows are written is in "error".
+type jsonWriter struct {
+	w       io.Writer
+	started bool
+}
+
+func (j *jsonWriter) WriteRow(row *Row) error {
+	var sb strings.Builder
+	if j.started {
+		sb.WriteByte(',')
+	} else {
+		sb.WriteString(`{"rows":[`)
+		j.started = true
+	}
+	sb.WriteString(`{"timestamp":`)
+	sb.WriteString(strconv.FormatInt(row.Timestamp, 10))
+	for _, fields := range [][]Field{row.Tags, row.Fields} {
+		for _, f := range fields {
+			sb.WriteByte(',')
+			sb.WriteString(strconv.Quote(f.Name))
+			sb.WriteByte(':')
+			buf, err := json.Marshal(f.Value)
+			if err != nil {
+				// NaN or Inf
+				buf = []byte("null")
+			}
+			sb.Write(buf)
+		}
+	}
+	sb.WriteByte('}')
+
+	_, err := io.WriteString(j.w, sb.String())
+	return errors.WithStack(err)
+}
+
+func (j *jsonWriter) Close(rows int, next *Cursor, err error) error {
+	var sb strings.Builder
+	if !j.started {
+		sb.WriteString(`{"rows":[`)
+	}
+	sb.WriteString(`],"len":`)
+	sb.WriteString(strconv.Itoa(rows))
+	if next != nil {
+		sb.WriteString(`,"next":`)
+		sb.WriteString(strconv.Quote(next.String()))
+	}
+	if err != nil {
+		sb.WriteString(`,"error":`)
+		sb.WriteString(strconv.Quote(err.Error()))
+	}
+	sb.WriteString("}\n")
+
+	_, werr := io.WriteString(j.w, sb.String())
+	return errors.WithStack(werr)
+}
+
+// lineWriter writes rows in the line protocol as the export command, timestamps are in seconds. The cursor of the
+// next page and the error are written as comments at the end.
+type lineWriter struct {
+	w           io.Writer
+	measurement string
+	hostname    string
+}
+
+func (l *lineWriter) WriteRow(row *Row) error {
+	if len(row.Fields) == 0 {
+		// a line requires at least a field
+		return nil
+	}
+
+	var sb strings.Builder
+	sb.WriteString(l.measurement)
+	sb.WriteString(",Hostname=")
+	sb.WriteString(escapeTag(l.hostname))
+	for _, t := range row.Tags {
+		v := fmt.Sprint(t.Value)
+		if v == "" {
+			continue
+		}
+		sb.WriteByte(',')
+		sb.WriteString(t.Name)
+		sb.WriteByte('=')
+		sb.WriteString(escapeTag(v))
+	}
+	for i, f := range row.Fields {
+		if i == 0 {
+			sb.WriteByte(' ')
+		} else {
+			sb.WriteByte(',')
+		}
+		sb.WriteString(f.Name)
+		sb.WriteByte('=')
+		switch v := f.Value.(type) {
+		case string:
+			sb.WriteString(strconv.Quote(v))
+		case bool:
+			if v {
+				sb.WriteByte('1')
+			} else {
+				sb.WriteByte('0')
+			}
+		default:
+			sb.WriteString(fmt.Sprint(v))
+		}
+	}
+	sb.WriteByte(' ')
+	sb.WriteString(strconv.FormatInt(row.Timestamp, 10))
+	sb.WriteByte('\n')
+
+	_, err := io.WriteString(l.w, sb.String())
+	return errors.WithStack(err)
+}
+
+func (l *lineWriter) Close(rows int, next *Cursor, err error) error {
+	var sb strings.Builder
+	if next != nil {
+		sb.WriteString("# next: ")
+		sb.WriteString(next.String())
+		sb.WriteByte('\n')
+	}
+	if err != nil {
+		sb.WriteString("# error: ")
+		sb.WriteString(strings.ReplaceAll(err.Error(), "\n", " "))
+		sb.WriteByte('\n')
+	}
+
+	_, werr := io.WriteString(l.w, sb.String())
+	return errors.WithStack(werr)
+}
+
+func escapeTag(s string) string {
+	s = strings.ReplaceAll(s, ",", `\,`)
+	s = strings.ReplaceAll(s, "=", `\=`)
+	return strings.ReplaceAll(s, " ", `\ `)
+}
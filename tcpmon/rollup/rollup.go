@@ -0,0 +1,348 @@
+package rollup
+
+import (
+	"sort"
+	"strings"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"github.com/zperf/tcpmon/tcpmon/conntrack"
+	"github.com/zperf/tcpmon/tcpmon/gproto"
+)
+
+// fields not aggregated, they are not measurements
+var skippedFields = map[protoreflect.Name]bool{
+	"timestamp": true,
+	"type":      true,
+	"cookie":    true,
+	"inode":     true,
+	"uid":       true,
+}
+
+// Aggregator rolls up metrics into windows of the resolution. Metrics of each source type are expected in time
+// order, a window is finished once a metric of a later window comes. Rollups of a finer resolution can be added to
+// roll them up further.
+type Aggregator struct {
+	resolution int64 // in seconds
+	topN       int
+	windows    map[gproto.MetricType]*window // the current window of each source type
+}
+
+// NewAggregator creates an aggregator, TCP rollups keep the top n sockets by retransmissions and by bytes
+func NewAggregator(resolution time.Duration, topN int) *Aggregator {
+	r := int64(resolution / time.Second)
+	if r <= 0 {
+		r = 1
+	}
+	return &Aggregator{
+		resolution: r,
+		topN:       topN,
+		windows:    make(map[gproto.MetricType]*window),
+	}
+}
+
+// Add adds the metric, returns rollups of the windows finished. Metrics other than TCP, NIC, NET and rollups are
+// ignored.
+func (a *Aggregator) Add(m *gproto.Metric) []*gproto.RollupMetric {
+	var source gproto.MetricType
+	var ts int64
+	switch {
+	case m.GetTcp() != nil:
+		source, ts = gproto.MetricType_TCP, m.GetTcp().GetTimestamp()
+	case m.GetNic() != nil:
+		source, ts = gproto.MetricType_NIC, m.GetNic().GetTimestamp()
+	case m.GetNet() != nil:
+		source, ts = gproto.MetricType_NET, m.GetNet().GetTimestamp()
+	case m.GetRollup() != nil:
+		source, ts = m.GetRollup().GetSource(), m.GetRollup().GetTimestamp()
+	default:
+		return nil
+	}
+
+	start := ts - mod(ts, a.resolution)
+	var finished []*gproto.RollupMetric
+	w, ok := a.windows[source]
+	if ok && w.start != start {
+		finished = append(finished, w.rollup(a.topN))
+		ok = false
+	}
+	if !ok {
+		w = newWindow(start, a.resolution, source)
+		a.windows[source] = w
+	}
+
+	switch {
+	case m.GetTcp() != nil:
+		w.addTcp(m.GetTcp())
+	case m.GetNic() != nil:
+		w.addNic(m.GetNic())
+	case m.GetNet() != nil:
+		w.samples++
+		w.group("").addMessage("", m.GetNet())
+	case m.GetRollup() != nil:
+		w.addRollup(m.GetRollup())
+	}
+	return finished
+}
+
+// Flush returns rollups of the unfinished windows
+func (a *Aggregator) Flush() []*gproto.RollupMetric {
+	windows := make([]*window, 0, len(a.windows))
+	for _, w := range a.windows {
+		windows = append(windows, w)
+	}
+	sort.Slice(windows, func(i, j int) bool {
+		return windows[i].source < windows[j].source
+	})
+
+	rollups := make([]*gproto.RollupMetric, 0, len(windows))
+	for _, w := range windows {
+		rollups = append(rollups, w.rollup(a.topN))
+	}
+	a.windows = make(map[gproto.MetricType]*window)
+	return rollups
+}
+
+func mod(a, b int64) int64 {
+	m := a % b
+	if m < 0 {
+		m += b
+	}
+	return m
+}
+
+type window struct {
+	start      int64
+	resolution int64
+	source     gproto.MetricType
+	samples    uint32
+	groups     map[string]*group
+	keys       []string // in the order of the first seen
+}
+
+func newWindow(start int64, resolution int64, source gproto.MetricType) *window {
+	return &window{
+		start:      start,
+		resolution: resolution,
+		source:     source,
+		groups:     make(map[string]*group),
+	}
+}
+
+func (w *window) group(key string) *group {
+	g, ok := w.groups[key]
+	if !ok {
+		g = &group{RollupGroup: &gproto.RollupGroup{Key: key}, fields: make(map[string]*gproto.RollupField)}
+		w.groups[key] = g
+		w.keys = append(w.keys, key)
+	}
+	return g
+}
+
+func (w *window) addTcp(m *gproto.TcpMetric) {
+	w.samples++
+
+	states := make(map[gproto.SocketState]int)
+	for _, s := range m.GetSockets() {
+		states[s.GetState()]++
+
+		g := w.group(conntrack.Key(s))
+		g.LocalAddr = s.GetLocalAddr()
+		g.PeerAddr = s.GetPeerAddr()
+		g.State = s.GetState()
+		g.addMessage("", s)
+	}
+
+	summary := w.group("")
+	summary.Samples++
+	summary.add("sockets", float64(len(m.GetSockets())))
+	for i := 0; i < len(gproto.SocketState_name); i++ {
+		state := gproto.SocketState(i)
+		summary.add("sockets_"+strings.ToLower(strings.TrimPrefix(state.String(), "TCP_")), float64(states[state]))
+	}
+}
+
+func (w *window) addNic(m *gproto.NicMetric) {
+	w.samples++
+	for _, iface := range m.GetIfaces() {
+		w.group(iface.GetName()).addMessage("", iface)
+	}
+}
+
+func (w *window) addRollup(m *gproto.RollupMetric) {
+	w.samples += m.GetSamples()
+	for _, rg := range m.GetGroups() {
+		g := w.group(rg.GetKey())
+		g.merge(rg)
+	}
+}
+
+// rollup returns the rollup of the window. For TCP, only the summary and the top sockets are kept.
+func (w *window) rollup(topN int) *gproto.RollupMetric {
+	r := &gproto.RollupMetric{
+		Timestamp:  w.start,
+		Type:       gproto.MetricType_ROLLUP,
+		Resolution: w.resolution,
+		Source:     w.source,
+		Samples:    w.samples,
+	}
+
+	keys := w.keys
+	if w.source == gproto.MetricType_TCP {
+		keys = w.topKeys(topN)
+	}
+	for _, key := range keys {
+		r.Groups = append(r.Groups, w.groups[key].result())
+	}
+	return r
+}
+
+// topKeys returns the summary and the top n sockets by retransmissions and by bytes in the window
+func (w *window) topKeys(n int) []string {
+	sockets := make([]string, 0, len(w.keys))
+	for _, key := range w.keys {
+		if key != "" {
+			sockets = append(sockets, key)
+		}
+	}
+
+	top := func(score func(g *group) float64) []string {
+		candidates := make([]string, 0)
+		for _, key := range sockets {
+			if score(w.groups[key]) > 0 {
+				candidates = append(candidates, key)
+			}
+		}
+		sort.SliceStable(candidates, func(i, j int) bool {
+			return score(w.groups[candidates[i]]) > score(w.groups[candidates[j]])
+		})
+		if len(candidates) > n {
+			candidates = candidates[:n]
+		}
+		return candidates
+	}
+
+	selected := make(map[string]bool)
+	for _, key := range top(func(g *group) float64 { return g.increase("retrans_total") }) {
+		selected[key] = true
+	}
+	for _, key := range top(func(g *group) float64 {
+		return g.increase("bytes_acked") + g.increase("bytes_received")
+	}) {
+		selected[key] = true
+	}
+
+	keys := make([]string, 0, len(selected)+1)
+	if _, ok := w.groups[""]; ok {
+		keys = append(keys, "")
+	}
+	for _, key := range sockets {
+		if selected[key] {
+			keys = append(keys, key)
+		}
+	}
+	return keys
+}
+
+type group struct {
+	*gproto.RollupGroup
+	fields map[string]*gproto.RollupField
+}
+
+func (g *group) field(name string) *gproto.RollupField {
+	f, ok := g.fields[name]
+	if !ok {
+		f = &gproto.RollupField{Name: name}
+		g.fields[name] = f
+		g.Fields = append(g.Fields, f)
+	}
+	return f
+}
+
+func (g *group) add(name string, v float64) {
+	f, ok := g.fields[name]
+	if !ok {
+		f = g.field(name)
+		f.Min, f.Max = v, v
+	}
+	f.Min = min(f.Min, v)
+	f.Max = max(f.Max, v)
+	f.Sum += v
+	f.Last = v
+}
+
+// addMessage adds a sample of the numeric fields in the message, fields of nested messages are prefixed with the
+// field name, e.g. skmem_rcv_buf
+func (g *group) addMessage(prefix string, m proto.Message) {
+	if prefix == "" {
+		g.Samples++
+	}
+
+	r := m.ProtoReflect()
+	fields := r.Descriptor().Fields()
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		if fd.IsList() || fd.IsMap() || skippedFields[fd.Name()] {
+			continue
+		}
+
+		name := prefix + string(fd.Name())
+		v := r.Get(fd)
+		switch fd.Kind() {
+		case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind,
+			protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+			g.add(name, float64(v.Int()))
+		case protoreflect.Uint32Kind, protoreflect.Fixed32Kind, protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+			g.add(name, float64(v.Uint()))
+		case protoreflect.FloatKind, protoreflect.DoubleKind:
+			g.add(name, v.Float())
+		case protoreflect.MessageKind:
+			g.addMessage(name+"_", v.Message().Interface())
+		}
+	}
+}
+
+// merge merges a rollup group of a finer resolution in time order
+func (g *group) merge(rg *gproto.RollupGroup) {
+	g.Samples += rg.GetSamples()
+	if rg.GetLocalAddr() != "" {
+		g.LocalAddr = rg.GetLocalAddr()
+		g.PeerAddr = rg.GetPeerAddr()
+		g.State = rg.GetState()
+	}
+
+	for _, rf := range rg.GetFields() {
+		f, ok := g.fields[rf.GetName()]
+		if !ok {
+			f = g.field(rf.GetName())
+			f.Min, f.Max = rf.GetMin(), rf.GetMax()
+		}
+		f.Min = min(f.Min, rf.GetMin())
+		f.Max = max(f.Max, rf.GetMax())
+		f.Sum += rf.GetSum()
+		f.Last = rf.GetLast()
+	}
+}
+
+// increase returns the increase of a counter in the window
+func (g *group) increase(name string) float64 {
+	f, ok := g.fields[name]
+	if !ok {
+		return 0
+	}
+	return f.Max - f.Min
+}
+
+func (g *group) result() *gproto.RollupGroup {
+	return g.RollupGroup
+}
+
+// Avg returns the average of the field
+func Avg(g *gproto.RollupGroup, f *gproto.RollupField) float64 {
+	if g.GetSamples() == 0 {
+		return 0
+	}
+	return f.GetSum() / float64(g.GetSamples())
+}
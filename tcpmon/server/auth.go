@@ -0,0 +1,164 @@
+package server
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/sha256"
+	"crypto/subtle"
+	"crypto/tls"
+	"crypto/x509"
+	"net/http"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+	"github.com/gin-gonic/gin"
+	"github.com/spf13/afero"
+
+	"github.com/zperf/tcpmon/tcpmon/tutils"
+)
+
+// Scope is what a bearer token is allowed to do. Admin tokens can do what read tokens can.
+type Scope string
+
+const (
+	ScopeRead  Scope = "read"  // metrics, queries, events and backups
+	ScopeAdmin Scope = "admin" // pins, cluster membership and pprof
+)
+
+// Auth authenticates requests by bearer tokens
+type Auth struct {
+	tokens map[[sha256.Size]byte]Scope // by the hash of the token
+}
+
+func NewAuth() *Auth {
+	return &Auth{tokens: make(map[[sha256.Size]byte]Scope)}
+}
+
+func (a *Auth) Add(scope Scope, token string) error {
+	if scope != ScopeRead && scope != ScopeAdmin {
+		return errors.Newf("unknown scope %q, expect read or admin", scope)
+	}
+	if token == "" {
+		return errors.New("token is empty")
+	}
+	a.tokens[sha256.Sum256([]byte(token))] = scope
+	return nil
+}
+
+// LoadTokenFile adds the tokens in the file, one token per line as "<scope> <token>". Lines starting with # are
+// ignored.
+func (a *Auth) LoadTokenFile(fs afero.Fs, path string) error {
+	buf, err := afero.ReadFile(fs, path)
+	if err != nil {
+		return errors.Wrap(err, "read token file failed")
+	}
+
+	scanner := bufio.NewScanner(bytes.NewReader(buf))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		fields := strings.Fields(line)
+		if len(fields) != 2 {
+			// the line isn't in the error, it may be a token
+			return errors.New("invalid line in token file, <scope> <token> expected")
+		}
+		err = a.Add(Scope(fields[0]), fields[1])
+		if err != nil {
+			return err
+		}
+	}
+	if len(a.tokens) == 0 {
+		return errors.Newf("no token in %s", path)
+	}
+	return nil
+}
+
+// scope returns the scope of the token, false if the token is unknown
+func (a *Auth) scope(token string) (Scope, bool) {
+	h := sha256.Sum256([]byte(token))
+	for k, scope := range a.tokens {
+		if subtle.ConstantTimeCompare(k[:], h[:]) == 1 {
+			return scope, true
+		}
+	}
+	return "", false
+}
+
+// Authorize requires a bearer token of the scope, requests are not authenticated if auth is nil
+func Authorize(auth *Auth, scope Scope) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if auth == nil {
+			return
+		}
+
+		token, ok := strings.CutPrefix(c.GetHeader("Authorization"), "Bearer ")
+		if !ok {
+			c.Header("WWW-Authenticate", `Bearer realm="tcpmon"`)
+			c.AbortWithStatusJSON(http.StatusUnauthorized, tutils.ErrorJSON(errors.New("bearer token required")))
+			return
+		}
+		s, ok := auth.scope(strings.TrimSpace(token))
+		if !ok {
+			c.Header("WWW-Authenticate", `Bearer realm="tcpmon", error="invalid_token"`)
+			c.AbortWithStatusJSON(http.StatusUnauthorized, tutils.ErrorJSON(errors.New("invalid token")))
+			return
+		}
+		if scope == ScopeAdmin && s != ScopeAdmin {
+			c.AbortWithStatusJSON(http.StatusForbidden, tutils.ErrorJSON(errors.New("admin token required")))
+			return
+		}
+	}
+}
+
+// NewTLSConfig loads the certificate and the key of the server. Clients must present a certificate signed by the CA
+// if clientCA is not empty. Returns nil if certFile is empty.
+func NewTLSConfig(fs afero.Fs, certFile string, keyFile string, clientCA string) (*tls.Config, error) {
+	if certFile == "" {
+		if clientCA != "" {
+			return nil, errors.New("client CA is set without a certificate")
+		}
+		return nil, nil
+	}
+
+	certPEM, err := afero.ReadFile(fs, certFile)
+	if err != nil {
+		return nil, errors.Wrap(err, "read certificate failed")
+	}
+	keyPEM, err := afero.ReadFile(fs, keyFile)
+	if err != nil {
+		return nil, errors.Wrap(err, "read key failed")
+	}
+	cert, err := tls.X509KeyPair(certPEM, keyPEM)
+	if err != nil {
+		return nil, errors.Wrap(err, "load certificate failed")
+	}
+
+	config := &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		MinVersion:   tls.VersionTLS12,
+	}
+	if clientCA != "" {
+		pool, err := LoadCertPool(fs, clientCA)
+		if err != nil {
+			return nil, err
+		}
+		config.ClientCAs = pool
+		config.ClientAuth = tls.RequireAndVerifyClientCert
+	}
+	return config, nil
+}
+
+// LoadCertPool loads the PEM encoded certificates in the file
+func LoadCertPool(fs afero.Fs, path string) (*x509.CertPool, error) {
+	buf, err := afero.ReadFile(fs, path)
+	if err != nil {
+		return nil, errors.Wrap(err, "read CA failed")
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(buf) {
+		return nil, errors.Newf("no certificate in %s", path)
+	}
+	return pool, nil
+}
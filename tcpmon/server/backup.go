@@ -0,0 +1,163 @@
+package server
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/gin-gonic/gin"
+	"github.com/klauspost/compress/zstd"
+	"github.com/rs/zerolog/log"
+
+	"github.com/zperf/tcpmon/tcpmon/storage"
+	"github.com/zperf/tcpmon/tcpmon/tutils"
+)
+
+// GetBackup streams a tar archive of the data files and the dictionaries to read them, with a manifest of the host
+// and the checksums of the files at the end. Files being written are packaged up to the records flushed when the
+// request arrives, no file is sealed for the backup. The archive is encrypted if the server has a keyring.
+// from, to: unix timestamp or local time in tutils.TimeFormat, only files overlapping the time range are packaged
+// types: tcp, nic, net, event, host or rollup, separated by comma. All types if not given
+// pinned: include pinned files, true by default
+// format: tar, or tar.zst for an archive compressed with zstd. Uncompressed archives are sent with the zstd content
+// encoding if the client accepts it
+func GetBackup(mon *Monitor) func(c *gin.Context) {
+	hostname := tutils.Hostname()
+	keyring := mon.datastore.Keyring()
+
+	return func(c *gin.Context) {
+		config, err := newBackupConfig(c)
+		if err != nil {
+			c.AbortWithStatusJSON(http.StatusBadRequest, tutils.ErrorJSON(err))
+			return
+		}
+		format := c.DefaultQuery("format", "tar")
+		if format != "tar" && format != "tar.zst" {
+			c.AbortWithStatusJSON(http.StatusBadRequest,
+				tutils.ErrorJSON(errors.Newf("unknown format %q, expect tar or tar.zst", format)))
+			return
+		}
+
+		filename := tutils.SafeFilename(fmt.Sprintf("tcpmon-datastore-%s.%s", hostname, format))
+		if keyring != nil {
+			filename += ".enc"
+		}
+		c.Writer.Header().Set("Content-Type", "application/octet-stream")
+		c.Writer.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="%s"`, filename))
+
+		// writers are closed in the reverse order, from the archive to the response
+		var w io.Writer = c.Writer
+		closers := make([]io.Closer, 0)
+		if format == "tar" && keyring == nil && acceptsZstd(c.GetHeader("Accept-Encoding")) {
+			c.Writer.Header().Set("Content-Encoding", "zstd")
+			c.Writer.Header().Add("Vary", "Accept-Encoding")
+			z, err := zstd.NewWriter(w)
+			if err != nil {
+				c.AbortWithStatusJSON(http.StatusInternalServerError, tutils.ErrorJSON(err))
+				return
+			}
+			w = z
+			closers = append(closers, z)
+		}
+		// sealed files are packaged as they are on disk, the archive is encrypted as a whole as well
+		encrypted, err := storage.EncryptTo(keyring, w)
+		if err != nil {
+			c.AbortWithStatusJSON(http.StatusInternalServerError, tutils.ErrorJSON(err))
+			return
+		}
+		w = encrypted
+		closers = append(closers, encrypted)
+		if format == "tar.zst" {
+			z, err := zstd.NewWriter(w)
+			if err != nil {
+				c.AbortWithStatusJSON(http.StatusInternalServerError, tutils.ErrorJSON(err))
+				return
+			}
+			w = z
+			closers = append(closers, z)
+		}
+
+		manifest, err := mon.datastore.Backup(w, config)
+		for i := len(closers) - 1; i >= 0 && err == nil; i-- {
+			err = closers[i].Close()
+		}
+		if err != nil {
+			// the archive isn't completed, encoders are released without writing the end of it
+			for _, closer := range closers {
+				if z, ok := closer.(*zstd.Encoder); ok {
+					z.Reset(io.Discard)
+					_ = z.Close()
+				}
+			}
+			if !c.Writer.Written() {
+				c.Writer.Header().Del("Content-Encoding")
+				c.Writer.Header().Del("Content-Disposition")
+				c.AbortWithStatusJSON(http.StatusInternalServerError, tutils.ErrorJSON(err))
+				return
+			}
+			// the archive has been partially sent, the response can't be changed
+			log.Warn().Err(err).Msg("Write backup failed")
+			return
+		}
+		log.Info().Int("files", len(manifest.Files)).Str("filename", filename).Msg("Backup sent")
+	}
+}
+
+func newBackupConfig(c *gin.Context) (*storage.BackupConfig, error) {
+	config := storage.NewBackupConfig()
+
+	// pinned files are included unless pinned=false
+	pinned, err := strconv.ParseBool(c.DefaultQuery("pinned", "true"))
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid pinned")
+	}
+	config.WithPinned(pinned)
+
+	var from, to time.Time
+	if s := c.Query("from"); s != "" {
+		ts, err := ParseTime(s)
+		if err != nil {
+			return nil, err
+		}
+		from = time.Unix(ts, 0)
+	}
+	if s := c.Query("to"); s != "" {
+		ts, err := ParseTime(s)
+		if err != nil {
+			return nil, err
+		}
+		to = time.Unix(ts, 0)
+	}
+	config.WithTimeRange(from, to)
+
+	if s := c.Query("types"); s != "" {
+		types := make([]string, 0)
+		for _, t := range strings.Split(s, ",") {
+			types = append(types, strings.ToLower(strings.TrimSpace(t)))
+		}
+		config.WithTypes(types...)
+	}
+
+	return config, config.Validate()
+}
+
+// acceptsZstd returns true if the Accept-Encoding header accepts zstd, e.g. gzip, zstd;q=0.8
+func acceptsZstd(header string) bool {
+	for _, part := range strings.Split(header, ",") {
+		name, params, _ := strings.Cut(part, ";")
+		if strings.TrimSpace(name) != "zstd" {
+			continue
+		}
+		q, ok := strings.CutPrefix(strings.TrimSpace(params), "q=")
+		if !ok {
+			return true
+		}
+		v, err := strconv.ParseFloat(q, 64)
+		return err == nil && v > 0
+	}
+	return false
+}
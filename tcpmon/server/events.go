@@ -0,0 +1,181 @@
+package server
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/gin-gonic/gin"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/zperf/tcpmon/tcpmon/gproto"
+	"github.com/zperf/tcpmon/tcpmon/tutils"
+)
+
+// EventBuffer keeps the recent connection events in memory
+type EventBuffer struct {
+	mutex  sync.Mutex
+	events []*gproto.ConnectionEvent
+	next   int // the next position to write
+	full   bool
+}
+
+func NewEventBuffer(size int) *EventBuffer {
+	if size <= 0 {
+		size = 1
+	}
+	return &EventBuffer{events: make([]*gproto.ConnectionEvent, size)}
+}
+
+func (b *EventBuffer) Add(events []*gproto.ConnectionEvent) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	for _, e := range events {
+		b.events[b.next] = e
+		b.next++
+		if b.next == len(b.events) {
+			b.next = 0
+			b.full = true
+		}
+	}
+}
+
+// List returns events matching the filter, from the oldest to the newest
+func (b *EventBuffer) List(filter func(e *gproto.ConnectionEvent) bool) []*gproto.ConnectionEvent {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	var events []*gproto.ConnectionEvent
+	if b.full {
+		events = append(events, b.events[b.next:]...)
+	}
+	events = append(events, b.events[:b.next]...)
+
+	r := make([]*gproto.ConnectionEvent, 0)
+	for _, e := range events {
+		if filter(e) {
+			r = append(r, e)
+		}
+	}
+	return r
+}
+
+// diffSockets derives connection events from the socket table, returns the marshaled event metric or nil if
+// there is no event
+func (m *Monitor) diffSockets(now time.Time, table *gproto.TcpMetric) ([]byte, error) {
+	events := m.differ.Diff(table)
+	if len(events) == 0 {
+		return nil, nil
+	}
+	m.events.Add(events)
+
+	metric := &gproto.Metric{Body: &gproto.Metric_Event{Event: &gproto.EventMetric{
+		Timestamp: now.Unix(),
+		Type:      gproto.MetricType_EVENT,
+		Events:    events,
+	}}}
+	m.stream.Publish(metric)
+
+	buf, err := proto.Marshal(metric)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return buf, nil
+}
+
+// GetEvents lists recent connection events
+// since: unix timestamp or local time in tutils.TimeFormat
+// type: opened, closed, state_changed, disappeared or stuck, separated by comma
+// addr: local or peer address contains it
+// limit: returns the newest N events
+func GetEvents(mon *Monitor) func(c *gin.Context) {
+	return func(c *gin.Context) {
+		filter, err := newEventFilter(c)
+		if err != nil {
+			c.AbortWithStatusJSON(http.StatusBadRequest, tutils.ErrorJSON(err))
+			return
+		}
+
+		events := mon.events.List(filter)
+		limit, err := strconv.Atoi(c.DefaultQuery("limit", "0"))
+		if err != nil {
+			c.AbortWithStatusJSON(http.StatusBadRequest, tutils.ErrorJSON(errors.Wrap(err, "invalid limit")))
+			return
+		}
+		if limit > 0 && len(events) > limit {
+			events = events[len(events)-limit:]
+		}
+
+		r := make([]json.RawMessage, 0, len(events))
+		for _, e := range events {
+			buf, err := protojson.Marshal(e)
+			if err != nil {
+				c.AbortWithStatusJSON(http.StatusInternalServerError, tutils.ErrorJSON(err))
+				return
+			}
+			r = append(r, buf)
+		}
+
+		c.JSON(http.StatusOK, gin.H{
+			"len":    len(r),
+			"events": r,
+		})
+	}
+}
+
+func newEventFilter(c *gin.Context) (func(e *gproto.ConnectionEvent) bool, error) {
+	var since int64
+	if s := c.Query("since"); s != "" {
+		var err error
+		since, err = ParseTime(s)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	types := make(map[gproto.ConnectionEventType]bool)
+	if s := c.Query("type"); s != "" {
+		for _, name := range strings.Split(s, ",") {
+			t, ok := gproto.ConnectionEventType_value[strings.ToUpper(strings.TrimSpace(name))]
+			if !ok {
+				return nil, errors.Newf("unknown event type %q", name)
+			}
+			types[gproto.ConnectionEventType(t)] = true
+		}
+	}
+
+	addr := c.Query("addr")
+
+	return func(e *gproto.ConnectionEvent) bool {
+		if e.GetTimestamp() < since {
+			return false
+		}
+		if len(types) != 0 && !types[e.GetType()] {
+			return false
+		}
+		if addr != "" && !strings.Contains(e.GetLocalAddr(), addr) && !strings.Contains(e.GetPeerAddr(), addr) {
+			return false
+		}
+		return true
+	}, nil
+}
+
+// ParseTime parses a unix timestamp or local time in tutils.TimeFormat, returns the unix timestamp
+func ParseTime(s string) (int64, error) {
+	ts, err := strconv.ParseInt(s, 10, 64)
+	if err == nil {
+		return ts, nil
+	}
+
+	t, err := time.ParseInLocation(tutils.TimeFormat, s, time.Local)
+	if err != nil {
+		return 0, errors.Wrapf(err, "invalid time %q", s)
+	}
+	return t.Unix(), nil
+}
@@ -0,0 +1,211 @@
+package server
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/zperf/tcpmon/tcpmon/storage"
+)
+
+// Collectors reported by the health checks
+const (
+	CollectorSocket = "socket"
+	CollectorNic    = "nic"
+	CollectorNet    = "net"
+	CollectorHost   = "host"
+)
+
+// writeQueueSize is the capacity of the queue of collected records to the writer
+const writeQueueSize = 256
+
+// CollectorHealth is the result of the recent runs of a collector
+type CollectorHealth struct {
+	LastSuccess         time.Time `json:"lastSuccess"`
+	LastFailure         time.Time `json:"lastFailure"`
+	LastError           string    `json:"lastError,omitempty"`
+	ConsecutiveFailures int       `json:"consecutiveFailures"`
+	Successes           uint64    `json:"successes"`
+	Failures            uint64    `json:"failures"`
+}
+
+// Health records the runs of collectors
+type Health struct {
+	mutex      sync.Mutex
+	started    time.Time
+	collectors map[string]*CollectorHealth
+}
+
+func NewHealth(now time.Time, collectors ...string) *Health {
+	h := &Health{
+		started:    now,
+		collectors: make(map[string]*CollectorHealth),
+	}
+	for _, name := range collectors {
+		h.collectors[name] = &CollectorHealth{}
+	}
+	return h
+}
+
+func (h *Health) collector(name string) *CollectorHealth {
+	c, ok := h.collectors[name]
+	if !ok {
+		c = &CollectorHealth{}
+		h.collectors[name] = c
+	}
+	return c
+}
+
+func (h *Health) Success(name string, now time.Time) {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+
+	c := h.collector(name)
+	c.LastSuccess = now
+	c.ConsecutiveFailures = 0
+	c.Successes++
+}
+
+func (h *Health) Failure(name string, now time.Time, err error) {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+
+	c := h.collector(name)
+	c.LastFailure = now
+	c.LastError = err.Error()
+	c.ConsecutiveFailures++
+	c.Failures++
+}
+
+// Collectors returns a copy of the health of collectors
+func (h *Health) Collectors() map[string]CollectorHealth {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+
+	collectors := make(map[string]CollectorHealth, len(h.collectors))
+	for name, c := range h.collectors {
+		collectors[name] = *c
+	}
+	return collectors
+}
+
+type HealthCheck struct {
+	Name    string `json:"name"`
+	OK      bool   `json:"ok"`
+	Message string `json:"message,omitempty"`
+}
+
+type QuorumHealth struct {
+	Members     int `json:"members"`
+	HealthScore int `json:"healthScore"` // 0 is healthy, see memberlist.GetHealthScore
+}
+
+type HealthReport struct {
+	OK            bool                       `json:"ok"`
+	Checks        []HealthCheck              `json:"checks"`
+	Collectors    map[string]CollectorHealth `json:"collectors"`
+	QueueDepth    int                        `json:"queueDepth"` // records waiting for the writer
+	QueueCapacity int                        `json:"queueCapacity"`
+	Storage       storage.WriteStats         `json:"storage"`
+	Quorum        *QuorumHealth              `json:"quorum,omitempty"`
+}
+
+func (r *HealthReport) check(name string, ok bool, format string, args ...any) {
+	c := HealthCheck{Name: name, OK: ok}
+	if !ok {
+		c.Message = fmt.Sprintf(format, args...)
+	}
+	r.Checks = append(r.Checks, c)
+	r.OK = r.OK && ok
+}
+
+// Health returns the health of collectors
+func (m *Monitor) Health() *Health {
+	return m.health
+}
+
+// HealthReport checks collectors, the writer queue and storage writes. A collector fails after max failures in a
+// row, or without success in max age. Readiness requires the monitor running and every collector to have succeeded.
+func (m *Monitor) HealthReport(now time.Time, ready bool) *HealthReport {
+	r := &HealthReport{
+		OK:            true,
+		Checks:        make([]HealthCheck, 0),
+		Collectors:    m.health.Collectors(),
+		QueueDepth:    len(m.tx),
+		QueueCapacity: cap(m.tx),
+		Storage:       m.datastore.WriteStats(),
+	}
+	maxFailures := m.config.HealthMaxFailures
+	maxAge := m.config.HealthMaxAge
+
+	if ready {
+		r.check("running", m.running.Load(), "not started or shutting down")
+	}
+
+	names := make([]string, 0, len(r.Collectors))
+	for name := range r.Collectors {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		c := r.Collectors[name]
+		check := "collector:" + name
+		last := c.LastSuccess
+		if last.IsZero() {
+			if ready {
+				r.check(check, false, "no success yet")
+				continue
+			}
+			last = m.health.started
+		}
+		switch {
+		case maxFailures > 0 && c.ConsecutiveFailures >= maxFailures:
+			r.check(check, false, "%d failures in a row: %s", c.ConsecutiveFailures, c.LastError)
+		case maxAge > 0 && now.Sub(last) > maxAge:
+			r.check(check, false, "no success in %v", now.Sub(last).Truncate(time.Second))
+		default:
+			r.check(check, true, "")
+		}
+	}
+
+	maxDepth := m.config.HealthMaxQueueDepth
+	r.check("write-queue", maxDepth <= 0 || r.QueueDepth < maxDepth, "%d records waiting for the writer",
+		r.QueueDepth)
+	recentError := !r.Storage.LastErrorTime.IsZero() && (maxAge <= 0 || now.Sub(r.Storage.LastErrorTime) <= maxAge)
+	r.check("storage", !recentError, "write failed at %v: %s", r.Storage.LastErrorTime.Format(time.RFC3339),
+		r.Storage.LastError)
+
+	if m.quorum != nil {
+		r.Quorum = &QuorumHealth{
+			Members:     m.quorum.mlist.NumMembers(),
+			HealthScore: m.quorum.mlist.GetHealthScore(),
+		}
+	}
+	return r
+}
+
+// GetHealthz reports if tcpmon is working, 503 if a check fails
+func GetHealthz(mon *Monitor) func(c *gin.Context) {
+	return func(c *gin.Context) {
+		writeHealthReport(c, mon.HealthReport(time.Now(), false))
+	}
+}
+
+// GetReadyz reports if tcpmon is running and has collected every metric, 503 if a check fails
+func GetReadyz(mon *Monitor) func(c *gin.Context) {
+	return func(c *gin.Context) {
+		writeHealthReport(c, mon.HealthReport(time.Now(), true))
+	}
+}
+
+func writeHealthReport(c *gin.Context, r *HealthReport) {
+	code := http.StatusOK
+	if !r.OK {
+		code = http.StatusServiceUnavailable
+	}
+	c.JSON(code, r)
+}
@@ -1,63 +1,49 @@
 package server
 
 import (
-	"fmt"
 	"net/http"
 
 	"github.com/cockroachdb/errors"
 	"github.com/gin-contrib/pprof"
 	"github.com/gin-gonic/gin"
 	"github.com/rs/zerolog/log"
-
-	"github.com/zperf/tcpmon/tcpmon/storage"
-	"github.com/zperf/tcpmon/tcpmon/tutils"
 )
 
+// RegisterRoutes registers the routes, writes and pprof require an admin token if the monitor has an Auth. Health
+// checks don't require a token.
 func RegisterRoutes(router *gin.Engine, mon *Monitor) {
-	router.GET("/", GetHome)
-	router.GET("/backup", GetBackup(mon))
+	read := router.Group("", Authorize(mon.config.Auth, ScopeRead))
+	admin := router.Group("", Authorize(mon.config.Auth, ScopeAdmin))
+
+	// probes of systemd, load balancers and orchestrators are not authenticated
+	router.GET("/healthz", GetHealthz(mon))
+	router.GET("/readyz", GetReadyz(mon))
+
+	read.GET("/", GetHome)
+	read.GET("/backup", GetBackup(mon))
+	read.GET("/events", GetEvents(mon))
+	read.GET("/storage", GetStorage(mon))
+	read.GET("/metrics", GetMetrics(mon))
+	read.GET("/self", GetSelf(mon))
+	read.GET("/api/v1/query", GetQuery(mon))
+	read.GET("/stream", GetStream(mon))
+	read.GET("/pins", GetPins(mon))
+	admin.POST("/pins", PostPin(mon))
+	admin.DELETE("/pins/:id", DeletePin(mon))
 
 	if mon.quorum != nil {
-		router.GET("/members", GetMember(mon.quorum))
-		router.POST("/members", JoinCluster(mon.quorum))
-		router.POST("/members/leave", LeaveCluster(mon.quorum))
+		read.GET("/members", GetMember(mon.quorum))
+		admin.POST("/members", JoinCluster(mon.quorum))
+		admin.POST("/members/leave", LeaveCluster(mon.quorum))
 	}
 
-	pprof.Register(router)
+	pprof.RouteRegister(admin)
 }
 
 func GetHome(c *gin.Context) {
 	c.JSON(http.StatusOK, gin.H{"service": "tcpmon"})
 }
 
-func GetBackup(mon *Monitor) func(c *gin.Context) {
-	hostname := tutils.Hostname()
-	filename := tutils.SafeFilename(fmt.Sprintf("tcpmon-datastore-%s.tar", hostname))
-
-	return func(c *gin.Context) {
-		c.Writer.Header().Set("Content-Type", "application/octet-stream")
-		c.Writer.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="%s"`, filename))
-
-		err := mon.datastore.NextFile()
-		if err != nil {
-			c.AbortWithStatusJSON(http.StatusInternalServerError, tutils.ErrorJSON(err))
-			return
-		}
-
-		r, err := storage.NewDataStoreReader(storage.NewReaderConfig(mon.datastore.BaseDir()).WithSuffix(storage.SealFileSuffix))
-		if err != nil {
-			c.AbortWithStatusJSON(http.StatusInternalServerError, tutils.ErrorJSON(err))
-			return
-		}
-
-		err = r.Package(c.Writer)
-		if err != nil {
-			c.AbortWithStatusJSON(http.StatusInternalServerError, tutils.ErrorJSON(err))
-			return
-		}
-	}
-}
-
 func (m *Monitor) startHttpServer(addr string) {
 	gin.SetMode(gin.ReleaseMode)
 
@@ -67,13 +53,23 @@ func (m *Monitor) startHttpServer(addr string) {
 	RegisterRoutes(engine, m)
 
 	m.httpServer = &http.Server{
-		Addr:    addr,
-		Handler: engine,
+		Addr:      addr,
+		Handler:   engine,
+		TLSConfig: m.config.TLSConfig,
 	}
-	log.Info().Str("addr", addr).Msg("HTTP server started")
+	log.Info().Str("addr", addr).
+		Bool("tls", m.config.TLSConfig != nil).
+		Bool("auth", m.config.Auth != nil).
+		Msg("HTTP server started")
 
 	go func(srv *http.Server) {
-		err := srv.ListenAndServe()
+		var err error
+		if srv.TLSConfig != nil {
+			// the certificate is in the TLS config
+			err = srv.ListenAndServeTLS("", "")
+		} else {
+			err = srv.ListenAndServe()
+		}
 		if err != nil && !errors.Is(err, http.ErrServerClosed) {
 			log.Fatal().Err(errors.WithStack(err)).Msg("Serve HTTP service failed")
 		}
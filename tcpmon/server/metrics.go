@@ -0,0 +1,70 @@
+package server
+
+import (
+	"bytes"
+	"net/http"
+	"sync"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/zperf/tcpmon/tcpmon/export/prometheus"
+	"github.com/zperf/tcpmon/tcpmon/gproto"
+	"github.com/zperf/tcpmon/tcpmon/tutils"
+)
+
+// LatestMetrics keeps the latest metric of each type in memory for /metrics. Metrics are not modified once
+// collected, so they are kept as they are.
+type LatestMetrics struct {
+	mutex    sync.RWMutex
+	snapshot prometheus.Snapshot
+}
+
+func NewLatestMetrics() *LatestMetrics {
+	return &LatestMetrics{}
+}
+
+func (l *LatestMetrics) SetTcp(m *gproto.TcpMetric) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	l.snapshot.Tcp = m
+}
+
+func (l *LatestMetrics) SetNic(m *gproto.NicMetric) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	l.snapshot.Nic = m
+}
+
+func (l *LatestMetrics) SetNet(m *gproto.NetstatMetric) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	l.snapshot.Net = m
+}
+
+// Snapshot returns the latest metrics, metrics not collected yet are nil
+func (l *LatestMetrics) Snapshot() *prometheus.Snapshot {
+	l.mutex.RLock()
+	defer l.mutex.RUnlock()
+	s := l.snapshot
+	return &s
+}
+
+// GetMetrics exposes the latest metrics in the Prometheus text format, or in OpenMetrics if the Accept header asks
+// for it
+func GetMetrics(mon *Monitor) func(c *gin.Context) {
+	config := prometheus.Config{
+		TopN:      mon.config.MetricsTopN,
+		MaxSeries: mon.config.MetricsMaxSeries,
+	}
+
+	return func(c *gin.Context) {
+		format := prometheus.NegotiateFormat(c.GetHeader("Accept"))
+		var buf bytes.Buffer
+		err := prometheus.Write(&buf, mon.latest.Snapshot(), format, config)
+		if err != nil {
+			c.AbortWithStatusJSON(http.StatusInternalServerError, tutils.ErrorJSON(err))
+			return
+		}
+		c.Data(http.StatusOK, format.ContentType(), buf.Bytes())
+	}
+}
@@ -2,23 +2,42 @@ package server
 
 import (
 	"context"
+	"crypto/tls"
 	"net/http"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/rs/zerolog/log"
 	"github.com/spf13/viper"
 
 	"github.com/zperf/tcpmon/tcpmon/collector"
+	"github.com/zperf/tcpmon/tcpmon/conntrack"
+	"github.com/zperf/tcpmon/tcpmon/gproto"
 	"github.com/zperf/tcpmon/tcpmon/storage"
+	"github.com/zperf/tcpmon/tcpmon/tutils"
 )
 
+const compactInterval = time.Minute
+
 type Monitor struct {
 	config MonitorConfig
 
 	socketCollector *collector.SocketCollector
 	nicCollector    *collector.NicCollector
 	netCollector    *collector.NetstatCollector
+	hostCollector   *collector.HostCollector
+	hostPending     bool // the host info changed but writing it failed, it's written on the next tick
+
+	differ *conntrack.Differ
+	events *EventBuffer
+	latest *LatestMetrics
+	stream *Broadcaster
+	health *Health
+	costs  *Costs
+
+	tx      chan []byte // collected records to the writer
+	running atomic.Bool
 
 	datastore  *storage.DataStore
 	httpServer *http.Server
@@ -30,6 +49,27 @@ type MonitorConfig struct {
 	CollectInterval time.Duration
 	HttpListen      string
 	DataStoreConfig storage.Config
+
+	TLSConfig *tls.Config // serve HTTPS if not nil
+	Auth      *Auth       // requests are not authenticated if nil
+
+	EventStuckThreshold time.Duration
+	EventBufferSize     int
+
+	MetricsTopN      int
+	MetricsMaxSeries int
+
+	QueryMaxResults int
+
+	StreamBufferSize int
+	StreamMaxClients int
+
+	HealthMaxFailures   int           // a collector is unhealthy after this many failures in a row, 0 to disable
+	HealthMaxAge        time.Duration // a collector is unhealthy without success in this duration, 0 to disable
+	HealthMaxQueueDepth int           // unhealthy if this many records are waiting for the writer, 0 to disable
+	SystemdNotify       bool          // notify systemd of readiness and ping its watchdog while healthy
+
+	SelfMetricsInterval time.Duration // interval of storing the cost of tcpmon, 0 to disable
 }
 
 func New(monitorConfig MonitorConfig) (*Monitor, error) {
@@ -51,49 +91,115 @@ func New(monitorConfig MonitorConfig) (*Monitor, error) {
 		socketCollector: collector.NewSocket(collectorConfig),
 		nicCollector:    collector.NewNic(collectorConfig),
 		netCollector:    collector.NewNetstat(collectorConfig),
+		hostCollector:   collector.NewHost(collectorConfig),
+		differ:          conntrack.NewDiffer(monitorConfig.EventStuckThreshold),
+		events:          NewEventBuffer(monitorConfig.EventBufferSize),
+		latest:          NewLatestMetrics(),
+		stream:          NewBroadcaster(monitorConfig.StreamBufferSize, monitorConfig.StreamMaxClients),
+		health:          NewHealth(time.Now(), CollectorSocket, CollectorNic, CollectorNet, CollectorHost),
+		costs:           NewCosts(CollectorSocket, CollectorNic, CollectorNet, CollectorHost),
+		tx:              make(chan []byte, writeQueueSize),
 	}, nil
 }
 
+// Broadcaster returns the broadcaster of collected metrics to streaming clients
+func (m *Monitor) Broadcaster() *Broadcaster {
+	return m.stream
+}
+
 func (m *Monitor) Collect(now time.Time, tx chan<- []byte) {
 	var wg sync.WaitGroup
 	wg.Add(3)
 
 	go func() {
 		defer wg.Done()
-		req, err := m.socketCollector.Collect(now)
+		start := time.Now()
+		req, table, err := m.socketCollector.Collect(now)
+		m.costs.Record(CollectorSocket, time.Since(start), m.socketCollector.ChildWallTime(), err)
 		if err != nil {
+			m.health.Failure(CollectorSocket, now, err)
 			log.Warn().Err(err).Msg("collect socket metrics failed")
 			return
 		}
 		tx <- req
+		m.health.Success(CollectorSocket, now)
+		m.latest.SetTcp(table)
+		m.stream.Publish(&gproto.Metric{Body: &gproto.Metric_Tcp{Tcp: table}})
+
+		req, err = m.diffSockets(now, table)
+		if err != nil {
+			log.Warn().Err(err).Msg("diff socket tables failed")
+			return
+		}
+		if req != nil {
+			tx <- req
+		}
 	}()
 
 	go func() {
 		defer wg.Done()
-		req, err := m.nicCollector.Collect(now)
+		start := time.Now()
+		req, nic, err := m.nicCollector.Collect(now)
+		m.costs.Record(CollectorNic, time.Since(start), m.nicCollector.ChildWallTime(), err)
 		if err != nil {
+			m.health.Failure(CollectorNic, now, err)
 			log.Warn().Err(err).Msg("collect nic metrics failed")
 			return
 		}
 		tx <- req
+		m.health.Success(CollectorNic, now)
+		m.latest.SetNic(nic)
+		m.stream.Publish(&gproto.Metric{Body: &gproto.Metric_Nic{Nic: nic}})
 	}()
 
 	go func() {
 		defer wg.Done()
-		req, err := m.netCollector.Collect(now)
+		start := time.Now()
+		req, net, err := m.netCollector.Collect(now)
+		m.costs.Record(CollectorNet, time.Since(start), 0, err)
 		if err != nil {
+			m.health.Failure(CollectorNet, now, err)
 			log.Warn().Err(err).Msg("collect net metrics failed")
 			return
 		}
 		tx <- req
+		m.health.Success(CollectorNet, now)
+		m.latest.SetNet(net)
+		m.stream.Publish(&gproto.Metric{Body: &gproto.Metric_Net{Net: net}})
 	}()
 
 	wg.Wait()
 }
 
+// CollectHost writes the host info as the head record of data files if it has changed
+func (m *Monitor) CollectHost(now time.Time) {
+	start := time.Now()
+	buf, changed, err := m.hostCollector.Collect(now)
+	m.costs.Record(CollectorHost, time.Since(start), 0, err)
+	if err != nil {
+		m.health.Failure(CollectorHost, now, err)
+		log.Warn().Err(err).Msg("collect host info failed")
+		return
+	}
+	if !changed && !m.hostPending {
+		m.health.Success(CollectorHost, now)
+		return
+	}
+
+	err = m.datastore.SetHeadRecord(buf)
+	m.hostPending = err != nil
+	if err != nil {
+		m.health.Failure(CollectorHost, now, err)
+		log.Warn().Err(err).Msg("Write host info failed, retry on the next tick")
+		return
+	}
+	m.health.Success(CollectorHost, now)
+}
+
 func (m *Monitor) Run(ctx context.Context) error {
 	ticker := time.NewTicker(m.config.CollectInterval)
 
+	m.CollectHost(time.Now())
 	m.startHttpServer(m.config.HttpListen)
 
 	if m.quorum != nil {
@@ -106,37 +212,169 @@ func (m *Monitor) Run(ctx context.Context) error {
 		}
 	}
 
-	tx := make(chan []byte, 256)
+	tx := m.tx
 
-	go func() {
-		for {
-			select {
-			case c := <-tx:
-				err := m.datastore.Put(c)
-				if err != nil {
-					log.Fatal().Err(err).Msg("Write failed")
-				}
+	// producers of records stop on ctx.Done, then the writer drains the queue before the datastore is closed
+	var producers sync.WaitGroup
+	if len(m.config.DataStoreConfig.RollupLevels) > 0 {
+		producers.Add(1)
+		go func() {
+			defer producers.Done()
+			m.compact(ctx)
+		}()
+	}
+	if m.config.SelfMetricsInterval > 0 {
+		producers.Add(1)
+		go func() {
+			defer producers.Done()
+			m.collectSelf(ctx, tx)
+		}()
+	}
 
-			case <-ctx.Done():
-				log.Info().Msg("Shutting down writer...")
-				return
-			}
-		}
+	stopWriter := make(chan struct{})
+	writerDone := make(chan struct{})
+	go func() {
+		defer close(writerDone)
+		m.write(tx, stopWriter)
 	}()
 
+	m.running.Store(true)
+	if m.config.SystemdNotify {
+		go m.notifySystemd(ctx)
+	}
+
 	for {
 		select {
 		case now := <-ticker.C:
+			m.CollectHost(now)
 			m.Collect(now, tx)
 
 		case <-ctx.Done():
 			log.Info().Msg("Shutting down monitor...")
+			m.running.Store(false)
+			ticker.Stop()
+			producers.Wait()
+			close(stopWriter)
+			<-writerDone
 			m.Close()
 			return nil
 		}
 	}
 }
 
+// write puts the records of tx into the datastore until stop is closed, then the records left in tx are written and
+// flushed
+func (m *Monitor) write(tx <-chan []byte, stop <-chan struct{}) {
+	// flush buffered records when there is no new record
+	var flush <-chan time.Time
+	if interval := m.config.DataStoreConfig.WriteInterval; interval > 0 {
+		flushTicker := time.NewTicker(interval)
+		defer flushTicker.Stop()
+		flush = flushTicker.C
+	}
+
+	put := func(c []byte) {
+		// failed writes are reported by the storage health check, the record is dropped
+		err := m.datastore.Put(c)
+		if err != nil {
+			log.Warn().Err(err).Msg("Write failed")
+		}
+	}
+
+	for {
+		select {
+		case c := <-tx:
+			put(c)
+
+		case <-flush:
+			err := m.datastore.Flush()
+			if err != nil {
+				log.Warn().Err(err).Msg("Flush failed")
+			}
+
+		case <-stop:
+			log.Info().Msg("Shutting down writer...")
+			for {
+				select {
+				case c := <-tx:
+					put(c)
+				default:
+					err := m.datastore.Flush()
+					if err != nil {
+						log.Warn().Err(err).Msg("Flush failed")
+					}
+					return
+				}
+			}
+		}
+	}
+}
+
+// notifySystemd sends READY=1 to systemd, and pings the watchdog while the health checks pass, so systemd restarts
+// tcpmon if collectors keep failing or the writer is stuck
+func (m *Monitor) notifySystemd(ctx context.Context) {
+	ok, err := tutils.SdNotify("READY=1")
+	if err != nil {
+		log.Warn().Err(err).Msg("Notify systemd failed")
+		return
+	}
+	if !ok {
+		return
+	}
+	defer func() {
+		_, _ = tutils.SdNotify("STOPPING=1")
+	}()
+
+	interval, err := tutils.SdWatchdogInterval()
+	if err != nil {
+		log.Warn().Err(err).Msg("Get systemd watchdog interval failed")
+	}
+	if interval <= 0 {
+		<-ctx.Done()
+		return
+	}
+	log.Info().Dur("interval", interval).Msg("Systemd watchdog enabled")
+
+	ticker := time.NewTicker(interval / 2)
+	defer ticker.Stop()
+	for {
+		select {
+		case now := <-ticker.C:
+			r := m.HealthReport(now, false)
+			if !r.OK {
+				log.Warn().Interface("checks", r.Checks).Msg("Unhealthy, systemd watchdog not notified")
+				continue
+			}
+			_, err = tutils.SdNotify("WATCHDOG=1")
+			if err != nil {
+				log.Warn().Err(err).Msg("Notify systemd watchdog failed")
+			}
+
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// compact rolls up old data files periodically, apart from the writer so writes are not blocked by reading files
+func (m *Monitor) compact(ctx context.Context) {
+	ticker := time.NewTicker(compactInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case now := <-ticker.C:
+			err := m.datastore.Compact(now)
+			if err != nil {
+				log.Warn().Err(err).Msg("Compact failed")
+			}
+
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
 func (m *Monitor) Close() {
 	if m.httpServer != nil {
 		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
@@ -0,0 +1,85 @@
+package server
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/gin-gonic/gin"
+
+	"github.com/zperf/tcpmon/tcpmon/storage"
+	"github.com/zperf/tcpmon/tcpmon/tutils"
+)
+
+// GetPins lists pins with the data files they protect
+func GetPins(mon *Monitor) func(c *gin.Context) {
+	return func(c *gin.Context) {
+		pins, err := mon.datastore.Pins()
+		if err != nil {
+			c.AbortWithStatusJSON(http.StatusInternalServerError, tutils.ErrorJSON(err))
+			return
+		}
+
+		c.JSON(http.StatusOK, gin.H{
+			"len":  len(pins),
+			"pins": pins,
+		})
+	}
+}
+
+// PostPin pins a time range, data files covering it are protected from reclaim
+// from, to: unix timestamp or local time in tutils.TimeFormat
+// label: describes the incident
+// ttl: the pin expires after it, e.g. 72h. 0 for never, defaults to 7 days
+func PostPin(mon *Monitor) func(c *gin.Context) {
+	return func(c *gin.Context) {
+		if c.Query("from") == "" || c.Query("to") == "" {
+			c.AbortWithStatusJSON(http.StatusBadRequest, tutils.ErrorJSON(errors.New("from and to are required")))
+			return
+		}
+
+		from, err := ParseTime(c.Query("from"))
+		if err != nil {
+			c.AbortWithStatusJSON(http.StatusBadRequest, tutils.ErrorJSON(err))
+			return
+		}
+		to, err := ParseTime(c.Query("to"))
+		if err != nil {
+			c.AbortWithStatusJSON(http.StatusBadRequest, tutils.ErrorJSON(err))
+			return
+		}
+
+		ttl, err := time.ParseDuration(c.DefaultQuery("ttl", "168h"))
+		if err != nil {
+			c.AbortWithStatusJSON(http.StatusBadRequest, tutils.ErrorJSON(errors.Wrap(err, "invalid ttl")))
+			return
+		}
+		var expires time.Time
+		if ttl > 0 {
+			expires = time.Now().Add(ttl)
+		}
+
+		pin, err := mon.datastore.Pin(c.Query("label"), time.Unix(from, 0), time.Unix(to, 0), expires)
+		if err != nil {
+			c.AbortWithStatusJSON(http.StatusBadRequest, tutils.ErrorJSON(err))
+			return
+		}
+		c.JSON(http.StatusOK, pin)
+	}
+}
+
+// DeletePin deletes the pin, files not covered by other pins are reclaimed as usual
+func DeletePin(mon *Monitor) func(c *gin.Context) {
+	return func(c *gin.Context) {
+		err := mon.datastore.Unpin(c.Param("id"))
+		if err != nil {
+			status := http.StatusInternalServerError
+			if errors.Is(err, storage.ErrPinNotFound) {
+				status = http.StatusNotFound
+			}
+			c.AbortWithStatusJSON(status, tutils.ErrorJSON(err))
+			return
+		}
+		c.JSON(http.StatusOK, gin.H{"id": c.Param("id")})
+	}
+}
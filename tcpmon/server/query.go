@@ -0,0 +1,144 @@
+package server
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/gin-gonic/gin"
+	"github.com/rs/zerolog/log"
+
+	"github.com/zperf/tcpmon/tcpmon/query"
+	"github.com/zperf/tcpmon/tcpmon/tutils"
+)
+
+// rows of a page if limit is not given
+const defaultQueryLimit = 1000
+
+// GetQuery streams the stored rows of a metric type, a socket, an interface, a connection event or a whole metric
+// for each row
+// type: tcp, nic, net, event, host, self or self_collector, or rollup_tcp, rollup_nic or rollup_net for the rollups
+// of a level, raw metrics are deleted once they are rolled up (see storage.Config.RollupLevels)
+// resolution: the resolution of the level to query rollups of, e.g. 1m. Required by rollup types
+// from, to: unix timestamp or local time in tutils.TimeFormat, unbounded if not given
+// fields: field names separated by comma, e.g. rtt,retrans_total. All fields if not given. Rollups have the min,
+// max, avg and last of the fields, e.g. rtt_max
+// state: socket states separated by comma, e.g. established,close_wait
+// local, peer: ip, ip:port or :port
+// process: the name of a process contains it
+// limit: rows of the page, up to the max results of the server
+// cursor: the next cursor of the previous page
+// format: json or line
+func GetQuery(mon *Monitor) func(c *gin.Context) {
+	hostname := tutils.Hostname()
+	maxResults := mon.config.QueryMaxResults
+
+	return func(c *gin.Context) {
+		q, err := newQuery(c, maxResults)
+		if err != nil {
+			c.AbortWithStatusJSON(http.StatusBadRequest, tutils.ErrorJSON(err))
+			return
+		}
+		format := c.DefaultQuery("format", query.FormatJSON)
+		w, err := query.NewWriter(format, c.Writer, q, hostname)
+		if err != nil {
+			c.AbortWithStatusJSON(http.StatusBadRequest, tutils.ErrorJSON(err))
+			return
+		}
+
+		// buffered records are visible to the query
+		err = mon.datastore.Flush()
+		if err != nil {
+			c.AbortWithStatusJSON(http.StatusInternalServerError, tutils.ErrorJSON(err))
+			return
+		}
+		r, err := mon.datastore.NewReader()
+		if err != nil {
+			c.AbortWithStatusJSON(http.StatusInternalServerError, tutils.ErrorJSON(err))
+			return
+		}
+		defer r.Close()
+
+		c.Writer.Header().Set("Content-Type", query.ContentType(format))
+		c.Status(http.StatusOK)
+		err = query.Run(r, q, w)
+		if err != nil {
+			// rows may have been written, the response can't be changed
+			log.Warn().Err(err).Msg("Write query result failed")
+		}
+	}
+}
+
+func newQuery(c *gin.Context, maxResults int) (*query.Query, error) {
+	q := &query.Query{
+		Type:    c.Query("type"),
+		Local:   c.Query("local"),
+		Peer:    c.Query("peer"),
+		Process: c.Query("process"),
+	}
+	if q.Type == "" {
+		return nil, errors.Newf("type is required, expect one of %s", strings.Join(query.Types(), ", "))
+	}
+
+	if s := c.Query("from"); s != "" {
+		from, err := ParseTime(s)
+		if err != nil {
+			return nil, err
+		}
+		q.From = time.Unix(from, 0)
+	}
+	if s := c.Query("to"); s != "" {
+		to, err := ParseTime(s)
+		if err != nil {
+			return nil, err
+		}
+		q.To = time.Unix(to, 0)
+	}
+
+	if s := c.Query("resolution"); s != "" {
+		resolution, err := time.ParseDuration(s)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid resolution %q", s)
+		}
+		q.Resolution = resolution
+	}
+
+	if s := c.Query("fields"); s != "" {
+		for _, name := range strings.Split(s, ",") {
+			q.Fields = append(q.Fields, strings.TrimSpace(name))
+		}
+	}
+	if s := c.Query("state"); s != "" {
+		for _, name := range strings.Split(s, ",") {
+			state, err := query.ParseState(name)
+			if err != nil {
+				return nil, err
+			}
+			q.States = append(q.States, state)
+		}
+	}
+
+	q.Limit = defaultQueryLimit
+	if s := c.Query("limit"); s != "" {
+		limit, err := strconv.Atoi(s)
+		if err != nil || limit <= 0 {
+			return nil, errors.Newf("invalid limit %q", s)
+		}
+		q.Limit = limit
+	}
+	if maxResults > 0 && q.Limit > maxResults {
+		q.Limit = maxResults
+	}
+
+	if s := c.Query("cursor"); s != "" {
+		cursor, err := query.ParseCursor(s)
+		if err != nil {
+			return nil, err
+		}
+		q.Cursor = &cursor
+	}
+
+	return q, q.Validate()
+}
@@ -0,0 +1,162 @@
+package server
+
+import (
+	"context"
+	"net/http"
+	"runtime"
+	"sort"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/gin-gonic/gin"
+	"github.com/rs/zerolog/log"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/zperf/tcpmon/tcpmon/gproto"
+	"github.com/zperf/tcpmon/tcpmon/tutils"
+)
+
+// Costs records the duration of collector runs
+type Costs struct {
+	mutex      sync.Mutex
+	collectors map[string]*gproto.CollectorCost
+}
+
+func NewCosts(collectors ...string) *Costs {
+	c := &Costs{collectors: make(map[string]*gproto.CollectorCost)}
+	for _, name := range collectors {
+		c.collectors[name] = &gproto.CollectorCost{Name: name}
+	}
+	return c
+}
+
+// Record adds a run of the collector, child is the wall time of its child process, 0 if there is none
+func (c *Costs) Record(name string, duration time.Duration, child time.Duration, err error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	cc, ok := c.collectors[name]
+	if !ok {
+		cc = &gproto.CollectorCost{Name: name}
+		c.collectors[name] = cc
+	}
+	cc.Runs++
+	if err != nil {
+		cc.Failures++
+	}
+	cc.LastDurationUs = duration.Microseconds()
+	cc.TotalDurationUs += duration.Microseconds()
+	if cc.LastDurationUs > cc.MaxDurationUs {
+		cc.MaxDurationUs = cc.LastDurationUs
+	}
+	cc.LastChildWallUs = child.Microseconds()
+	cc.TotalChildWallUs += child.Microseconds()
+}
+
+// Collectors returns a copy of the costs of collectors, sorted by name
+func (c *Costs) Collectors() []*gproto.CollectorCost {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	collectors := make([]*gproto.CollectorCost, 0, len(c.collectors))
+	for _, cc := range c.collectors {
+		collectors = append(collectors, proto.Clone(cc).(*gproto.CollectorCost))
+	}
+	sort.Slice(collectors, func(i, j int) bool {
+		return collectors[i].GetName() < collectors[j].GetName()
+	})
+	return collectors
+}
+
+// Costs returns the costs of collectors
+func (m *Monitor) Costs() *Costs {
+	return m.costs
+}
+
+// SelfMetric returns the cost of tcpmon since it started: collectors, CPU time of tcpmon and its child processes,
+// storage writes and the Go runtime
+func (m *Monitor) SelfMetric(now time.Time) *gproto.SelfMetric {
+	r := &gproto.SelfMetric{
+		Timestamp:  now.Unix(),
+		Type:       gproto.MetricType_SELF,
+		UptimeS:    int64(now.Sub(m.health.started).Seconds()),
+		Collectors: m.costs.Collectors(),
+	}
+
+	var usage syscall.Rusage
+	err := syscall.Getrusage(syscall.RUSAGE_SELF, &usage)
+	if err != nil {
+		log.Warn().Err(err).Msg("Get resource usage failed")
+	} else {
+		r.UserCpuUs = timevalMicros(usage.Utime)
+		r.SystemCpuUs = timevalMicros(usage.Stime)
+		r.MaxRssKb = usage.Maxrss
+	}
+	// children are counted once they have exited and been waited for
+	err = syscall.Getrusage(syscall.RUSAGE_CHILDREN, &usage)
+	if err != nil {
+		log.Warn().Err(err).Msg("Get resource usage of child processes failed")
+	} else {
+		r.ChildUserCpuUs = timevalMicros(usage.Utime)
+		r.ChildSystemCpuUs = timevalMicros(usage.Stime)
+	}
+
+	stats := m.datastore.WriteStats()
+	r.RecordsWritten = stats.Records
+	r.BytesWritten = stats.Bytes
+	r.Flushes = stats.Flushes
+	r.FlushUs = stats.TotalFlushLatency.Microseconds()
+	r.Seals = stats.Seals
+	r.CompressionUs = stats.TotalCompression.Microseconds()
+
+	var mem runtime.MemStats
+	runtime.ReadMemStats(&mem)
+	r.HeapAllocBytes = mem.HeapAlloc
+	r.HeapSysBytes = mem.HeapSys
+	r.SysBytes = mem.Sys
+	r.NumGc = mem.NumGC
+	r.GcPauseTotalUs = time.Duration(mem.PauseTotalNs).Microseconds()
+	r.GcCpuFraction = mem.GCCPUFraction
+	r.Goroutines = uint32(runtime.NumGoroutine())
+	return r
+}
+
+func timevalMicros(tv syscall.Timeval) int64 {
+	return int64(tv.Sec)*1e6 + int64(tv.Usec)
+}
+
+// collectSelf writes the self metric every SelfMetricsInterval, it's stored in the default stream
+func (m *Monitor) collectSelf(ctx context.Context, tx chan<- []byte) {
+	ticker := time.NewTicker(m.config.SelfMetricsInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case now := <-ticker.C:
+			buf, err := proto.Marshal(&gproto.Metric{Body: &gproto.Metric_Self{Self: m.SelfMetric(now)}})
+			if err != nil {
+				log.Warn().Err(errors.WithStack(err)).Msg("Marshal self metric failed")
+				continue
+			}
+			tx <- buf
+
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// GetSelf returns the cost of tcpmon since it started
+func GetSelf(mon *Monitor) func(c *gin.Context) {
+	return func(c *gin.Context) {
+		buf, err := protojson.MarshalOptions{EmitUnpopulated: true}.Marshal(mon.SelfMetric(time.Now()))
+		if err != nil {
+			c.AbortWithStatusJSON(http.StatusInternalServerError, tutils.ErrorJSON(err))
+			return
+		}
+		c.Data(http.StatusOK, "application/json; charset=utf-8", buf)
+	}
+}
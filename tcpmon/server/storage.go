@@ -0,0 +1,21 @@
+package server
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/zperf/tcpmon/tcpmon/tutils"
+)
+
+// GetStorage returns the usage of the data dir, the reclaim history and the write statistics
+func GetStorage(mon *Monitor) func(c *gin.Context) {
+	return func(c *gin.Context) {
+		stats, err := mon.datastore.Stats()
+		if err != nil {
+			c.AbortWithStatusJSON(http.StatusInternalServerError, tutils.ErrorJSON(err))
+			return
+		}
+		c.JSON(http.StatusOK, stats)
+	}
+}
@@ -0,0 +1,334 @@
+package server
+
+import (
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/gin-gonic/gin"
+	"github.com/rs/zerolog/log"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/zperf/tcpmon/tcpmon/gproto"
+	"github.com/zperf/tcpmon/tcpmon/query"
+	"github.com/zperf/tcpmon/tcpmon/tutils"
+)
+
+// a comment is sent at this interval, so proxies don't close idle streams
+const streamKeepAlive = 15 * time.Second
+
+// Broadcaster sends collected metrics to the streaming clients. Publish never blocks, metrics are dropped for
+// clients whose buffer is full and reported to them later.
+type Broadcaster struct {
+	mutex       sync.Mutex
+	subscribers map[*Subscriber]struct{}
+	bufferSize  int
+	maxClients  int
+}
+
+// Subscriber receives metrics from the broadcaster
+type Subscriber struct {
+	ch      chan *gproto.Metric
+	dropped atomic.Uint64
+}
+
+func NewBroadcaster(bufferSize int, maxClients int) *Broadcaster {
+	if bufferSize <= 0 {
+		bufferSize = 1
+	}
+	return &Broadcaster{
+		subscribers: make(map[*Subscriber]struct{}),
+		bufferSize:  bufferSize,
+		maxClients:  maxClients,
+	}
+}
+
+// Subscribe returns a new subscriber, nil if there are max clients already
+func (b *Broadcaster) Subscribe() *Subscriber {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	if b.maxClients > 0 && len(b.subscribers) >= b.maxClients {
+		return nil
+	}
+	s := &Subscriber{ch: make(chan *gproto.Metric, b.bufferSize)}
+	b.subscribers[s] = struct{}{}
+	return s
+}
+
+func (b *Broadcaster) Unsubscribe(s *Subscriber) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	delete(b.subscribers, s)
+}
+
+// Len returns the number of subscribers
+func (b *Broadcaster) Len() int {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	return len(b.subscribers)
+}
+
+// Publish sends the metric to all subscribers without blocking. The metric is shared, it must not be modified.
+func (b *Broadcaster) Publish(m *gproto.Metric) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	for s := range b.subscribers {
+		select {
+		case s.ch <- m:
+		default:
+			s.dropped.Add(1)
+		}
+	}
+}
+
+func (s *Subscriber) C() <-chan *gproto.Metric {
+	return s.ch
+}
+
+// Dropped returns the metrics dropped since the last call
+func (s *Subscriber) Dropped() uint64 {
+	return s.dropped.Swap(0)
+}
+
+// streamFilter selects metrics and the sockets or events in them
+type streamFilter struct {
+	types   map[gproto.MetricType]bool // nil for all types
+	states  []gproto.SocketState
+	local   string
+	peer    string
+	process string
+}
+
+func newStreamFilter(c *gin.Context) (*streamFilter, error) {
+	f := &streamFilter{
+		local:   c.Query("local"),
+		peer:    c.Query("peer"),
+		process: c.Query("process"),
+	}
+
+	if s := c.Query("type"); s != "" {
+		f.types = make(map[gproto.MetricType]bool)
+		for _, name := range strings.Split(s, ",") {
+			name = strings.ToUpper(strings.TrimSpace(name))
+			t, ok := gproto.MetricType_value[name]
+			if !ok || !streamTypes[gproto.MetricType(t)] {
+				return nil, errors.Newf("unknown type %q, expect tcp, nic, net or event", name)
+			}
+			f.types[gproto.MetricType(t)] = true
+		}
+	}
+	if s := c.Query("state"); s != "" {
+		for _, name := range strings.Split(s, ",") {
+			state, err := query.ParseState(name)
+			if err != nil {
+				return nil, err
+			}
+			f.states = append(f.states, state)
+		}
+	}
+	return f, nil
+}
+
+// types of metrics streamed
+var streamTypes = map[gproto.MetricType]bool{
+	gproto.MetricType_TCP:   true,
+	gproto.MetricType_NIC:   true,
+	gproto.MetricType_NET:   true,
+	gproto.MetricType_EVENT: true,
+}
+
+func (f *streamFilter) sockets() bool {
+	return len(f.states) != 0 || f.local != "" || f.peer != "" || f.process != ""
+}
+
+func (f *streamFilter) match(state gproto.SocketState, local, peer, process string) bool {
+	if len(f.states) != 0 {
+		ok := false
+		for _, s := range f.states {
+			ok = ok || s == state
+		}
+		if !ok {
+			return false
+		}
+	}
+	if f.local != "" && !query.MatchAddr(local, f.local) {
+		return false
+	}
+	if f.peer != "" && !query.MatchAddr(peer, f.peer) {
+		return false
+	}
+	return f.process == "" || strings.Contains(process, f.process)
+}
+
+// apply returns the metric with the matching sockets or events, nil if nothing matches. The metric is not modified.
+func (f *streamFilter) apply(m *gproto.Metric) *gproto.Metric {
+	var t gproto.MetricType
+	switch body := m.Body.(type) {
+	case *gproto.Metric_Tcp:
+		t = gproto.MetricType_TCP
+		if f.types != nil && !f.types[t] {
+			return nil
+		}
+		if !f.sockets() {
+			return m
+		}
+		tcp := &gproto.TcpMetric{Timestamp: body.Tcp.GetTimestamp(), Type: body.Tcp.GetType()}
+		for _, s := range body.Tcp.GetSockets() {
+			process := ""
+			if len(s.GetProcesses()) != 0 {
+				process = s.GetProcesses()[0].GetName()
+			}
+			if f.match(s.GetState(), s.GetLocalAddr(), s.GetPeerAddr(), process) {
+				tcp.Sockets = append(tcp.Sockets, s)
+			}
+		}
+		if len(tcp.Sockets) == 0 {
+			return nil
+		}
+		return &gproto.Metric{Body: &gproto.Metric_Tcp{Tcp: tcp}}
+
+	case *gproto.Metric_Event:
+		t = gproto.MetricType_EVENT
+		if f.types != nil && !f.types[t] {
+			return nil
+		}
+		if !f.sockets() {
+			return m
+		}
+		event := &gproto.EventMetric{Timestamp: body.Event.GetTimestamp(), Type: body.Event.GetType()}
+		for _, e := range body.Event.GetEvents() {
+			if f.match(e.GetState(), e.GetLocalAddr(), e.GetPeerAddr(), e.GetProcess()) {
+				event.Events = append(event.Events, e)
+			}
+		}
+		if len(event.Events) == 0 {
+			return nil
+		}
+		return &gproto.Metric{Body: &gproto.Metric_Event{Event: event}}
+
+	case *gproto.Metric_Nic:
+		t = gproto.MetricType_NIC
+	case *gproto.Metric_Net:
+		t = gproto.MetricType_NET
+	default:
+		return nil
+	}
+
+	// socket filters don't apply to NIC and netstat metrics
+	if f.types != nil && !f.types[t] {
+		return nil
+	}
+	return m
+}
+
+// metricTypeName returns the name of the event, e.g. tcp
+func metricTypeName(m *gproto.Metric) string {
+	switch m.Body.(type) {
+	case *gproto.Metric_Tcp:
+		return "tcp"
+	case *gproto.Metric_Nic:
+		return "nic"
+	case *gproto.Metric_Net:
+		return "net"
+	case *gproto.Metric_Event:
+		return "event"
+	}
+	return "unknown"
+}
+
+// GetStream streams collected metrics as server-sent events named by the type: tcp, nic, net or event. When metrics
+// are dropped because the client is slow, a dropped event with the number of them is sent.
+// type: tcp, nic, net or event, separated by comma. All types if not given
+// state: socket states separated by comma, e.g. established,close_wait
+// local, peer: ip, ip:port or :port
+// process: the name of a process contains it
+// format: json, or protobuf for the marshaled gproto.Metric in base64
+func GetStream(mon *Monitor) func(c *gin.Context) {
+	return func(c *gin.Context) {
+		filter, err := newStreamFilter(c)
+		if err != nil {
+			c.AbortWithStatusJSON(http.StatusBadRequest, tutils.ErrorJSON(err))
+			return
+		}
+		format := c.DefaultQuery("format", "json")
+		if format != "json" && format != "protobuf" {
+			c.AbortWithStatusJSON(http.StatusBadRequest,
+				tutils.ErrorJSON(errors.Newf("unknown format %q, expect json or protobuf", format)))
+			return
+		}
+
+		sub := mon.stream.Subscribe()
+		if sub == nil {
+			c.AbortWithStatusJSON(http.StatusServiceUnavailable, tutils.ErrorJSON(errors.New("too many clients")))
+			return
+		}
+		defer mon.stream.Unsubscribe(sub)
+
+		c.Writer.Header().Set("Content-Type", "text/event-stream")
+		c.Writer.Header().Set("Cache-Control", "no-cache")
+		c.Writer.Header().Set("X-Accel-Buffering", "no")
+		c.Status(http.StatusOK)
+		c.Writer.Flush()
+
+		keepAlive := time.NewTicker(streamKeepAlive)
+		defer keepAlive.Stop()
+
+		for {
+			var err error
+			select {
+			case <-c.Request.Context().Done():
+				return
+
+			case <-keepAlive.C:
+				_, err = fmt.Fprint(c.Writer, ": keepalive\n\n")
+
+			case m := <-sub.C():
+				if dropped := sub.Dropped(); dropped != 0 {
+					_, err = fmt.Fprintf(c.Writer, "event: dropped\ndata: {\"dropped\":%d}\n\n", dropped)
+					if err != nil {
+						break
+					}
+				}
+
+				m = filter.apply(m)
+				if m == nil {
+					continue
+				}
+				var data string
+				data, err = encodeStreamMetric(m, format)
+				if err == nil {
+					_, err = fmt.Fprintf(c.Writer, "event: %s\ndata: %s\n\n", metricTypeName(m), data)
+				}
+			}
+			if err != nil {
+				log.Warn().Err(err).Msg("Stream metrics failed")
+				return
+			}
+			c.Writer.Flush()
+		}
+	}
+}
+
+func encodeStreamMetric(m *gproto.Metric, format string) (string, error) {
+	if format == "protobuf" {
+		buf, err := proto.Marshal(m)
+		if err != nil {
+			return "", errors.WithStack(err)
+		}
+		return base64.StdEncoding.EncodeToString(buf), nil
+	}
+
+	buf, err := protojson.Marshal(m)
+	if err != nil {
+		return "", errors.WithStack(err)
+	}
+	return string(buf), nil
+}
@@ -0,0 +1,336 @@
+package storage
+
+import (
+	"archive/tar"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/klauspost/compress/zstd"
+	"github.com/spf13/afero"
+)
+
+const (
+	ArchiveSuffix           = ".tar"
+	CompressedArchiveSuffix = ".tar.zst"
+	EncryptedArchiveSuffix  = ".tar.enc"
+
+	// CompressedEncryptedArchiveSuffix is an archive compressed before it's encrypted
+	CompressedEncryptedArchiveSuffix = ".tar.zst.enc"
+)
+
+// ArchiveRoot is the base dir of the files in an archive opened by OpenArchive
+const ArchiveRoot = "/"
+
+// IsArchive returns true if the path is a backup archive, e.g. tcpmon-datastore-node-1.tar
+func IsArchive(path string) bool {
+	return strings.HasSuffix(path, ArchiveSuffix) || strings.HasSuffix(path, CompressedArchiveSuffix) ||
+		strings.HasSuffix(path, EncryptedArchiveSuffix) || strings.HasSuffix(path, CompressedEncryptedArchiveSuffix)
+}
+
+// OpenArchive opens the backup archive as a read-only fs, files are at ArchiveRoot. Files are read in place from the
+// archive, compressed archives (.tar.zst or .tar.zst.enc) are decompressed to a temp file first, which is deleted
+// once it's opened. Encrypted archives and files in them are decrypted if fs is a DecryptFs.
+func OpenArchive(path string, fs afero.Fs) (afero.Fs, error) {
+	if fs == nil {
+		fs = afero.NewOsFs()
+	}
+
+	fh, err := fs.Open(path)
+	if err != nil {
+		return nil, errors.Wrap(err, "open archive failed")
+	}
+
+	err = checkEncrypted(fh)
+	if err != nil {
+		_ = fh.Close()
+		return nil, errors.Wrapf(err, "open archive %s failed", path)
+	}
+
+	var archive io.ReaderAt = fh
+	if strings.HasSuffix(path, CompressedArchiveSuffix) || strings.HasSuffix(path, CompressedEncryptedArchiveSuffix) {
+		archive, err = spool(fh)
+		_ = fh.Close()
+		if err != nil {
+			return nil, errors.Wrapf(err, "decompress archive %s failed", path)
+		}
+	}
+
+	tfs, err := newTarFs(archive)
+	if err != nil {
+		return nil, errors.Wrapf(err, "read archive %s failed", path)
+	}
+
+	var r afero.Fs = afero.NewReadOnlyFs(tfs)
+	if keyring := KeyringOf(fs); keyring != nil {
+		r = NewDecryptFs(r, keyring)
+	}
+	return r, nil
+}
+
+// spool decompresses the archive to a temp file, the file is deleted so it's removed once it's closed
+func spool(r io.Reader) (*os.File, error) {
+	z, err := zstd.NewReader(r)
+	if err != nil {
+		return nil, errors.Wrap(err, "create new zstd reader failed")
+	}
+	defer z.Close()
+
+	f, err := os.CreateTemp("", "tcpmon-archive-*"+ArchiveSuffix)
+	if err != nil {
+		return nil, errors.Wrap(err, "create temp file failed")
+	}
+	_ = os.Remove(f.Name())
+
+	_, err = io.Copy(f, z)
+	if err != nil {
+		_ = f.Close()
+		return nil, errors.Wrap(err, "write temp file failed")
+	}
+	return f, nil
+}
+
+// tarFs is a read-only fs of the regular files in a tar archive, each file is read from its section of the archive.
+// afero's tarfs shares the read offset between opened files, it can't be used to read files at the same time.
+type tarFs struct {
+	archive io.ReaderAt
+	files   map[string]*tarEntry // by path
+	names   []string             // sorted paths
+	modTime time.Time
+}
+
+type tarEntry struct {
+	header *tar.Header
+	offset int64
+}
+
+func newTarFs(archive io.ReaderAt) (*tarFs, error) {
+	size, err := readerSize(archive)
+	if err != nil {
+		return nil, err
+	}
+
+	fs := &tarFs{archive: archive, files: make(map[string]*tarEntry)}
+	section := io.NewSectionReader(archive, 0, size)
+	t := tar.NewReader(section)
+	for {
+		h, err := t.Next()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			return nil, err
+		}
+		if h.Typeflag != tar.TypeReg {
+			continue
+		}
+
+		// the reader stops at the data of the entry
+		offset, err := section.Seek(0, io.SeekCurrent)
+		if err != nil {
+			return nil, errors.Wrap(err, "seek failed")
+		}
+		if offset+h.Size > size {
+			return nil, errors.Wrapf(io.ErrUnexpectedEOF, "%s is truncated", h.Name)
+		}
+
+		// backups are flat, files in subdirectories are read from the root as well
+		name := filepath.Join(ArchiveRoot, filepath.Base(h.Name))
+		if _, ok := fs.files[name]; !ok {
+			fs.names = append(fs.names, name)
+		}
+		fs.files[name] = &tarEntry{header: h, offset: offset}
+		if h.ModTime.After(fs.modTime) {
+			fs.modTime = h.ModTime
+		}
+	}
+	sort.Strings(fs.names)
+	return fs, nil
+}
+
+// readerSize returns the size of the file or the reader
+func readerSize(r io.ReaderAt) (int64, error) {
+	if f, ok := r.(interface{ Stat() (os.FileInfo, error) }); ok {
+		stat, err := f.Stat()
+		if err != nil {
+			return 0, errors.Wrap(err, "stat failed")
+		}
+		return stat.Size(), nil
+	}
+	if s, ok := r.(interface{ Size() int64 }); ok {
+		return s.Size(), nil
+	}
+	return 0, errors.New("unknown size of the archive")
+}
+
+func (fs *tarFs) Name() string {
+	return "tarFs"
+}
+
+func (fs *tarFs) Open(name string) (afero.File, error) {
+	name = filepath.Join(ArchiveRoot, name)
+	if name == ArchiveRoot {
+		return &tarFile{fs: fs, name: name}, nil
+	}
+	e, ok := fs.files[name]
+	if !ok {
+		return nil, &os.PathError{Op: "open", Path: name, Err: os.ErrNotExist}
+	}
+	return &tarFile{
+		fs:      fs,
+		name:    name,
+		entry:   e,
+		section: io.NewSectionReader(fs.archive, e.offset, e.header.Size),
+	}, nil
+}
+
+func (fs *tarFs) OpenFile(name string, flag int, _ os.FileMode) (afero.File, error) {
+	if flag&(os.O_WRONLY|os.O_RDWR|os.O_CREATE|os.O_APPEND|os.O_TRUNC) != 0 {
+		return nil, syscall.EPERM
+	}
+	return fs.Open(name)
+}
+
+func (fs *tarFs) Stat(name string) (os.FileInfo, error) {
+	f, err := fs.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	return f.Stat()
+}
+
+func (fs *tarFs) Create(string) (afero.File, error) {
+	return nil, syscall.EPERM
+}
+
+func (fs *tarFs) Mkdir(string, os.FileMode) error {
+	return syscall.EPERM
+}
+
+func (fs *tarFs) MkdirAll(string, os.FileMode) error {
+	return syscall.EPERM
+}
+
+func (fs *tarFs) Remove(string) error {
+	return syscall.EPERM
+}
+
+func (fs *tarFs) RemoveAll(string) error {
+	return syscall.EPERM
+}
+
+func (fs *tarFs) Rename(string, string) error {
+	return syscall.EPERM
+}
+
+func (fs *tarFs) Chmod(string, os.FileMode) error {
+	return syscall.EPERM
+}
+
+func (fs *tarFs) Chown(string, int, int) error {
+	return syscall.EPERM
+}
+
+func (fs *tarFs) Chtimes(string, time.Time, time.Time) error {
+	return syscall.EPERM
+}
+
+// tarFile is a file in the archive, or the root dir if entry is nil
+type tarFile struct {
+	fs      *tarFs
+	name    string
+	entry   *tarEntry
+	section *io.SectionReader
+	listed  int // entries of the dir returned by Readdir
+}
+
+func (f *tarFile) Name() string {
+	return f.name
+}
+
+func (f *tarFile) Stat() (os.FileInfo, error) {
+	if f.entry == nil {
+		h := &tar.Header{Name: f.name, Typeflag: tar.TypeDir, Mode: 0755, ModTime: f.fs.modTime}
+		return h.FileInfo(), nil
+	}
+	return f.entry.header.FileInfo(), nil
+}
+
+func (f *tarFile) Read(p []byte) (int, error) {
+	if f.section == nil {
+		return 0, syscall.EISDIR
+	}
+	return f.section.Read(p)
+}
+
+func (f *tarFile) ReadAt(p []byte, off int64) (int, error) {
+	if f.section == nil {
+		return 0, syscall.EISDIR
+	}
+	return f.section.ReadAt(p, off)
+}
+
+func (f *tarFile) Seek(offset int64, whence int) (int64, error) {
+	if f.section == nil {
+		return 0, syscall.EISDIR
+	}
+	return f.section.Seek(offset, whence)
+}
+
+func (f *tarFile) Readdir(count int) ([]os.FileInfo, error) {
+	if f.entry != nil {
+		return nil, syscall.ENOTDIR
+	}
+
+	names := f.fs.names[f.listed:]
+	if count > 0 {
+		if len(names) == 0 {
+			return nil, io.EOF
+		}
+		names = names[:min(count, len(names))]
+	}
+	f.listed += len(names)
+	infos := make([]os.FileInfo, 0, len(names))
+	for _, name := range names {
+		infos = append(infos, f.fs.files[name].header.FileInfo())
+	}
+	return infos, nil
+}
+
+func (f *tarFile) Readdirnames(count int) ([]string, error) {
+	infos, err := f.Readdir(count)
+	names := make([]string, 0, len(infos))
+	for _, info := range infos {
+		names = append(names, info.Name())
+	}
+	return names, err
+}
+
+func (f *tarFile) Close() error {
+	return nil
+}
+
+func (f *tarFile) Sync() error {
+	return nil
+}
+
+func (f *tarFile) Write([]byte) (int, error) {
+	return 0, syscall.EPERM
+}
+
+func (f *tarFile) WriteAt([]byte, int64) (int, error) {
+	return 0, syscall.EPERM
+}
+
+func (f *tarFile) WriteString(string) (int, error) {
+	return 0, syscall.EPERM
+}
+
+func (f *tarFile) Truncate(int64) error {
+	return syscall.EPERM
+}
@@ -0,0 +1,401 @@
+package storage
+
+import (
+	"archive/tar"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/rs/zerolog/log"
+	"github.com/samber/lo"
+	"github.com/spf13/afero"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/zperf/tcpmon/tcpmon/gproto"
+	"github.com/zperf/tcpmon/tcpmon/tutils"
+)
+
+// ManifestFileName is the last file of a backup archive, readers skip it as it's not a data file
+const ManifestFileName = "tcpmon-manifest.json"
+
+// BackupTypes are the types of data files selected by a backup, host is the default stream (host info and self
+// metrics) and rollup selects the rollups of all resolutions
+var BackupTypes = []string{"tcp", "nic", "net", "event", "host", "rollup"}
+
+// Manifest describes the files in a backup archive
+type Manifest struct {
+	CreatedAt time.Time       `json:"createdAt"`
+	Hostname  string          `json:"hostname"`
+	Version   string          `json:"version"`
+	Host      json.RawMessage `json:"host,omitempty"` // the latest host info
+	From      *time.Time      `json:"from,omitempty"`
+	To        *time.Time      `json:"to,omitempty"`
+	Types     []string        `json:"types,omitempty"`
+	Encrypted bool            `json:"encrypted"` // sealed files and dictionaries are encrypted
+	Dicts     []ManifestFile  `json:"dicts"`
+	Files     []ManifestFile  `json:"files"`
+}
+
+type ManifestFile struct {
+	Name         string `json:"name"`
+	Stream       string `json:"stream"`
+	Size         int64  `json:"size"`
+	SHA256       string `json:"sha256"`
+	MinTimestamp int64  `json:"minTimestamp,omitempty"`
+	MaxTimestamp int64  `json:"maxTimestamp,omitempty"`
+	Sealed       bool   `json:"sealed"`
+	Pinned       bool   `json:"pinned,omitempty"`
+}
+
+type BackupConfig struct {
+	From   time.Time // zero for unbounded
+	To     time.Time // zero for unbounded
+	Types  []string  // all types if empty
+	Pinned bool      // include pinned files
+}
+
+func NewBackupConfig() *BackupConfig {
+	return &BackupConfig{Pinned: true}
+}
+
+func (c *BackupConfig) WithTimeRange(from, to time.Time) *BackupConfig {
+	c.From = from
+	c.To = to
+	return c
+}
+
+func (c *BackupConfig) WithTypes(types ...string) *BackupConfig {
+	c.Types = types
+	return c
+}
+
+func (c *BackupConfig) WithPinned(pinned bool) *BackupConfig {
+	c.Pinned = pinned
+	return c
+}
+
+func (c *BackupConfig) Validate() error {
+	for _, t := range c.Types {
+		if !lo.Contains(BackupTypes, t) {
+			return errors.Newf("unknown type %q, expect one of %s", t, strings.Join(BackupTypes, ", "))
+		}
+	}
+	if !c.From.IsZero() && !c.To.IsZero() && c.To.Before(c.From) {
+		return errors.Newf("to %v is before from %v", c.To, c.From)
+	}
+	return nil
+}
+
+// selects returns true if the backup includes files of the stream
+func (c *BackupConfig) selects(stream string) bool {
+	if len(c.Types) == 0 {
+		return true
+	}
+	t := stream
+	switch {
+	case stream == StreamDefault:
+		t = "host"
+	case IsRollupStream(stream):
+		t = "rollup"
+	}
+	return lo.Contains(c.Types, t)
+}
+
+// overlaps returns true if the time range [from, to] of a file overlaps the backup. Files without metrics only
+// overlap unbounded backups.
+func (c *BackupConfig) overlaps(from, to int64, ok bool) bool {
+	if c.From.IsZero() && c.To.IsZero() {
+		return true
+	}
+	if !ok {
+		return false
+	}
+	return (c.To.IsZero() || from <= c.To.Unix()) && (c.From.IsZero() || to >= c.From.Unix())
+}
+
+// activeFile is the file being written when the backup starts, packaged up to the size committed then
+type activeFile struct {
+	fh    afero.File
+	size  int64
+	index *gproto.FileIndex
+}
+
+// backupSnapshot is the state of the data dir when the backup starts
+type backupSnapshot struct {
+	files  []string // data files, ordered by number
+	dicts  []string
+	used   map[uint32]bool // ids of the dictionaries the sealed files are compressed with, nil if unknown
+	active map[string]*activeFile
+	host   []byte
+}
+
+func (s *backupSnapshot) close() {
+	for _, f := range s.active {
+		_ = f.fh.Close()
+	}
+}
+
+// Backup writes a tar archive of the data files in the time range and of the types, and the dictionaries to read
+// them, followed by the manifest. Files being written are packaged up to the size flushed when the backup starts, so
+// no file is sealed for the backup. Sealed files and dictionaries are packaged as they are on disk.
+func (ds *DataStore) Backup(w io.Writer, config *BackupConfig) (*Manifest, error) {
+	err := config.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	snapshot, err := ds.snapshot(config.Pinned)
+	if err != nil {
+		return nil, err
+	}
+	defer snapshot.close()
+
+	manifest := &Manifest{
+		CreatedAt: time.Now(),
+		Hostname:  tutils.Hostname(),
+		Version:   tutils.Version,
+		Types:     config.Types,
+		Encrypted: ds.config.Keyring != nil,
+		Dicts:     make([]ManifestFile, 0),
+		Files:     make([]ManifestFile, 0),
+	}
+	if !config.From.IsZero() {
+		manifest.From = &config.From
+	}
+	if !config.To.IsZero() {
+		manifest.To = &config.To
+	}
+	manifest.Host = hostInfoJSON(snapshot.host)
+
+	raw := rawFs(ds.fs)
+	t := tar.NewWriter(w)
+
+	for _, d := range snapshot.dicts {
+		stream := streamOfDict(filepath.Base(d))
+		if !config.selects(stream) {
+			continue
+		}
+		if id, ok := idOfDict(filepath.Base(d)); ok && snapshot.used != nil && !snapshot.used[id] {
+			continue
+		}
+		f := ManifestFile{Name: filepath.Base(d), Stream: stream}
+		err = writeBackupFile(t, raw, d, -1, nil, &f)
+		if err != nil {
+			return nil, err
+		}
+		manifest.Dicts = append(manifest.Dicts, f)
+	}
+
+	for _, file := range snapshot.files {
+		name := filepath.Base(file)
+		stream := StreamOfFile(name)
+		if !config.selects(stream) {
+			continue
+		}
+
+		f := ManifestFile{
+			Name:   name,
+			Stream: stream,
+			Sealed: strings.HasSuffix(name, SealFileSuffix),
+			Pinned: filepath.Base(filepath.Dir(file)) == PinDir,
+		}
+
+		active := snapshot.active[file]
+		var index *gproto.FileIndex
+		if active != nil {
+			index = active.index
+		} else {
+			index, err = ds.fileIndex(file)
+			if err != nil {
+				// the file is packaged when its time range is unknown
+				log.Warn().Err(err).Str("file", file).Msg("Load index failed")
+			}
+		}
+		if index != nil {
+			var ok bool
+			f.MinTimestamp, f.MaxTimestamp, ok = MetricRange(index)
+			if !config.overlaps(f.MinTimestamp, f.MaxTimestamp, ok) {
+				continue
+			}
+		}
+
+		if active != nil {
+			err = writeBackupFile(t, raw, file, active.size, active.fh, &f)
+		} else {
+			err = writeBackupFile(t, raw, file, -1, nil, &f)
+		}
+		if err != nil {
+			if os.IsNotExist(errors.UnwrapAll(err)) {
+				// reclaimed since the backup started
+				log.Warn().Str("file", file).Msg("Data file deleted during backup")
+				continue
+			}
+			return nil, err
+		}
+		manifest.Files = append(manifest.Files, f)
+	}
+
+	buf, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	err = t.WriteHeader(&tar.Header{
+		Name:    ManifestFileName,
+		Mode:    0644,
+		Size:    int64(len(buf)),
+		ModTime: manifest.CreatedAt,
+		Format:  tar.FormatPAX,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "write header failed")
+	}
+	_, err = t.Write(buf)
+	if err != nil {
+		return nil, errors.Wrap(err, "write manifest failed")
+	}
+
+	err = t.Close()
+	if err != nil {
+		return nil, errors.Wrap(err, "close archive failed")
+	}
+	return manifest, nil
+}
+
+// snapshot flushes the buffered records, lists the files and opens the files being written, so they can be read up
+// to the flushed size even if they are sealed during the backup
+func (ds *DataStore) snapshot(pinned bool) (*backupSnapshot, error) {
+	ds.mutex.Lock()
+	defer ds.mutex.Unlock()
+
+	err := ds.writeError(ds.flush(time.Now()))
+	if err != nil {
+		return nil, err
+	}
+
+	files, err := DataFiles(ds.fs, ds.baseDir)
+	if err != nil {
+		return nil, err
+	}
+	if !pinned {
+		files = lo.Filter(files, func(f string, _ int) bool {
+			return filepath.Base(filepath.Dir(f)) != PinDir
+		})
+	}
+
+	dicts, err := listDicts(ds.fs, ds.baseDir)
+	if err != nil {
+		return nil, err
+	}
+
+	s := &backupSnapshot{
+		files:  files,
+		dicts:  dicts,
+		used:   make(map[uint32]bool),
+		active: make(map[string]*activeFile),
+		host:   ds.headRecord,
+	}
+	for _, f := range files {
+		if !strings.HasSuffix(f, SealFileSuffix) {
+			continue
+		}
+		id, err := ds.dictOfFile(f)
+		if err != nil {
+			// all dictionaries are packaged
+			log.Warn().Err(err).Str("file", f).Msg("Read dictionary id failed")
+			s.used = nil
+			break
+		}
+		s.used[id] = true
+	}
+	raw := rawFs(ds.fs)
+	for _, st := range ds.sortedStreams() {
+		if st.writerFile == nil {
+			continue
+		}
+		fh, err := raw.Open(st.writerFilePath)
+		if err != nil {
+			s.close()
+			return nil, errors.Wrap(err, "open file failed")
+		}
+		s.active[st.writerFilePath] = &activeFile{fh: fh, size: st.writerOffset, index: st.index.Index()}
+	}
+	return s, nil
+}
+
+// fileIndex loads the index of the data file, or builds it if the file has no valid index
+func (ds *DataStore) fileIndex(file string) (*gproto.FileIndex, error) {
+	index, err := LoadIndex(ds.fs, file)
+	if err == nil {
+		return index, nil
+	}
+	if !errors.Is(err, ErrIndexNotFound) {
+		log.Warn().Err(err).Str("file", file).Msg("Invalid index, rebuild it")
+	}
+	return BuildIndex(ds.fs, file)
+}
+
+// writeBackupFile writes the first size bytes of the file to the archive, the whole file if size is negative. The
+// file is opened if fh is nil.
+func writeBackupFile(t *tar.Writer, fs afero.Fs, path string, size int64, fh afero.File, f *ManifestFile) error {
+	if fh == nil {
+		var err error
+		fh, err = fs.Open(path)
+		if err != nil {
+			return errors.Wrapf(err, "open %s failed", path)
+		}
+		defer fh.Close()
+	}
+
+	stat, err := fh.Stat()
+	if err != nil {
+		return errors.Wrap(err, "stat failed")
+	}
+	if size < 0 || size > stat.Size() {
+		size = stat.Size()
+	}
+
+	err = t.WriteHeader(&tar.Header{
+		Name:    f.Name,
+		Mode:    0644,
+		Size:    size,
+		ModTime: stat.ModTime(),
+		Format:  tar.FormatPAX,
+	})
+	if err != nil {
+		return errors.Wrap(err, "write header failed")
+	}
+
+	h := sha256.New()
+	_, err = io.Copy(io.MultiWriter(t, h), io.NewSectionReader(fh, 0, size))
+	if err != nil {
+		return errors.Wrap(err, "copy file failed")
+	}
+
+	f.Size = size
+	f.SHA256 = hex.EncodeToString(h.Sum(nil))
+	return nil
+}
+
+// hostInfoJSON returns the host info of the head record in JSON, nil if there is none
+func hostInfoJSON(head []byte) json.RawMessage {
+	if head == nil {
+		return nil
+	}
+	var m gproto.Metric
+	err := proto.Unmarshal(head, &m)
+	if err != nil || m.GetHost() == nil {
+		return nil
+	}
+	buf, err := protojson.Marshal(m.GetHost())
+	if err != nil {
+		return nil
+	}
+	return buf
+}
@@ -0,0 +1,490 @@
+package storage
+
+import (
+	"encoding/binary"
+	"io"
+	"math"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+	"github.com/klauspost/compress/zstd"
+	"github.com/spf13/afero"
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"github.com/zperf/tcpmon/tcpmon/gproto"
+)
+
+// A segment stores the sockets of the TCP snapshots in a data file by column, one row per socket per snapshot, so a
+// field is scanned without unmarshalling the snapshots. Each column is compressed with zstd on its own.
+//
+//	magic(8) + columns + footer + footer size(4) + magic(8)
+//
+// The footer has the number of rows, and the name, kind, offset and size of each column.
+const (
+	SegmentFilePrefix = "tcpmon-colseg-"
+	segmentMagic      = "tcpmonS1"
+
+	// ColumnTimestamp is the column of the snapshot timestamps, other columns are named after the fields of
+	// gproto.SocketMetric, e.g. rtt, local_addr
+	ColumnTimestamp = "timestamp"
+)
+
+var ErrSegmentNotFound = errors.New("segment not found")
+var ErrColumnNotFound = errors.New("column not found")
+
+type ColumnKind uint8
+
+const (
+	ColumnInt    ColumnKind = iota + 1 // signed integers and enums, zigzag varints of the delta to the previous row
+	ColumnUint                         // unsigned integers and bools, varints
+	ColumnFloat                        // float64 bits, little endian
+	ColumnString                       // length prefixed
+)
+
+// SegmentFilePath returns the path of the columnar segment of the data file
+func SegmentFilePath(dataFile string) string {
+	name := strings.TrimSuffix(filepath.Base(dataFile), SealFileSuffix)
+	name = strings.TrimPrefix(name, DataFilePrefix)
+	return filepath.Join(filepath.Dir(dataFile), SegmentFilePrefix+name)
+}
+
+func columnKind(fd protoreflect.FieldDescriptor) ColumnKind {
+	if fd.IsList() || fd.IsMap() {
+		return 0
+	}
+	switch fd.Kind() {
+	case protoreflect.EnumKind, protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind,
+		protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		return ColumnInt
+	case protoreflect.BoolKind, protoreflect.Uint32Kind, protoreflect.Fixed32Kind, protoreflect.Uint64Kind,
+		protoreflect.Fixed64Kind:
+		return ColumnUint
+	case protoreflect.FloatKind, protoreflect.DoubleKind:
+		return ColumnFloat
+	case protoreflect.StringKind:
+		return ColumnString
+	default:
+		// messages and bytes are not stored
+		return 0
+	}
+}
+
+type columnBuilder struct {
+	name  string
+	kind  ColumnKind
+	field protoreflect.FieldDescriptor // nil for the timestamp
+	buf   []byte
+	prev  int64
+}
+
+func (c *columnBuilder) add(v protoreflect.Value) {
+	switch c.kind {
+	case ColumnInt:
+		var i int64
+		if c.field.Kind() == protoreflect.EnumKind {
+			i = int64(v.Enum())
+		} else {
+			i = v.Int()
+		}
+		c.addInt(i)
+	case ColumnUint:
+		var u uint64
+		if c.field.Kind() == protoreflect.BoolKind {
+			if v.Bool() {
+				u = 1
+			}
+		} else {
+			u = v.Uint()
+		}
+		c.buf = protowire.AppendVarint(c.buf, u)
+	case ColumnFloat:
+		c.buf = binary.LittleEndian.AppendUint64(c.buf, math.Float64bits(v.Float()))
+	case ColumnString:
+		c.buf = protowire.AppendString(c.buf, v.String())
+	}
+}
+
+func (c *columnBuilder) addInt(i int64) {
+	c.buf = protowire.AppendVarint(c.buf, protowire.EncodeZigZag(i-c.prev))
+	c.prev = i
+}
+
+// SegmentBuilder builds the segment of TCP snapshots
+type SegmentBuilder struct {
+	columns []*columnBuilder
+	rows    int
+}
+
+func NewSegmentBuilder() *SegmentBuilder {
+	b := &SegmentBuilder{columns: []*columnBuilder{{name: ColumnTimestamp, kind: ColumnInt}}}
+	fields := (&gproto.SocketMetric{}).ProtoReflect().Descriptor().Fields()
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		kind := columnKind(fd)
+		if kind != 0 {
+			b.columns = append(b.columns, &columnBuilder{name: string(fd.Name()), kind: kind, field: fd})
+		}
+	}
+	return b
+}
+
+// Add adds the sockets of the snapshot
+func (b *SegmentBuilder) Add(m *gproto.TcpMetric) {
+	for _, s := range m.GetSockets() {
+		r := s.ProtoReflect()
+		b.columns[0].addInt(m.GetTimestamp())
+		for _, c := range b.columns[1:] {
+			c.add(r.Get(c.field))
+		}
+		b.rows++
+	}
+}
+
+func (b *SegmentBuilder) Rows() int {
+	return b.rows
+}
+
+// WriteTo writes the segment compressed with the zstd level
+func (b *SegmentBuilder) WriteTo(w io.Writer, level int) error {
+	encoder, err := zstd.NewWriter(nil, zstd.WithEncoderLevel(zstd.EncoderLevelFromZstd(level)),
+		zstd.WithEncoderConcurrency(1))
+	if err != nil {
+		return errors.Wrap(err, "create new zstd writer failed")
+	}
+	defer encoder.Close()
+
+	_, err = w.Write([]byte(segmentMagic))
+	if err != nil {
+		return errors.Wrap(err, "write segment failed")
+	}
+
+	offset := uint64(len(segmentMagic))
+	footer := protowire.AppendVarint(nil, uint64(b.rows))
+	footer = protowire.AppendVarint(footer, uint64(len(b.columns)))
+	for _, c := range b.columns {
+		compressed := encoder.EncodeAll(c.buf, nil)
+		_, err = w.Write(compressed)
+		if err != nil {
+			return errors.Wrapf(err, "write column %s failed", c.name)
+		}
+
+		footer = protowire.AppendString(footer, c.name)
+		footer = append(footer, byte(c.kind))
+		footer = protowire.AppendVarint(footer, offset)
+		footer = protowire.AppendVarint(footer, uint64(len(compressed)))
+		offset += uint64(len(compressed))
+	}
+
+	footer = binary.LittleEndian.AppendUint32(footer, uint32(len(footer)))
+	footer = append(footer, segmentMagic...)
+	_, err = w.Write(footer)
+	return errors.Wrap(err, "write segment footer failed")
+}
+
+// WriteSegment writes the segment of the TCP snapshots in the data file, the segment is encrypted if fs is a
+// DecryptFs
+func WriteSegment(fs afero.Fs, dataFile string, level int) error {
+	if fs == nil {
+		fs = afero.NewOsFs()
+	}
+
+	reader, err := NewDataFileReader(dataFile, fs)
+	if err != nil {
+		return err
+	}
+	defer reader.Close()
+
+	// a truncated segment would look complete, it's not written if the file can't be read to the end
+	b := NewSegmentBuilder()
+	err = iterateFile(reader, func(buf []byte) error {
+		typ, _, ok := peekMetric(buf)
+		if !ok || typ != gproto.MetricType_TCP {
+			return nil
+		}
+		var m gproto.Metric
+		err := proto.Unmarshal(buf, &m)
+		if err != nil {
+			return errors.Wrap(err, "unmarshal failed")
+		}
+		b.Add(m.GetTcp())
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	p := SegmentFilePath(dataFile)
+	out, err := fs.OpenFile(p+".tmp", os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0644)
+	if err != nil {
+		return errors.Wrap(err, "open segment file failed")
+	}
+	w, err := EncryptTo(KeyringOf(fs), out)
+	if err == nil {
+		err = b.WriteTo(w, level)
+	}
+	if err == nil {
+		err = w.Close()
+	}
+	if err != nil {
+		_ = out.Close()
+		return err
+	}
+	err = out.Close()
+	if err != nil {
+		return errors.Wrap(err, "close segment file failed")
+	}
+	return errors.Wrap(fs.Rename(p+".tmp", p), "rename segment file failed")
+}
+
+type segmentColumn struct {
+	kind   ColumnKind
+	offset int64
+	size   int64
+}
+
+// Segment reads the columns of a segment
+type Segment struct {
+	fh      afero.File
+	rows    int
+	names   []string
+	columns map[string]segmentColumn
+	decoder *zstd.Decoder
+}
+
+// OpenSegment opens the segment of the data file, returns ErrSegmentNotFound if there is no segment
+func OpenSegment(fs afero.Fs, dataFile string) (*Segment, error) {
+	if fs == nil {
+		fs = afero.NewOsFs()
+	}
+
+	fh, err := fs.Open(SegmentFilePath(dataFile))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, ErrSegmentNotFound
+		}
+		return nil, errors.Wrap(err, "open segment failed")
+	}
+
+	s, err := readSegmentFooter(fh)
+	if err != nil {
+		_ = fh.Close()
+		return nil, errors.Wrapf(err, "read segment of %s failed", dataFile)
+	}
+
+	s.decoder, err = zstd.NewReader(nil, zstd.WithDecoderConcurrency(1))
+	if err != nil {
+		_ = fh.Close()
+		return nil, errors.Wrap(err, "create new zstd reader failed")
+	}
+	return s, nil
+}
+
+func readSegmentFooter(fh afero.File) (*Segment, error) {
+	err := checkEncrypted(fh)
+	if err != nil {
+		return nil, err
+	}
+	stat, err := fh.Stat()
+	if err != nil {
+		return nil, errors.Wrap(err, "stat failed")
+	}
+
+	tail := make([]byte, 4+len(segmentMagic))
+	if stat.Size() < int64(len(segmentMagic)+len(tail)) {
+		return nil, errors.New("segment is truncated")
+	}
+	_, err = fh.ReadAt(tail, stat.Size()-int64(len(tail)))
+	if err != nil {
+		return nil, errors.Wrap(err, "read footer failed")
+	}
+	if string(tail[4:]) != segmentMagic {
+		return nil, errors.New("invalid magic of segment")
+	}
+
+	size := int64(binary.LittleEndian.Uint32(tail))
+	if size > stat.Size()-int64(len(segmentMagic)+len(tail)) {
+		return nil, errors.Newf("invalid footer size %d", size)
+	}
+	footer := make([]byte, size)
+	_, err = fh.ReadAt(footer, stat.Size()-int64(len(tail))-size)
+	if err != nil {
+		return nil, errors.Wrap(err, "read footer failed")
+	}
+
+	s := &Segment{fh: fh, columns: make(map[string]segmentColumn)}
+	rows, n := protowire.ConsumeVarint(footer)
+	if n < 0 {
+		return nil, errors.New("invalid footer")
+	}
+	footer = footer[n:]
+	s.rows = int(rows)
+
+	count, n := protowire.ConsumeVarint(footer)
+	if n < 0 {
+		return nil, errors.New("invalid footer")
+	}
+	footer = footer[n:]
+	for i := uint64(0); i < count; i++ {
+		name, n := protowire.ConsumeString(footer)
+		if n < 0 || len(footer) <= n {
+			return nil, errors.New("invalid footer")
+		}
+		kind := ColumnKind(footer[n])
+		footer = footer[n+1:]
+
+		offset, n := protowire.ConsumeVarint(footer)
+		if n < 0 {
+			return nil, errors.New("invalid footer")
+		}
+		footer = footer[n:]
+		length, n := protowire.ConsumeVarint(footer)
+		if n < 0 {
+			return nil, errors.New("invalid footer")
+		}
+		footer = footer[n:]
+
+		s.names = append(s.names, name)
+		s.columns[name] = segmentColumn{kind: kind, offset: int64(offset), size: int64(length)}
+	}
+	return s, nil
+}
+
+func (s *Segment) Close() {
+	s.decoder.Close()
+	_ = s.fh.Close()
+}
+
+// Rows returns the number of rows, sockets of all snapshots
+func (s *Segment) Rows() int {
+	return s.rows
+}
+
+// Columns returns the names of the columns
+func (s *Segment) Columns() []string {
+	return s.names
+}
+
+// Kind returns the kind of the column, 0 if there is no such column
+func (s *Segment) Kind(name string) ColumnKind {
+	return s.columns[name].kind
+}
+
+// column returns the decompressed column
+func (s *Segment) column(name string) (segmentColumn, []byte, error) {
+	c, ok := s.columns[name]
+	if !ok {
+		return c, nil, errors.Wrapf(ErrColumnNotFound, "column %s", name)
+	}
+
+	compressed := make([]byte, c.size)
+	_, err := s.fh.ReadAt(compressed, c.offset)
+	if err != nil && !errors.Is(err, io.EOF) {
+		return c, nil, errors.Wrapf(err, "read column %s failed", name)
+	}
+	buf, err := s.decoder.DecodeAll(compressed, nil)
+	if err != nil {
+		return c, nil, errors.Wrapf(err, "decompress column %s failed", name)
+	}
+	return c, buf, nil
+}
+
+// each calls fn with the value of each row, integers and bools are converted to uint64 by bits, floats by bits
+func (s *Segment) each(name string, kind ColumnKind, fn func(i int, v uint64)) error {
+	c, buf, err := s.column(name)
+	if err != nil {
+		return err
+	}
+	if kind != 0 && c.kind != kind {
+		return errors.Newf("column %s is not the expected kind", name)
+	}
+
+	prev := int64(0)
+	for i := 0; i < s.rows; i++ {
+		var v uint64
+		n := 8
+		switch c.kind {
+		case ColumnInt:
+			v, n = protowire.ConsumeVarint(buf)
+			prev += protowire.DecodeZigZag(v)
+			v = uint64(prev)
+		case ColumnUint:
+			v, n = protowire.ConsumeVarint(buf)
+		case ColumnFloat:
+			if len(buf) < 8 {
+				n = -1
+			} else {
+				v = binary.LittleEndian.Uint64(buf)
+			}
+		default:
+			return errors.Newf("column %s is not numeric", name)
+		}
+		if n < 0 {
+			return errors.Newf("column %s is truncated at row %d", name, i)
+		}
+		buf = buf[n:]
+		fn(i, v)
+	}
+	return nil
+}
+
+// Timestamps returns the snapshot timestamp of each row
+func (s *Segment) Timestamps() ([]int64, error) {
+	return s.Int64s(ColumnTimestamp)
+}
+
+// Int64s returns the values of an integer column
+func (s *Segment) Int64s(name string) ([]int64, error) {
+	values := make([]int64, s.rows)
+	err := s.each(name, ColumnInt, func(i int, v uint64) { values[i] = int64(v) })
+	return values, err
+}
+
+// Uint64s returns the values of an unsigned integer or bool column
+func (s *Segment) Uint64s(name string) ([]uint64, error) {
+	values := make([]uint64, s.rows)
+	err := s.each(name, ColumnUint, func(i int, v uint64) { values[i] = v })
+	return values, err
+}
+
+// Float64s returns the values of a numeric column converted to float64
+func (s *Segment) Float64s(name string) ([]float64, error) {
+	values := make([]float64, s.rows)
+	kind := s.Kind(name)
+	err := s.each(name, 0, func(i int, v uint64) {
+		switch kind {
+		case ColumnInt:
+			values[i] = float64(int64(v))
+		case ColumnUint:
+			values[i] = float64(v)
+		default:
+			values[i] = math.Float64frombits(v)
+		}
+	})
+	return values, err
+}
+
+// Strings returns the values of a string column
+func (s *Segment) Strings(name string) ([]string, error) {
+	c, buf, err := s.column(name)
+	if err != nil {
+		return nil, err
+	}
+	if c.kind != ColumnString {
+		return nil, errors.Newf("column %s is not a string column", name)
+	}
+
+	values := make([]string, s.rows)
+	for i := range values {
+		v, n := protowire.ConsumeString(buf)
+		if n < 0 {
+			return nil, errors.Newf("column %s is truncated at row %d", name, i)
+		}
+		values[i] = v
+		buf = buf[n:]
+	}
+	return values, nil
+}
@@ -0,0 +1,149 @@
+package storage
+
+import (
+	"os"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/rs/zerolog/log"
+	"github.com/spf13/afero"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/zperf/tcpmon/tcpmon/gproto"
+	"github.com/zperf/tcpmon/tcpmon/rollup"
+)
+
+// streams rolled up by the first rollup level
+var rollupSources = []string{StreamTcp, StreamNic, StreamNet}
+
+// Compact rolls up sealed data files by the rollup levels, source files are deleted once their rollups are written.
+// Windows are aggregated per compaction, so a window across files compacted at different times has more than one
+// rollup. Rollups may be duplicated if the process crashes before source files are deleted.
+func (ds *DataStore) Compact(now time.Time) error {
+	for i, level := range ds.config.RollupLevels {
+		sources := rollupSources
+		if i > 0 {
+			sources = []string{RollupStream(ds.config.RollupLevels[i-1].Resolution)}
+		}
+
+		err := ds.compactLevel(now, level, sources)
+		if err != nil {
+			return errors.Wrapf(err, "compact level %s failed", RollupStream(level.Resolution))
+		}
+	}
+	return nil
+}
+
+func (ds *DataStore) compactLevel(now time.Time, level RollupLevel, sources []string) error {
+	files, err := ds.compactionFiles(now.Add(-level.After), sources)
+	if err != nil {
+		return err
+	}
+	if len(files) == 0 {
+		return nil
+	}
+
+	// files are sealed, they are read without the lock. Files that can't be read to the end are left as they are, only
+	// the files rolled up are deleted.
+	aggregator := rollup.NewAggregator(level.Resolution, ds.config.RollupTopN)
+	rollups := make([]*gproto.RollupMetric, 0)
+	compacted := make([]dataFileInfo, 0, len(files))
+	for _, f := range files {
+		metrics, err := readMetrics(ds.fs, f.path, ds.dictCache)
+		if err != nil {
+			log.Warn().Err(err).Str("file", f.path).Msg("Read data file failed, it's not compacted")
+			continue
+		}
+		for _, m := range metrics {
+			rollups = append(rollups, aggregator.Add(m)...)
+		}
+		compacted = append(compacted, f)
+	}
+	rollups = append(rollups, aggregator.Flush()...)
+
+	ds.mutex.Lock()
+	defer ds.mutex.Unlock()
+
+	stream := RollupStream(level.Resolution)
+	for _, r := range rollups {
+		buf, err := proto.Marshal(&gproto.Metric{Body: &gproto.Metric_Rollup{Rollup: r}})
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		err = ds.put(stream, buf)
+		if err != nil {
+			return err
+		}
+	}
+	err = ds.flush(now)
+	if err != nil {
+		return err
+	}
+
+	for _, f := range compacted {
+		err = ds.fs.Remove(f.path)
+		if err != nil && !os.IsNotExist(err) {
+			log.Warn().Err(err).Str("file", f.path).Msg("Delete compacted file failed")
+			continue
+		}
+		for _, sidecar := range sidecarFiles(f.path) {
+			err = ds.fs.Remove(sidecar)
+			if err != nil && !os.IsNotExist(err) {
+				log.Warn().Err(err).Str("file", sidecar).Msg("Delete sidecar failed")
+			}
+		}
+	}
+
+	log.Info().Str("stream", stream).Int("files", len(compacted)).Int("rollups", len(rollups)).Msg("Compacted")
+	return nil
+}
+
+// compactionFiles returns sealed files of the streams with all records before the deadline
+func (ds *DataStore) compactionFiles(deadline time.Time, streams []string) ([]dataFileInfo, error) {
+	ds.mutex.Lock()
+	defer ds.mutex.Unlock()
+
+	files, err := ds.listDataFiles()
+	if err != nil {
+		return nil, err
+	}
+
+	selected := make([]dataFileInfo, 0)
+	for _, f := range files {
+		if !f.sealed {
+			continue
+		}
+		for _, stream := range streams {
+			if f.stream == stream && ds.lastTimestamp(f).Before(deadline) {
+				selected = append(selected, f)
+				break
+			}
+		}
+	}
+	return selected, nil
+}
+
+// readMetrics returns the metrics in the data file, records not metrics are skipped
+func readMetrics(fs afero.Fs, dataFile string, dicts *DictCache) ([]*gproto.Metric, error) {
+	reader, err := newDataFileReader(dataFile, fs, dicts)
+	if err != nil {
+		return nil, err
+	}
+	defer reader.Close()
+
+	metrics := make([]*gproto.Metric, 0)
+	err = iterateFile(reader, func(buf []byte) error {
+		var m gproto.Metric
+		err := proto.Unmarshal(buf, &m)
+		if err != nil {
+			// not a metric
+			return nil
+		}
+		metrics = append(metrics, &m)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return metrics, nil
+}
@@ -1,20 +1,110 @@
 package storage
 
-import "github.com/spf13/afero"
+import (
+	"strings"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/spf13/afero"
+)
 
 type Config struct {
-	BaseDir           string
-	Fs                afero.Fs
-	MaxSize           int64
-	MaxEntriesPerFile uint32
+	BaseDir             string
+	Fs                  afero.Fs
+	MaxSize             int64
+	MaxAge              time.Duration
+	StreamMaxSize       map[string]int64
+	MaxEntriesPerFile   uint32
+	KeyframeInterval    uint32
+	CompressionLevel    int           // zstd level of sealed files
+	FrameSize           int           // min size of zstd frames in sealed files, in bytes
+	DictSize            int           // max size of the zstd dictionary trained for each stream, 0 to disable dictionaries
+	DictRetrainInterval time.Duration // dictionaries are retrained after it, 0 to retrain only if the ratio drops
+	Keyring             *Keyring      // encrypts sealed files and dictionaries if not nil
+	Columnar            bool          // writes a columnar segment for each sealed TCP file
+
+	WriteInterval  time.Duration
+	WriteBatchSize uint32
+	SyncPolicy     SyncPolicy
+
+	RollupLevels []RollupLevel
+	RollupTopN   int
+}
+
+// RollupLevel rolls up sealed data files older than After into records of the resolution. The first level rolls up
+// raw metrics, each of the following levels rolls up the rollups of the previous level.
+type RollupLevel struct {
+	Resolution time.Duration
+	After      time.Duration
+}
+
+// ParseRollupLevels parses levels in the form of resolution:after separated by commas, e.g. 1m:1h,10m:24h
+func ParseRollupLevels(s string) ([]RollupLevel, error) {
+	levels := make([]RollupLevel, 0)
+	if strings.TrimSpace(s) == "" {
+		return levels, nil
+	}
+
+	for _, level := range strings.Split(s, ",") {
+		resolution, after, ok := strings.Cut(strings.TrimSpace(level), ":")
+		if !ok {
+			return nil, errors.Newf("invalid rollup level %q, resolution:after expected", level)
+		}
+
+		var l RollupLevel
+		var err error
+		l.Resolution, err = time.ParseDuration(resolution)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid resolution of rollup level %q", level)
+		}
+		l.After, err = time.ParseDuration(after)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid age of rollup level %q", level)
+		}
+		if l.Resolution < time.Second {
+			return nil, errors.Newf("resolution of rollup level %q is less than 1s", level)
+		}
+
+		if n := len(levels); n > 0 && (l.Resolution <= levels[n-1].Resolution || l.After <= levels[n-1].After) {
+			return nil, errors.Newf("rollup level %q is not coarser and older than the previous one", level)
+		}
+		levels = append(levels, l)
+	}
+	return levels, nil
+}
+
+// SyncPolicy decides when to fsync data files after buffered records are written
+type SyncPolicy string
+
+const (
+	SyncNever      SyncPolicy = "never"       // only when a file is sealed or closed
+	SyncInterval   SyncPolicy = "interval"    // at most once per WriteInterval
+	SyncEveryBatch SyncPolicy = "every-batch" // after every flush
+)
+
+func ParseSyncPolicy(s string) (SyncPolicy, error) {
+	switch p := SyncPolicy(s); p {
+	case SyncNever, SyncInterval, SyncEveryBatch:
+		return p, nil
+	default:
+		return "", errors.Newf("invalid sync policy %q", s)
+	}
 }
 
 func NewConfig(baseDir string) *Config {
 	return &Config{
-		BaseDir:           baseDir,
-		Fs:                afero.NewOsFs(),
-		MaxSize:           100 * (1 << 20),
-		MaxEntriesPerFile: 1000,
+		BaseDir:             baseDir,
+		Fs:                  afero.NewOsFs(),
+		MaxSize:             100 * (1 << 20),
+		StreamMaxSize:       make(map[string]int64),
+		MaxEntriesPerFile:   1000,
+		KeyframeInterval:    60,
+		CompressionLevel:    3,
+		FrameSize:           256 << 10,
+		DictSize:            64 << 10,
+		DictRetrainInterval: 24 * time.Hour,
+		SyncPolicy:          SyncNever,
+		RollupTopN:          10,
 	}
 }
 
@@ -29,8 +119,100 @@ func (c *Config) WithMaxSize(size int64) *Config {
 	return c
 }
 
+// WithMaxAge set the max age of data files, files with all records older than it are deleted. 0 disables it.
+func (c *Config) WithMaxAge(age time.Duration) *Config {
+	c.MaxAge = age
+	return c
+}
+
+// WithStreamMaxSize set the max size of a stream, in bytes. Streams without their own max size share MaxSize.
+func (c *Config) WithStreamMaxSize(stream string, size int64) *Config {
+	if c.StreamMaxSize == nil {
+		c.StreamMaxSize = make(map[string]int64)
+	}
+	c.StreamMaxSize[stream] = size
+	return c
+}
+
 // WithMaxEntriesPerFile set the max entries count per file
 func (c *Config) WithMaxEntriesPerFile(entries uint32) *Config {
 	c.MaxEntriesPerFile = entries
 	return c
 }
+
+// WithKeyframeInterval set the interval of full TCP snapshots, snapshots in between are stored as deltas.
+// 0 or 1 disables delta encoding.
+func (c *Config) WithKeyframeInterval(interval uint32) *Config {
+	c.KeyframeInterval = interval
+	return c
+}
+
+// WithCompressionLevel set the zstd level of sealed files, from 1 (fastest) to 22 (best)
+func (c *Config) WithCompressionLevel(level int) *Config {
+	c.CompressionLevel = level
+	return c
+}
+
+// WithFrameSize set the min size of zstd frames in sealed files. Reading a record decompresses its frame only,
+// smaller frames make seeking faster but compress worse.
+func (c *Config) WithFrameSize(size int) *Config {
+	c.FrameSize = size
+	return c
+}
+
+// WithDictSize set the max size of zstd dictionaries. A dictionary is trained for each stream from the records of its
+// first sealed file, and used to compress the files sealed after. 0 disables dictionaries.
+func (c *Config) WithDictSize(size int) *Config {
+	c.DictSize = size
+	return c
+}
+
+// WithDictRetrainInterval set the age of dictionaries to retrain them from the records of the next sealed file.
+// Dictionaries are also retrained if the compression ratio drops. 0 disables the periodic retraining.
+func (c *Config) WithDictRetrainInterval(interval time.Duration) *Config {
+	c.DictRetrainInterval = interval
+	return c
+}
+
+// WithKeyring set the keyring to encrypt sealed files and dictionaries, files are encrypted with the current key of
+// the keyring. Encrypted files are read with any key in the keyring.
+func (c *Config) WithKeyring(keyring *Keyring) *Config {
+	c.Keyring = keyring
+	return c
+}
+
+// WithColumnar set whether a columnar segment is written for each sealed TCP file, so a single field of the sockets
+// is scanned without unmarshalling the snapshots
+func (c *Config) WithColumnar(columnar bool) *Config {
+	c.Columnar = columnar
+	return c
+}
+
+// WithWriteInterval set the max time records are buffered before written to files. 0 writes every record at once.
+func (c *Config) WithWriteInterval(interval time.Duration) *Config {
+	c.WriteInterval = interval
+	return c
+}
+
+// WithWriteBatchSize set the max number of buffered records, 0 for no limit
+func (c *Config) WithWriteBatchSize(size uint32) *Config {
+	c.WriteBatchSize = size
+	return c
+}
+
+func (c *Config) WithSyncPolicy(policy SyncPolicy) *Config {
+	c.SyncPolicy = policy
+	return c
+}
+
+// WithRollupLevels set the levels of rollups, in the order of resolution. No levels disables compaction.
+func (c *Config) WithRollupLevels(levels ...RollupLevel) *Config {
+	c.RollupLevels = levels
+	return c
+}
+
+// WithRollupTopN set the number of sockets kept in TCP rollups, by retransmissions and by bytes respectively
+func (c *Config) WithRollupTopN(n int) *Config {
+	c.RollupTopN = n
+	return c
+}
@@ -0,0 +1,544 @@
+package storage
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/binary"
+	"io"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/spf13/afero"
+	"golang.org/x/crypto/scrypt"
+)
+
+// Encrypted files start with a header, followed by chunks of AES-GCM. Each file is encrypted with a random data key,
+// which is wrapped by the key of the key id in the header (envelope encryption), so keys are rotated by adding a new
+// key, files encrypted with the old keys are still readable.
+//
+//	magic(8) + key id length(1) + key id + salt(16) + nonce(12) + wrapped data key(48) + chunk size(4)
+//
+// The data key is wrapped with the rest of the header as the additional data, so the header can't be altered. Chunks
+// are encrypted with the chunk index as the nonce, and the last chunk is marked to detect truncation.
+const (
+	encMagic        = "tcpmonE1"
+	encSaltSize     = 16
+	encChunkSize    = 64 << 10
+	encMaxChunkSize = 16 << 20
+	KeySize         = 32
+
+	// PassphraseKeyID is the key id of keys derived from the passphrase
+	PassphraseKeyID = "passphrase"
+)
+
+var ErrEncrypted = errors.New("file is encrypted, a key is required")
+var ErrKeyNotFound = errors.New("key not found")
+
+// Keyring holds the keys to encrypt and decrypt files. New files are encrypted with the current key, which is the
+// last key added or the passphrase.
+type Keyring struct {
+	keys    map[string][]byte
+	current string
+
+	passphrase []byte
+	salt       []byte            // salt of the passphrase key of new files
+	derived    map[string][]byte // keys derived from the passphrase by salt
+	mutex      sync.Mutex
+}
+
+func NewKeyring() *Keyring {
+	return &Keyring{
+		keys:    make(map[string][]byte),
+		derived: make(map[string][]byte),
+	}
+}
+
+// Add adds the key, it becomes the current key
+func (k *Keyring) Add(id string, key []byte) error {
+	if id == "" || len(id) > 255 || id == PassphraseKeyID {
+		return errors.Newf("invalid key id %q", id)
+	}
+	if len(key) != KeySize {
+		return errors.Newf("key %s has %d bytes, %d expected", id, len(key), KeySize)
+	}
+	k.keys[id] = key
+	k.current = id
+	return nil
+}
+
+// SetPassphrase derives keys from the passphrase, it becomes the current key
+func (k *Keyring) SetPassphrase(passphrase []byte) error {
+	if len(passphrase) == 0 {
+		return errors.New("empty passphrase")
+	}
+	k.passphrase = passphrase
+	k.salt = make([]byte, encSaltSize)
+	_, err := rand.Read(k.salt)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	k.current = PassphraseKeyID
+	return nil
+}
+
+// LoadKeyFile adds the keys in the file, one key per line as "<id> <base64 key>". Lines starting with # are ignored,
+// the last key is the current key.
+func (k *Keyring) LoadKeyFile(fs afero.Fs, path string) error {
+	buf, err := afero.ReadFile(fs, path)
+	if err != nil {
+		return errors.Wrap(err, "read key file failed")
+	}
+
+	scanner := bufio.NewScanner(bytes.NewReader(buf))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		fields := strings.Fields(line)
+		if len(fields) != 2 {
+			return errors.Newf("invalid line %q in key file, <id> <base64 key> expected", line)
+		}
+		key, err := base64.StdEncoding.DecodeString(fields[1])
+		if err != nil {
+			return errors.Wrapf(err, "invalid key %s", fields[0])
+		}
+		err = k.Add(fields[0], key)
+		if err != nil {
+			return err
+		}
+	}
+	if len(k.keys) == 0 {
+		return errors.Newf("no key in %s", path)
+	}
+	return nil
+}
+
+// GenerateKey returns a line of key file with a random key
+func GenerateKey(id string) (string, error) {
+	key := make([]byte, KeySize)
+	_, err := rand.Read(key)
+	if err != nil {
+		return "", errors.WithStack(err)
+	}
+	return id + " " + base64.StdEncoding.EncodeToString(key), nil
+}
+
+// Current returns the id of the current key
+func (k *Keyring) Current() string {
+	return k.current
+}
+
+// key returns the key of the id, keys of the passphrase are derived with the salt
+func (k *Keyring) key(id string, salt []byte) ([]byte, error) {
+	if id != PassphraseKeyID {
+		key, ok := k.keys[id]
+		if !ok {
+			return nil, errors.Wrapf(ErrKeyNotFound, "key %s", id)
+		}
+		return key, nil
+	}
+
+	if k.passphrase == nil {
+		return nil, errors.Wrap(ErrKeyNotFound, "no passphrase")
+	}
+	k.mutex.Lock()
+	defer k.mutex.Unlock()
+	key, ok := k.derived[string(salt)]
+	if !ok {
+		var err error
+		key, err = scrypt.Key(k.passphrase, salt, 1<<15, 8, 1, KeySize)
+		if err != nil {
+			return nil, errors.Wrap(err, "derive key failed")
+		}
+		k.derived[string(salt)] = key
+	}
+	return key, nil
+}
+
+func newGCM(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	gcm, err := cipher.NewGCM(block)
+	return gcm, errors.WithStack(err)
+}
+
+// chunkNonce returns the nonce and the additional data of the chunk
+func chunkNonce(index uint64, final bool) ([]byte, []byte) {
+	nonce := make([]byte, 12)
+	binary.BigEndian.PutUint64(nonce[4:], index)
+	ad := []byte{0}
+	if final {
+		ad[0] = 1
+	}
+	return nonce, ad
+}
+
+// Encrypt returns a writer encrypting to w with the current key. The writer must be closed to write the last chunk,
+// w is not closed.
+func (k *Keyring) Encrypt(w io.Writer) (io.WriteCloser, error) {
+	if k.current == "" {
+		return nil, errors.Wrap(ErrKeyNotFound, "no current key")
+	}
+
+	salt := k.salt
+	if k.current != PassphraseKeyID {
+		salt = make([]byte, encSaltSize)
+	}
+	kek, err := k.key(k.current, salt)
+	if err != nil {
+		return nil, err
+	}
+	wrapper, err := newGCM(kek)
+	if err != nil {
+		return nil, err
+	}
+
+	dek := make([]byte, KeySize)
+	nonce := make([]byte, wrapper.NonceSize())
+	_, err = rand.Read(dek)
+	if err == nil {
+		_, err = rand.Read(nonce)
+	}
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	aead, err := newGCM(dek)
+	if err != nil {
+		return nil, err
+	}
+
+	header := []byte(encMagic)
+	header = append(header, byte(len(k.current)))
+	header = append(header, k.current...)
+	header = append(header, salt...)
+	header = append(header, nonce...)
+	chunkSize := binary.LittleEndian.AppendUint32(nil, encChunkSize)
+	header = wrapper.Seal(header, nonce, dek, headerAD(header, chunkSize))
+	header = append(header, chunkSize...)
+
+	_, err = w.Write(header)
+	if err != nil {
+		return nil, errors.Wrap(err, "write header failed")
+	}
+	return &encryptWriter{w: w, aead: aead, chunkSize: encChunkSize}, nil
+}
+
+type encryptWriter struct {
+	w         io.Writer
+	aead      cipher.AEAD
+	chunkSize int
+	buf       []byte
+	index     uint64
+}
+
+func (e *encryptWriter) writeChunk(chunk []byte, final bool) error {
+	nonce, ad := chunkNonce(e.index, final)
+	e.index++
+	_, err := e.w.Write(e.aead.Seal(nil, nonce, chunk, ad))
+	return errors.Wrap(err, "write chunk failed")
+}
+
+func (e *encryptWriter) Write(p []byte) (int, error) {
+	e.buf = append(e.buf, p...)
+	// the last chunk is kept until closed, it's marked as the final chunk
+	for len(e.buf) > e.chunkSize {
+		err := e.writeChunk(e.buf[:e.chunkSize], false)
+		if err != nil {
+			return 0, err
+		}
+		e.buf = e.buf[e.chunkSize:]
+	}
+	return len(p), nil
+}
+
+func (e *encryptWriter) Close() error {
+	return e.writeChunk(e.buf, true)
+}
+
+type nopWriteCloser struct {
+	io.Writer
+}
+
+func (nopWriteCloser) Close() error {
+	return nil
+}
+
+// EncryptTo returns a writer encrypting to w if the keyring is not nil
+func EncryptTo(keyring *Keyring, w io.Writer) (io.WriteCloser, error) {
+	if keyring == nil {
+		return nopWriteCloser{w}, nil
+	}
+	return keyring.Encrypt(w)
+}
+
+// headerAD returns the additional data of the wrapped data key: the header before it and the chunk size
+func headerAD(prefix []byte, chunkSize []byte) []byte {
+	return append(bytes.Clone(prefix), chunkSize...)
+}
+
+// isEncrypted returns true if the file starts with the header of encrypted files
+func isEncrypted(f io.ReaderAt) bool {
+	magic := make([]byte, len(encMagic))
+	n, _ := f.ReadAt(magic, 0)
+	return n == len(magic) && string(magic) == encMagic
+}
+
+// checkEncrypted returns ErrEncrypted if the file is encrypted, files opened by DecryptFs are decrypted already
+func checkEncrypted(f io.ReaderAt) error {
+	if isEncrypted(f) {
+		return ErrEncrypted
+	}
+	return nil
+}
+
+// decrypt returns the decrypted file if the file is encrypted, or the file itself
+func (k *Keyring) decrypt(f afero.File) (afero.File, error) {
+	stat, err := f.Stat()
+	if err != nil {
+		return nil, errors.Wrap(err, "stat failed")
+	}
+	if stat.IsDir() || !isEncrypted(f) {
+		return f, nil
+	}
+
+	// the header
+	buf := make([]byte, len(encMagic)+1+255+encSaltSize+12+KeySize+16+4)
+	n, err := f.ReadAt(buf, 0)
+	if err != nil && !errors.Is(err, io.EOF) {
+		return nil, errors.Wrap(err, "read header failed")
+	}
+	buf = buf[:n]
+	p := len(encMagic)
+	if len(buf) <= p {
+		return nil, errors.New("invalid header of encrypted file")
+	}
+	idLen := int(buf[p])
+	p++
+	headerLen := p + idLen + encSaltSize + 12 + KeySize + 16 + 4
+	if len(buf) < headerLen {
+		return nil, errors.New("invalid header of encrypted file")
+	}
+	id := string(buf[p : p+idLen])
+	p += idLen
+	salt := buf[p : p+encSaltSize]
+	p += encSaltSize
+	nonce := buf[p : p+12]
+	p += 12
+	prefix := buf[:p]
+	wrapped := buf[p : p+KeySize+16]
+	p += KeySize + 16
+	chunkSize := int(binary.LittleEndian.Uint32(buf[p : p+4]))
+	if chunkSize == 0 || chunkSize > encMaxChunkSize {
+		return nil, errors.Newf("invalid chunk size %d of encrypted file", chunkSize)
+	}
+
+	kek, err := k.key(id, salt)
+	if err != nil {
+		return nil, err
+	}
+	wrapper, err := newGCM(kek)
+	if err != nil {
+		return nil, err
+	}
+	dek, err := wrapper.Open(nil, nonce, wrapped, headerAD(prefix, buf[p:p+4]))
+	if err != nil {
+		return nil, errors.Wrapf(err, "unwrap the data key with key %s failed", id)
+	}
+	aead, err := newGCM(dek)
+	if err != nil {
+		return nil, err
+	}
+
+	// the size of the plaintext, the last chunk may be shorter
+	d := &decryptedFile{File: f, aead: aead, headerLen: int64(headerLen), chunkSize: int64(chunkSize), chunk: -1}
+	sealed := int64(chunkSize + aead.Overhead())
+	n64 := stat.Size() - d.headerLen
+	d.chunks = (n64 + sealed - 1) / sealed
+	last := n64 - (d.chunks-1)*sealed
+	if d.chunks == 0 || last < int64(aead.Overhead()) {
+		return nil, errors.Wrapf(ErrTornRecord, "encrypted file %s is truncated", f.Name())
+	}
+	d.size = (d.chunks-1)*d.chunkSize + last - int64(aead.Overhead())
+	return d, nil
+}
+
+// decryptedFile reads the plaintext of an encrypted file, only the last decrypted chunk is kept in memory
+type decryptedFile struct {
+	afero.File
+	aead      cipher.AEAD
+	headerLen int64
+	chunkSize int64
+	chunks    int64
+	size      int64
+	offset    int64 // for Read and Seek
+
+	chunk int64 // index of the decrypted chunk, -1 for none
+	buf   []byte
+}
+
+func (d *decryptedFile) load(i int64) error {
+	if d.chunk == i {
+		return nil
+	}
+
+	sealed := d.chunkSize + int64(d.aead.Overhead())
+	size := sealed
+	if i == d.chunks-1 {
+		size = d.size - i*d.chunkSize + int64(d.aead.Overhead())
+	}
+	ciphertext := make([]byte, size)
+	_, err := d.File.ReadAt(ciphertext, d.headerLen+i*sealed)
+	if err != nil && !errors.Is(err, io.EOF) {
+		return errors.Wrapf(err, "read chunk %d failed", i)
+	}
+
+	nonce, ad := chunkNonce(uint64(i), i == d.chunks-1)
+	d.buf, err = d.aead.Open(d.buf[:0], nonce, ciphertext, ad)
+	if err != nil {
+		d.chunk = -1
+		return errors.Wrapf(err, "decrypt chunk %d of %s failed", i, d.Name())
+	}
+	d.chunk = i
+	return nil
+}
+
+func (d *decryptedFile) ReadAt(p []byte, off int64) (int, error) {
+	if off < 0 {
+		return 0, errors.Newf("negative offset %d", off)
+	}
+
+	n := 0
+	for n < len(p) {
+		pos := off + int64(n)
+		if pos >= d.size {
+			return n, io.EOF
+		}
+		i := pos / d.chunkSize
+		err := d.load(i)
+		if err != nil {
+			return n, err
+		}
+		n += copy(p[n:], d.buf[pos-i*d.chunkSize:])
+	}
+	return n, nil
+}
+
+func (d *decryptedFile) Read(p []byte) (int, error) {
+	n, err := d.ReadAt(p, d.offset)
+	d.offset += int64(n)
+	if err == io.EOF && n > 0 {
+		err = nil
+	}
+	return n, err
+}
+
+func (d *decryptedFile) Seek(offset int64, whence int) (int64, error) {
+	switch whence {
+	case io.SeekStart:
+	case io.SeekCurrent:
+		offset += d.offset
+	case io.SeekEnd:
+		offset += d.size
+	default:
+		return 0, errors.Newf("invalid whence %d", whence)
+	}
+	if offset < 0 {
+		return 0, errors.Newf("negative offset %d", offset)
+	}
+	d.offset = offset
+	return offset, nil
+}
+
+func (d *decryptedFile) Stat() (os.FileInfo, error) {
+	stat, err := d.File.Stat()
+	if err != nil {
+		return nil, err
+	}
+	return decryptedFileInfo{FileInfo: stat, size: d.size}, nil
+}
+
+func (d *decryptedFile) Write([]byte) (int, error) {
+	return 0, errors.New("decrypted files are read-only")
+}
+
+func (d *decryptedFile) WriteAt([]byte, int64) (int, error) {
+	return 0, errors.New("decrypted files are read-only")
+}
+
+func (d *decryptedFile) WriteString(string) (int, error) {
+	return 0, errors.New("decrypted files are read-only")
+}
+
+func (d *decryptedFile) Truncate(int64) error {
+	return errors.New("decrypted files are read-only")
+}
+
+type decryptedFileInfo struct {
+	os.FileInfo
+	size int64
+}
+
+func (i decryptedFileInfo) Size() int64 {
+	return i.size
+}
+
+// DecryptFs decrypts encrypted files opened for reading, other files and operations are passed through. Stat of the
+// fs returns the size on disk, Stat of opened files returns the size of the plaintext.
+type DecryptFs struct {
+	afero.Fs
+	keyring *Keyring
+}
+
+func NewDecryptFs(fs afero.Fs, keyring *Keyring) *DecryptFs {
+	return &DecryptFs{Fs: fs, keyring: keyring}
+}
+
+// KeyringOf returns the keyring of the fs, nil if the fs is not a DecryptFs
+func KeyringOf(fs afero.Fs) *Keyring {
+	if d, ok := fs.(*DecryptFs); ok {
+		return d.keyring
+	}
+	return nil
+}
+
+// rawFs returns the fs under the DecryptFs, files are read as they are on disk
+func rawFs(fs afero.Fs) afero.Fs {
+	if d, ok := fs.(*DecryptFs); ok {
+		return d.Fs
+	}
+	return fs
+}
+
+func (fs *DecryptFs) Open(name string) (afero.File, error) {
+	f, err := fs.Fs.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	d, err := fs.keyring.decrypt(f)
+	if err != nil {
+		_ = f.Close()
+		return nil, errors.Wrapf(err, "decrypt %s failed", name)
+	}
+	return d, nil
+}
+
+func (fs *DecryptFs) OpenFile(name string, flag int, perm os.FileMode) (afero.File, error) {
+	if flag == os.O_RDONLY {
+		return fs.Open(name)
+	}
+	return fs.Fs.OpenFile(name, flag, perm)
+}
+
+func (fs *DecryptFs) Chtimes(name string, atime time.Time, mtime time.Time) error {
+	return fs.Fs.Chtimes(name, atime, mtime)
+}
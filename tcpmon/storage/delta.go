@@ -0,0 +1,393 @@
+package storage
+
+import (
+	"bytes"
+
+	"github.com/cockroachdb/errors"
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"github.com/zperf/tcpmon/tcpmon/conntrack"
+	"github.com/zperf/tcpmon/tcpmon/gproto"
+)
+
+var ErrMissingKeyframe = errors.New("delta without a keyframe")
+
+// field numbers of gproto.Metric.Body
+const tcpFieldNumber = 1
+const tcpDeltaFieldNumber = 6
+
+// bodyFieldNumber peeks the field number of the metric body without unmarshalling, returns 0 if the value is
+// not a marshaled gproto.Metric
+func bodyFieldNumber(value []byte) protowire.Number {
+	num, typ, n := protowire.ConsumeTag(value)
+	if n < 0 || typ != protowire.BytesType {
+		return 0
+	}
+	return num
+}
+
+// DeltaEncoder encodes TCP snapshots as deltas against the previous snapshot. A keyframe (the full snapshot) is
+// written every keyframeInterval snapshots, 0 or 1 disables deltas. Call Reset when starting a new data file, the
+// first snapshot in each file is always a keyframe.
+type DeltaEncoder struct {
+	prev             *gproto.TcpMetric
+	deltas           uint32 // deltas since the last keyframe
+	keyframeInterval uint32
+}
+
+func NewDeltaEncoder(keyframeInterval uint32) *DeltaEncoder {
+	return &DeltaEncoder{keyframeInterval: keyframeInterval}
+}
+
+func (e *DeltaEncoder) Reset() {
+	e.prev = nil
+	e.deltas = 0
+}
+
+// Encode returns the value to store. Records other than TCP snapshots are returned as is.
+func (e *DeltaEncoder) Encode(value []byte) ([]byte, error) {
+	if bodyFieldNumber(value) != tcpFieldNumber {
+		return value, nil
+	}
+
+	var m gproto.Metric
+	err := proto.Unmarshal(value, &m)
+	if err != nil || m.GetTcp() == nil {
+		// not a metric, store it as is
+		return value, nil
+	}
+	cur := m.GetTcp()
+
+	if e.prev == nil || e.deltas+1 >= e.keyframeInterval {
+		e.prev = cur
+		e.deltas = 0
+		return value, nil
+	}
+
+	delta := DiffTcp(e.prev, cur)
+	delta.Seq = e.deltas + 1
+	buf, err := proto.Marshal(&gproto.Metric{Body: &gproto.Metric_TcpDelta{TcpDelta: delta}})
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	e.prev = cur
+	e.deltas++
+	return buf, nil
+}
+
+// DeltaDecoder reconstructs TCP snapshots from keyframes and deltas. Records must be decoded in order, use one
+// decoder per data file.
+type DeltaDecoder struct {
+	prev   *gproto.TcpMetric
+	deltas uint32 // deltas since the last keyframe
+}
+
+func NewDeltaDecoder() *DeltaDecoder {
+	return &DeltaDecoder{}
+}
+
+// Reset drops the previous snapshot, e.g. after a corrupted record. Deltas fail with ErrMissingKeyframe until the
+// next keyframe.
+func (d *DeltaDecoder) Reset() {
+	d.prev = nil
+	d.deltas = 0
+}
+
+// DecodeMetric returns the full TCP snapshot for keyframes and deltas, or nil for other records. A delta not
+// computed from the previous snapshot, e.g. a record between them is lost, fails with ErrMissingKeyframe and resets
+// the decoder.
+func (d *DeltaDecoder) DecodeMetric(value []byte) (*gproto.Metric, error) {
+	switch bodyFieldNumber(value) {
+	case tcpFieldNumber:
+		var m gproto.Metric
+		err := proto.Unmarshal(value, &m)
+		if err != nil || m.GetTcp() == nil {
+			// not a metric, it was stored as is
+			return nil, nil
+		}
+		d.prev = m.GetTcp()
+		d.deltas = 0
+		return &m, nil
+
+	case tcpDeltaFieldNumber:
+		var m gproto.Metric
+		err := proto.Unmarshal(value, &m)
+		if err != nil || m.GetTcpDelta() == nil {
+			return nil, nil
+		}
+		if d.prev == nil {
+			return nil, ErrMissingKeyframe
+		}
+		delta := m.GetTcpDelta()
+		if delta.GetSeq() != 0 && (delta.GetSeq() != d.deltas+1 || delta.GetBaseTimestamp() != d.prev.GetTimestamp()) {
+			d.Reset()
+			return nil, ErrMissingKeyframe
+		}
+
+		cur, err := ApplyTcp(d.prev, delta)
+		if err != nil {
+			return nil, err
+		}
+		d.prev = cur
+		d.deltas++
+		return &gproto.Metric{Body: &gproto.Metric_Tcp{Tcp: cur}}, nil
+
+	default:
+		return nil, nil
+	}
+}
+
+// Decode returns the value with deltas reconstructed into marshaled full snapshots, other records are returned
+// as is
+func (d *DeltaDecoder) Decode(value []byte) ([]byte, error) {
+	isDelta := bodyFieldNumber(value) == tcpDeltaFieldNumber
+
+	m, err := d.DecodeMetric(value)
+	if err != nil {
+		return nil, err
+	}
+	if m == nil || !isDelta {
+		return value, nil
+	}
+
+	buf, err := proto.Marshal(m)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return buf, nil
+}
+
+// DiffTcp computes the delta from prev to cur. Sockets are matched by conntrack.Key, sockets sharing the same key
+// are matched in order.
+func DiffTcp(prev *gproto.TcpMetric, cur *gproto.TcpMetric) *gproto.TcpDelta {
+	index := make(map[string][]int)
+	for i, s := range prev.GetSockets() {
+		key := conntrack.Key(s)
+		index[key] = append(index[key], i)
+	}
+
+	delta := &gproto.TcpDelta{
+		Timestamp:     cur.GetTimestamp(),
+		Type:          gproto.MetricType_TCP_DELTA,
+		Sockets:       make([]*gproto.SocketDelta, 0, len(cur.GetSockets())),
+		BaseTimestamp: prev.GetTimestamp(),
+	}
+
+	last := -1 // index of the last matched socket
+	for _, s := range cur.GetSockets() {
+		key := conntrack.Key(s)
+		candidates := index[key]
+		if len(candidates) == 0 {
+			delta.Sockets = append(delta.Sockets, &gproto.SocketDelta{New: true, Changed: s})
+			continue
+		}
+
+		i := candidates[0]
+		index[key] = candidates[1:]
+
+		sd := &gproto.SocketDelta{Skip: int32(i - (last + 1))}
+		last = i
+
+		changed, increments, cleared := diffMessage(prev.GetSockets()[i], s)
+		if changed != nil {
+			sd.Changed = changed.(*gproto.SocketMetric)
+		}
+		if increments != nil {
+			sd.Increments = increments.(*gproto.SocketMetric)
+		}
+		sd.Cleared = cleared
+		delta.Sockets = append(delta.Sockets, sd)
+	}
+
+	return delta
+}
+
+// ApplyTcp reconstructs the snapshot from the previous one and the delta
+func ApplyTcp(prev *gproto.TcpMetric, delta *gproto.TcpDelta) (*gproto.TcpMetric, error) {
+	cur := &gproto.TcpMetric{
+		Timestamp: delta.GetTimestamp(),
+		Type:      gproto.MetricType_TCP,
+		Sockets:   make([]*gproto.SocketMetric, 0, len(delta.GetSockets())),
+	}
+
+	last := -1
+	for _, sd := range delta.GetSockets() {
+		if sd.GetNew() {
+			s := sd.GetChanged()
+			if s == nil {
+				s = &gproto.SocketMetric{}
+			}
+			cur.Sockets = append(cur.Sockets, s)
+			continue
+		}
+
+		i := last + 1 + int(sd.GetSkip())
+		if i < 0 || i >= len(prev.GetSockets()) {
+			return nil, errors.Newf("invalid socket index %d, %d sockets in the previous snapshot",
+				i, len(prev.GetSockets()))
+		}
+		last = i
+
+		s := proto.Clone(prev.GetSockets()[i]).(*gproto.SocketMetric)
+		var changed, increments proto.Message
+		if sd.GetChanged() != nil {
+			changed = sd.GetChanged()
+		}
+		if sd.GetIncrements() != nil {
+			increments = sd.GetIncrements()
+		}
+
+		err := applyMessage(s, changed, increments, sd.GetCleared())
+		if err != nil {
+			return nil, err
+		}
+		cur.Sockets = append(cur.Sockets, s)
+	}
+
+	return cur, nil
+}
+
+func isUnsigned(fd protoreflect.FieldDescriptor) bool {
+	if fd.IsList() || fd.IsMap() {
+		return false
+	}
+
+	switch fd.Kind() {
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind, protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		return true
+	default:
+		return false
+	}
+}
+
+// diffMessage returns the fields changed from prev to cur, the increments of unsigned integer fields and numbers
+// of the fields cleared in cur. Storing increments instead of values makes counters growing at a steady rate
+// compress well. changed and increments are nil if there is no such field.
+func diffMessage(prev proto.Message, cur proto.Message) (proto.Message, proto.Message, []uint32) {
+	p := prev.ProtoReflect()
+	c := cur.ProtoReflect()
+
+	var changed, increments protoreflect.Message
+	var cleared []uint32
+
+	fields := c.Descriptor().Fields()
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		ch, ph := c.Has(fd), p.Has(fd)
+		if !ch && !ph {
+			continue
+		}
+		if !ch {
+			cleared = append(cleared, uint32(fd.Number()))
+			continue
+		}
+		if ph && valueEqual(fd, p.Get(fd), c.Get(fd)) {
+			continue
+		}
+
+		// the default value is returned for unset fields
+		if isUnsigned(fd) && c.Get(fd).Uint() > p.Get(fd).Uint() {
+			if increments == nil {
+				increments = c.New()
+			}
+			increments.Set(fd, newUint(fd, c.Get(fd).Uint()-p.Get(fd).Uint()))
+			continue
+		}
+
+		if changed == nil {
+			changed = c.New()
+		}
+		changed.Set(fd, c.Get(fd))
+	}
+
+	var changedMsg, incrementsMsg proto.Message
+	if changed != nil {
+		changedMsg = changed.Interface()
+	}
+	if increments != nil {
+		incrementsMsg = increments.Interface()
+	}
+	return changedMsg, incrementsMsg, cleared
+}
+
+// applyMessage sets the changed fields, adds the increments and clears the cleared fields in dst
+func applyMessage(dst proto.Message, changed proto.Message, increments proto.Message, cleared []uint32) error {
+	d := dst.ProtoReflect()
+
+	if changed != nil {
+		changed.ProtoReflect().Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+			d.Set(fd, v)
+			return true
+		})
+	}
+
+	var err error
+	if increments != nil {
+		increments.ProtoReflect().Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+			if !isUnsigned(fd) {
+				err = errors.Newf("invalid increment of field %d", fd.Number())
+				return false
+			}
+			d.Set(fd, newUint(fd, d.Get(fd).Uint()+v.Uint()))
+			return true
+		})
+	}
+	if err != nil {
+		return err
+	}
+
+	fields := d.Descriptor().Fields()
+	for _, num := range cleared {
+		fd := fields.ByNumber(protoreflect.FieldNumber(num))
+		if fd == nil {
+			return errors.Newf("invalid cleared field %d", num)
+		}
+		d.Clear(fd)
+	}
+	return nil
+}
+
+func newUint(fd protoreflect.FieldDescriptor, v uint64) protoreflect.Value {
+	switch fd.Kind() {
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		return protoreflect.ValueOfUint32(uint32(v))
+	default:
+		return protoreflect.ValueOfUint64(v)
+	}
+}
+
+func valueEqual(fd protoreflect.FieldDescriptor, a protoreflect.Value, b protoreflect.Value) bool {
+	if fd.IsList() {
+		x, y := a.List(), b.List()
+		if x.Len() != y.Len() {
+			return false
+		}
+		for i := 0; i < x.Len(); i++ {
+			if !scalarOrMessageEqual(fd, x.Get(i), y.Get(i)) {
+				return false
+			}
+		}
+		return true
+	}
+
+	if fd.IsMap() {
+		// no map in metrics, always treat it as changed
+		return false
+	}
+
+	return scalarOrMessageEqual(fd, a, b)
+}
+
+func scalarOrMessageEqual(fd protoreflect.FieldDescriptor, a protoreflect.Value, b protoreflect.Value) bool {
+	if fd.Kind() == protoreflect.MessageKind || fd.Kind() == protoreflect.GroupKind {
+		return proto.Equal(a.Message().Interface(), b.Message().Interface())
+	}
+	if fd.Kind() == protoreflect.BytesKind {
+		return bytes.Equal(a.Bytes(), b.Bytes())
+	}
+	// NaN is never equal, it's stored as changed
+	return a.Interface() == b.Interface()
+}
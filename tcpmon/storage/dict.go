@@ -0,0 +1,477 @@
+package storage
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/klauspost/compress/dict"
+	"github.com/klauspost/compress/zstd"
+	"github.com/rs/zerolog/log"
+	"github.com/samber/lo"
+	"github.com/spf13/afero"
+)
+
+// DictPrefix is the prefix of zstd dictionary files, e.g. tcpmon-dict-tcp-1234567890. The dictionary id is recorded in
+// the frames compressed with it, so readers load all dictionaries in the directory and zstd picks the right one.
+const DictPrefix = "tcpmon-dict-"
+
+// dictMinSamples is the min number of records to train a dictionary from
+const dictMinSamples = 32
+
+// dictMinRatio is the share of the compression ratio of the first file compressed with a dictionary, the dictionary
+// is retrained when a file compresses worse than it, e.g. the workload of the host changed
+const dictMinRatio = 0.8
+
+// Records are split into chunks to train dictionaries, and only the latest chunks are used to bound the time of
+// training, e.g. socket tables of large hosts are hundreds of KiB.
+const (
+	dictChunkSize = 4 << 10
+	dictMaxInput  = 1 << 20
+)
+
+// streamDict is the dictionary to compress the sealed files of a stream. Retrained dictionaries are kept until
+// no data file is compressed with them (see gcDicts), files are read by the dictionary id.
+type streamDict struct {
+	id      uint32
+	data    []byte
+	trained time.Time
+	ratio   float64 // compression ratio of the first file compressed with it, 0 if there is none yet
+	stale   bool    // the compression ratio dropped, retrained with the next sealed file
+}
+
+// DictFileName returns the name of the dictionary of the stream
+func DictFileName(stream string, id uint32) string {
+	if stream == StreamDefault {
+		return fmt.Sprintf("%s%d", DictPrefix, id)
+	}
+	return fmt.Sprintf("%s%s-%d", DictPrefix, stream, id)
+}
+
+// streamOfDict returns the stream of the dictionary file
+func streamOfDict(fileName string) string {
+	name := strings.TrimPrefix(fileName, DictPrefix)
+	p := strings.LastIndex(name, "-")
+	if p == -1 {
+		return StreamDefault
+	}
+	return name[:p]
+}
+
+// TrainDict trains a zstd dictionary of at most size bytes from the samples
+func TrainDict(samples [][]byte, size int, level int) (d []byte, err error) {
+	if len(samples) < dictMinSamples {
+		return nil, errors.Newf("%d samples are not enough to train a dictionary", len(samples))
+	}
+
+	// the builder panics if the samples have too few matches
+	defer func() {
+		if r := recover(); r != nil {
+			d, err = nil, errors.Newf("train dictionary failed: %v", r)
+		}
+	}()
+
+	chunks := make([][]byte, 0)
+	n := 0
+	for i := len(samples) - 1; i >= 0 && n < dictMaxInput; i-- {
+		for b := samples[i]; len(b) > 0 && n < dictMaxInput; b = b[min(len(b), dictChunkSize):] {
+			chunks = append(chunks, b[:min(len(b), dictChunkSize)])
+			n += min(len(b), dictChunkSize)
+		}
+	}
+
+	d, err = dict.BuildZstdDict(chunks, dict.Options{
+		MaxDictSize:    size,
+		HashBytes:      6,
+		ZstdDictCompat: true, // readable by zstd 1.5.5 and earlier
+		ZstdLevel:      zstd.EncoderLevelFromZstd(level),
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "train dictionary failed")
+	}
+	return d, nil
+}
+
+// dictID returns the id of the zstd dictionary
+func dictID(d []byte) (uint32, error) {
+	info, err := zstd.InspectDictionary(d)
+	if err != nil {
+		return 0, errors.Wrap(err, "invalid dictionary")
+	}
+	return info.ID(), nil
+}
+
+// listDicts returns the paths of dictionaries in the directory, ordered by name
+func listDicts(fs afero.Fs, dir string) ([]string, error) {
+	files, err := afero.ReadDir(fs, dir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, errors.Wrap(err, "list dictionaries failed")
+	}
+
+	paths := make([]string, 0)
+	for _, f := range files {
+		if !f.IsDir() && strings.HasPrefix(f.Name(), DictPrefix) && !strings.HasSuffix(f.Name(), ".tmp") {
+			paths = append(paths, filepath.Join(dir, f.Name()))
+		}
+	}
+	sort.Strings(paths)
+	return paths, nil
+}
+
+// LoadDicts loads the dictionaries to read the data file, from the directory of the file. Dictionaries of pinned files
+// are in the parent directory.
+func LoadDicts(fs afero.Fs, dataFile string) ([][]byte, error) {
+	var c *DictCache
+	return c.Load(fs, dataFile)
+}
+
+// DictCache keeps the dictionaries read by path, so they are read and decrypted once. Dictionary files are never
+// modified once written. A nil cache reads the dictionaries every time.
+type DictCache struct {
+	mutex sync.Mutex
+	dicts map[string][]byte
+}
+
+func NewDictCache() *DictCache {
+	return &DictCache{dicts: make(map[string][]byte)}
+}
+
+// Load returns the dictionaries to read the data file, see LoadDicts
+func (c *DictCache) Load(fs afero.Fs, dataFile string) ([][]byte, error) {
+	if fs == nil {
+		fs = afero.NewOsFs()
+	}
+
+	dir := filepath.Dir(dataFile)
+	paths, err := listDicts(fs, dir)
+	if err != nil {
+		return nil, err
+	}
+	if filepath.Base(dir) == PinDir {
+		parent, err := listDicts(fs, filepath.Dir(dir))
+		if err != nil {
+			return nil, err
+		}
+		paths = append(paths, parent...)
+	}
+
+	dicts := make([][]byte, 0, len(paths))
+	for _, p := range paths {
+		d, err := c.read(fs, p)
+		if err != nil {
+			return nil, err
+		}
+		dicts = append(dicts, d)
+	}
+	return dicts, nil
+}
+
+func (c *DictCache) read(fs afero.Fs, path string) ([]byte, error) {
+	if c != nil {
+		c.mutex.Lock()
+		d, ok := c.dicts[path]
+		c.mutex.Unlock()
+		if ok {
+			return d, nil
+		}
+	}
+
+	d, err := afero.ReadFile(fs, path)
+	if err != nil {
+		return nil, errors.Wrapf(err, "read dictionary %s failed", path)
+	}
+	err = checkEncrypted(bytes.NewReader(d))
+	if err != nil {
+		return nil, errors.Wrapf(err, "read dictionary %s failed", path)
+	}
+
+	if c != nil {
+		c.mutex.Lock()
+		c.dicts[path] = d
+		c.mutex.Unlock()
+	}
+	return d, nil
+}
+
+// forget drops the dictionary from the cache once it's deleted
+func (c *DictCache) forget(path string) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	delete(c.dicts, path)
+}
+
+// idOfDict returns the dictionary id in the name of the dictionary file
+func idOfDict(fileName string) (uint32, bool) {
+	name := strings.TrimPrefix(fileName, DictPrefix)
+	id, err := strconv.ParseUint(name[strings.LastIndex(name, "-")+1:], 10, 32)
+	return uint32(id), err == nil
+}
+
+// dictOfFile returns the id of the dictionary the sealed file is compressed with, 0 if there is none. It's read from
+// the header of the first frame.
+func (ds *DataStore) dictOfFile(path string) (uint32, error) {
+	if id, ok := ds.fileDicts[path]; ok {
+		return id, nil
+	}
+
+	fh, err := ds.fs.Open(path)
+	if err != nil {
+		return 0, errors.Wrap(err, "open file failed")
+	}
+	defer fh.Close()
+
+	buf := make([]byte, zstd.HeaderMaxSize)
+	n, err := io.ReadFull(fh, buf)
+	if err != nil && !errors.Is(err, io.ErrUnexpectedEOF) && !errors.Is(err, io.EOF) {
+		return 0, errors.Wrapf(err, "read %s failed", path)
+	}
+	var h zstd.Header
+	err = h.Decode(buf[:n])
+	if err != nil && n != 0 {
+		return 0, errors.Wrapf(err, "read frame header of %s failed", path)
+	}
+	ds.fileDicts[path] = h.DictionaryID
+	return h.DictionaryID, nil
+}
+
+// dictsSize returns the total size of the dictionaries in the base dir
+func (ds *DataStore) dictsSize() int64 {
+	paths, err := listDicts(ds.fs, ds.baseDir)
+	if err != nil {
+		log.Warn().Err(err).Msg("List dictionaries failed")
+		return 0
+	}
+
+	size := int64(0)
+	for _, p := range paths {
+		stat, err := ds.fs.Stat(p)
+		if err == nil {
+			size += stat.Size()
+		}
+	}
+	return size
+}
+
+// gcDicts deletes the dictionaries no data file is compressed with, except the current dictionary of each stream.
+// Returns the size freed.
+func (ds *DataStore) gcDicts() int64 {
+	files, err := DataFiles(ds.fs, ds.baseDir)
+	if err != nil {
+		log.Warn().Err(err).Msg("List data files failed, dictionaries are kept")
+		return 0
+	}
+
+	used := make(map[uint32]bool)
+	for _, d := range ds.dicts {
+		used[d.id] = true
+	}
+	fileDicts := make(map[string]uint32)
+	for _, f := range files {
+		if !strings.HasSuffix(f, SealFileSuffix) {
+			continue
+		}
+		id, err := ds.dictOfFile(f)
+		if err != nil {
+			log.Warn().Err(err).Str("file", f).Msg("Read dictionary id failed, dictionaries are kept")
+			return 0
+		}
+		used[id] = true
+		fileDicts[f] = id
+	}
+	// deleted files are dropped from the cache
+	ds.fileDicts = fileDicts
+
+	paths, err := listDicts(ds.fs, ds.baseDir)
+	if err != nil {
+		log.Warn().Err(err).Msg("List dictionaries failed")
+		return 0
+	}
+	freed := int64(0)
+	for _, p := range paths {
+		id, ok := idOfDict(filepath.Base(p))
+		if !ok || used[id] {
+			continue
+		}
+		stat, err := ds.fs.Stat(p)
+		if err == nil {
+			err = ds.fs.Remove(p)
+		}
+		if err != nil {
+			log.Warn().Err(err).Str("file", p).Msg("Delete dictionary failed")
+			continue
+		}
+		ds.dictCache.forget(p)
+		freed += stat.Size()
+		log.Info().Str("file", p).Msg("Unused dictionary deleted")
+	}
+	return freed
+}
+
+// loadDicts loads the latest dictionary of each stream in the base dir
+func (ds *DataStore) loadDicts() error {
+	paths, err := listDicts(ds.fs, ds.baseDir)
+	if err != nil {
+		return err
+	}
+
+	for _, p := range paths {
+		stat, err := ds.fs.Stat(p)
+		if err != nil {
+			return errors.Wrapf(err, "stat dictionary %s failed", p)
+		}
+		stream := streamOfDict(filepath.Base(p))
+		if d, ok := ds.dicts[stream]; ok && d.trained.After(stat.ModTime()) {
+			continue
+		}
+
+		d, err := afero.ReadFile(ds.fs, p)
+		if err != nil {
+			return errors.Wrapf(err, "read dictionary %s failed", p)
+		}
+		id, err := dictID(d)
+		if err != nil {
+			return errors.Wrapf(err, "load dictionary %s failed", p)
+		}
+		ds.dicts[stream] = &streamDict{id: id, data: d, trained: stat.ModTime()}
+	}
+	return nil
+}
+
+// dict returns the dictionary to seal the data file. It's trained from the records of the file if the stream has no
+// dictionary yet, the dictionary is older than the retrain interval or the compression ratio dropped. nil is returned
+// if dictionaries are disabled or there are not enough records to train one.
+func (ds *DataStore) dict(dataFile string) []byte {
+	if ds.config.DictSize <= 0 {
+		return nil
+	}
+
+	stream := StreamOfFile(filepath.Base(dataFile))
+	current := ds.dicts[stream]
+	if current != nil && !current.stale &&
+		(ds.config.DictRetrainInterval <= 0 || time.Since(current.trained) < ds.config.DictRetrainInterval) {
+		return current.data
+	}
+
+	d := ds.trainDict(stream, dataFile)
+	if d != nil {
+		ds.dicts[stream] = d
+		return d.data
+	}
+	if current == nil {
+		return nil
+	}
+	// the current dictionary is used until the next retrain
+	current.trained = time.Now()
+	current.stale = false
+	return current.data
+}
+
+// trainDict trains a dictionary of the stream from the records of the data file and writes it, nil is returned if
+// there are not enough records or it failed
+func (ds *DataStore) trainDict(stream string, dataFile string) *streamDict {
+	samples, err := ds.samples(dataFile)
+	if err != nil {
+		log.Warn().Err(err).Str("file", dataFile).Msg("Read samples failed")
+		return nil
+	}
+	if len(samples) < dictMinSamples {
+		return nil
+	}
+
+	d, err := TrainDict(samples, ds.config.DictSize, ds.config.CompressionLevel)
+	if err != nil {
+		log.Warn().Err(err).Str("stream", stream).Msg("Train dictionary failed")
+		return nil
+	}
+	id, err := dictID(d)
+	if err != nil {
+		log.Warn().Err(err).Str("stream", stream).Msg("Train dictionary failed")
+		return nil
+	}
+
+	// readers pick dictionaries by id, ids of old dictionaries must not be reused
+	paths, err := listDicts(ds.fs, ds.baseDir)
+	if err != nil {
+		log.Warn().Err(err).Str("stream", stream).Msg("Train dictionary failed")
+		return nil
+	}
+	suffix := fmt.Sprintf("%d", id)
+	if lo.SomeBy(paths, func(p string) bool {
+		name := strings.TrimPrefix(filepath.Base(p), DictPrefix)
+		return name == suffix || strings.HasSuffix(name, "-"+suffix)
+	}) {
+		log.Warn().Uint32("id", id).Str("stream", stream).Msg("Dictionary id is in use, dictionary dropped")
+		return nil
+	}
+
+	// the dictionary is written before any file is compressed with it
+	p := filepath.Join(ds.baseDir, DictFileName(stream, id))
+	err = ds.writeFile(p+".tmp", d)
+	if err == nil {
+		err = ds.fs.Rename(p+".tmp", p)
+	}
+	if err != nil {
+		log.Warn().Err(err).Str("file", p).Msg("Write dictionary failed")
+		return nil
+	}
+
+	log.Info().Str("file", p).Int("size", len(d)).Int("samples", len(samples)).Msg("Dictionary trained")
+	return &streamDict{id: id, data: d, trained: time.Now()}
+}
+
+// checkDictRatio records the compression ratio of the data file compressed with the dictionary of its stream, the
+// dictionary is marked stale if the ratio dropped
+func (ds *DataStore) checkDictRatio(dataFile string, rawSize int64, size int64) {
+	d := ds.dicts[StreamOfFile(filepath.Base(dataFile))]
+	if d == nil || rawSize == 0 || size == 0 {
+		return
+	}
+
+	ratio := float64(rawSize) / float64(size)
+	if d.ratio == 0 {
+		d.ratio = ratio
+		return
+	}
+	if ratio < d.ratio*dictMinRatio {
+		log.Info().Str("file", dataFile).Float64("ratio", ratio).Float64("trained", d.ratio).
+			Msg("Compression ratio dropped, the dictionary will be retrained")
+		d.stale = true
+	}
+}
+
+// samples returns the record bodies of the raw data file
+func (ds *DataStore) samples(dataFile string) ([][]byte, error) {
+	fh, err := ds.fs.Open(dataFile)
+	if err != nil {
+		return nil, errors.Wrap(err, "open file failed")
+	}
+	defer fh.Close()
+
+	samples := make([][]byte, 0)
+	scanner := NewRecordScanner(bufio.NewReader(fh))
+	for {
+		rec, err := scanner.Next()
+		if errors.Is(err, ErrChecksumMismatch) {
+			continue
+		}
+		if err != nil {
+			// the file is sealed as it is, the records read are good samples anyway
+			return samples, nil
+		}
+		if len(rec.Body) != 0 {
+			samples = append(samples, rec.Body)
+		}
+	}
+}
@@ -0,0 +1,105 @@
+package storage
+
+import (
+	"time"
+
+	"github.com/samber/lo"
+)
+
+// WriteStats are the statistics of buffered writes
+type WriteStats struct {
+	Records           uint64        `json:"records"`          // records written
+	Bytes             uint64        `json:"bytes"`            // records and their headers written, before compression
+	PendingRecords    uint32        `json:"pendingRecords"`   // records in the buffers
+	Flushes           uint64        `json:"flushes"`          // times buffers flushed
+	Syncs             uint64        `json:"syncs"`            // times files synced by the sync policy
+	LastFlushLatency  time.Duration `json:"lastFlushLatency"` // flush and sync
+	MaxFlushLatency   time.Duration `json:"maxFlushLatency"`
+	TotalFlushLatency time.Duration `json:"totalFlushLatency"`
+	Seals             uint64        `json:"seals"`            // files sealed
+	TotalSealLatency  time.Duration `json:"totalSealLatency"` // compressing sealed files and writing segments
+	TotalCompression  time.Duration `json:"totalCompression"` // compressing sealed files, including training dictionaries
+	Errors            uint64        `json:"errors"`           // failed writes, flushes and seals
+	LastError         string        `json:"lastError,omitempty"`
+	LastErrorTime     time.Time     `json:"lastErrorTime,omitempty"`
+}
+
+// Flush writes the buffered records of all streams to the files, and syncs them by the sync policy
+func (ds *DataStore) Flush() error {
+	ds.mutex.Lock()
+	defer ds.mutex.Unlock()
+	return ds.writeError(ds.flush(time.Now()))
+}
+
+func (ds *DataStore) WriteStats() WriteStats {
+	ds.mutex.Lock()
+	defer ds.mutex.Unlock()
+	return ds.stats
+}
+
+// writeError counts the error in the write statistics if it's not nil, returns the error
+func (ds *DataStore) writeError(err error) error {
+	if err != nil {
+		ds.stats.Errors++
+		ds.stats.LastError = err.Error()
+		ds.stats.LastErrorTime = time.Now()
+	}
+	return err
+}
+
+// maybeFlush flushes when the batch is full or the write interval elapsed since the last flush
+func (ds *DataStore) maybeFlush(now time.Time) error {
+	batchFull := ds.config.WriteBatchSize != 0 && ds.stats.PendingRecords >= ds.config.WriteBatchSize
+	if !batchFull && now.Sub(ds.lastFlush) < ds.config.WriteInterval {
+		return nil
+	}
+	return ds.flush(now)
+}
+
+func (ds *DataStore) flush(now time.Time) error {
+	streams := ds.sortedStreams()
+	buffered := lo.SomeBy(streams, func(st *stream) bool {
+		return st.writer != nil && st.writer.Buffered() != 0
+	})
+	if ds.stats.PendingRecords == 0 && !buffered {
+		ds.lastFlush = now
+		return nil
+	}
+
+	start := time.Now()
+	for _, st := range streams {
+		err := st.flush()
+		if err != nil {
+			return err
+		}
+	}
+
+	sync := false
+	switch ds.config.SyncPolicy {
+	case SyncEveryBatch:
+		sync = true
+	case SyncInterval:
+		sync = now.Sub(ds.lastSync) >= ds.config.WriteInterval
+	}
+	if sync {
+		for _, st := range streams {
+			err := st.sync()
+			if err != nil {
+				return err
+			}
+		}
+		ds.lastSync = now
+		ds.stats.Syncs++
+	}
+
+	latency := time.Since(start)
+	ds.stats.PendingRecords = 0
+	ds.stats.Flushes++
+	ds.stats.LastFlushLatency = latency
+	ds.stats.TotalFlushLatency += latency
+	if latency > ds.stats.MaxFlushLatency {
+		ds.stats.MaxFlushLatency = latency
+	}
+	ds.lastFlush = now
+	return nil
+}
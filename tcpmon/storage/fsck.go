@@ -0,0 +1,320 @@
+package storage
+
+import (
+	"bytes"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+	"github.com/klauspost/compress/zstd"
+	"github.com/spf13/afero"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/zperf/tcpmon/tcpmon/gproto"
+)
+
+// FileReport is the result of checking a data file, offsets are in the uncompressed data
+type FileReport struct {
+	File         string
+	Size         int64
+	Records      int // valid records
+	Counts       map[gproto.MetricType]int
+	MinTimestamp int64
+	MaxTimestamp int64
+	Corrupted    []CorruptedRange
+	Repaired     bool
+	Dropped      int // deltas removed by repair, the snapshots they are computed from are lost
+
+	metrics int // non-empty records
+}
+
+// CorruptedRange is a range of bytes that can't be read as records
+type CorruptedRange struct {
+	Offset int64
+	End    int64
+	Err    error
+}
+
+func (r *FileReport) Ok() bool {
+	return len(r.Corrupted) == 0
+}
+
+func (r *FileReport) add(body []byte) {
+	r.Records++
+	if len(body) == 0 {
+		return
+	}
+
+	typ, ts, _ := peekMetric(body)
+	if r.metrics == 0 || ts < r.MinTimestamp {
+		r.MinTimestamp = ts
+	}
+	if r.metrics == 0 || ts > r.MaxTimestamp {
+		r.MaxTimestamp = ts
+	}
+	r.Counts[typ]++
+	r.metrics++
+}
+
+func (r *FileReport) corrupt(offset int64, end int64, err error) {
+	// adjacent ranges are merged
+	if n := len(r.Corrupted); n > 0 && r.Corrupted[n-1].End == offset {
+		r.Corrupted[n-1].End = end
+		return
+	}
+	r.Corrupted = append(r.Corrupted, CorruptedRange{Offset: offset, End: end, Err: err})
+}
+
+// DataFiles returns the data files in the dir and its pinned files, ordered by file number
+func DataFiles(fs afero.Fs, dir string) ([]string, error) {
+	files := make([]string, 0)
+	for _, d := range []string{dir, filepath.Join(dir, PinDir)} {
+		infos, err := afero.ReadDir(fs, d)
+		if err != nil {
+			if os.IsNotExist(err) && d != dir {
+				continue
+			}
+			return nil, errors.Wrapf(err, "list files in %s failed", d)
+		}
+		for _, f := range infos {
+			if !f.IsDir() && strings.HasPrefix(f.Name(), DataFilePrefix) {
+				files = append(files, filepath.Join(d, f.Name()))
+			}
+		}
+	}
+
+	sort.SliceStable(files, func(i, j int) bool {
+		return getFileNo(files[i]) < getFileNo(files[j])
+	})
+	return files, nil
+}
+
+// uncompressed is the uncompressed data of a data file
+type uncompressed struct {
+	io.ReaderAt
+	size int64
+
+	// the end of the zstd frame containing the offset, for skipping frames can't be decompressed
+	frameEnd func(off int64) int64
+	closer   func()
+
+	err error // the data after size is lost
+}
+
+func openUncompressed(fs afero.Fs, dataFile string) (*uncompressed, error) {
+	fh, err := fs.Open(dataFile)
+	if err != nil {
+		return nil, errors.Wrap(err, "open file failed")
+	}
+
+	if !strings.HasSuffix(dataFile, SealFileSuffix) {
+		stat, err := fh.Stat()
+		if err != nil {
+			_ = fh.Close()
+			return nil, errors.Wrap(err, "stat failed")
+		}
+		return &uncompressed{ReaderAt: fh, size: stat.Size(), closer: func() { _ = fh.Close() }}, nil
+	}
+
+	dicts, err := LoadDicts(fs, dataFile)
+	if err != nil {
+		_ = fh.Close()
+		return nil, err
+	}
+
+	z, err := NewSeekableReader(fh, dicts...)
+	if err == nil {
+		return &uncompressed{ReaderAt: z, size: z.Size(), frameEnd: z.frameEnd, closer: func() {
+			_ = z.Close()
+			_ = fh.Close()
+		}}, nil
+	}
+	if !errors.Is(err, ErrNoSeekTable) {
+		_ = fh.Close()
+		return nil, err
+	}
+
+	// sealed by an old version, data after a broken block is lost
+	defer fh.Close()
+	decoder, err := zstd.NewReader(fh, zstd.WithDecoderDicts(dicts...))
+	if err != nil {
+		return nil, errors.Wrap(err, "create new zstd reader failed")
+	}
+	defer decoder.Close()
+	buf, err := io.ReadAll(decoder)
+	return &uncompressed{ReaderAt: bytes.NewReader(buf), size: int64(len(buf)), closer: func() {}, err: err}, nil
+}
+
+// readRecordAt reads and validates the record at the offset. The record is returned with ErrChecksumMismatch or if
+// the body is not a metric, so the next record can be read after it.
+func readRecordAt(r io.ReaderAt, offset int64, size int64) (*Record, error) {
+	sr := io.NewSectionReader(r, offset, size-offset)
+	h, err := ReadHeader(sr)
+	if err != nil {
+		return nil, err
+	}
+	if int64(h.Size) > size-offset-int64(h.Len()) {
+		return nil, errors.Wrapf(ErrTornRecord, "%d bytes body exceeds the file", h.Size)
+	}
+
+	rec := &Record{Offset: offset, Header: h, Body: make([]byte, h.Size)}
+	_, err = io.ReadFull(sr, rec.Body)
+	if err != nil {
+		return nil, errors.Wrap(err, "read body failed")
+	}
+
+	err = h.Verify(rec.Body)
+	if err != nil {
+		return rec, err
+	}
+	if len(rec.Body) != 0 {
+		_, _, ok := peekMetric(rec.Body)
+		if !ok {
+			return rec, errors.New("not a metric")
+		}
+		err = proto.Unmarshal(rec.Body, &gproto.Metric{})
+		if err != nil {
+			return rec, errors.Wrap(err, "unmarshal failed")
+		}
+	}
+	return rec, nil
+}
+
+// resync returns the offset of the next valid record after the offset, or the size if there is none
+func (u *uncompressed) resync(offset int64) int64 {
+	version := make([]byte, 2)
+	for offset < u.size {
+		_, err := u.ReadAt(version, offset)
+		if err != nil && !errors.Is(err, io.EOF) && u.frameEnd != nil {
+			// the frame can't be decompressed
+			offset = u.frameEnd(offset)
+			continue
+		}
+
+		v := uint16(version[0]) | uint16(version[1])<<8
+		if v == Version || v == VersionChecksum {
+			_, err = readRecordAt(u, offset, u.size)
+			if err == nil {
+				return offset
+			}
+		}
+		offset++
+	}
+	return u.size
+}
+
+// CheckFile validates the headers, lengths, checksums and protobuf of all records in the data file. With repair, the
+// file is rewritten without the corrupted records and its index is rebuilt.
+func CheckFile(fs afero.Fs, dataFile string, repair bool) (*FileReport, error) {
+	if fs == nil {
+		fs = afero.NewOsFs()
+	}
+
+	u, err := openUncompressed(fs, dataFile)
+	if err != nil {
+		return nil, err
+	}
+	defer u.closer()
+
+	report := &FileReport{File: dataFile, Size: u.size, Counts: make(map[gproto.MetricType]int)}
+	valid := make([]int64, 0)
+	offset := int64(0)
+	for offset < u.size {
+		rec, err := readRecordAt(u, offset, u.size)
+		if err == nil {
+			report.add(rec.Body)
+			valid = append(valid, offset)
+			offset = rec.End()
+			continue
+		}
+
+		// a record with a valid header is skipped as a whole, otherwise the next valid record is searched
+		end := u.resync(offset + 1)
+		if rec != nil {
+			end = rec.End()
+		}
+		report.corrupt(offset, end, err)
+		offset = end
+	}
+	if u.err != nil {
+		report.corrupt(u.size, u.size, errors.Wrap(u.err, "decompress failed, the rest of the file is lost"))
+	}
+
+	if repair && !report.Ok() {
+		report.Dropped, err = rewrite(fs, dataFile, u, valid, report.Corrupted)
+		if err != nil {
+			return report, errors.Wrapf(err, "repair %s failed", dataFile)
+		}
+		report.Repaired = true
+	}
+	return report, nil
+}
+
+// rewrite writes the valid records to the data file and rebuilds its index. TCP deltas after a corrupted range are
+// computed from a lost snapshot, they are dropped up to the next keyframe. Returns the number of dropped deltas.
+func rewrite(fs afero.Fs, dataFile string, u *uncompressed, valid []int64, corrupted []CorruptedRange) (int, error) {
+	var raw bytes.Buffer
+	index := NewIndexBuilder()
+	dropped := 0
+	broken := false // a record is lost since the last keyframe
+	for _, offset := range valid {
+		for len(corrupted) != 0 && corrupted[0].Offset < offset {
+			broken = true
+			corrupted = corrupted[1:]
+		}
+
+		rec, err := readRecordAt(u, offset, u.size)
+		if err != nil {
+			return 0, err
+		}
+		switch bodyFieldNumber(rec.Body) {
+		case tcpFieldNumber:
+			broken = false
+		case tcpDeltaFieldNumber:
+			if broken {
+				dropped++
+				continue
+			}
+		}
+		index.Add(int64(raw.Len()), rec.Body)
+		raw.Write(encodeRecord(rec.Body))
+		raw.Write(rec.Body)
+	}
+
+	tmp := dataFile + ".repair"
+	out, err := fs.OpenFile(tmp, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0644)
+	if err != nil {
+		return 0, errors.Wrap(err, "open output file failed")
+	}
+	if strings.HasSuffix(dataFile, SealFileSuffix) {
+		// compressed without dictionary, readers don't need it. Encrypted if the file is read with a keyring.
+		config := NewConfig("")
+		var w io.WriteCloser
+		w, err = EncryptTo(KeyringOf(fs), out)
+		if err == nil {
+			err = writeSeekable(&raw, w, config.CompressionLevel, config.FrameSize, nil)
+		}
+		if err == nil {
+			err = w.Close()
+		}
+	} else {
+		_, err = raw.WriteTo(out)
+	}
+	if err != nil {
+		_ = out.Close()
+		return 0, errors.Wrap(err, "write failed")
+	}
+	err = out.Close()
+	if err != nil {
+		return 0, errors.Wrap(err, "close output file failed")
+	}
+
+	err = fs.Rename(tmp, dataFile)
+	if err != nil {
+		return 0, errors.Wrap(err, "rename failed")
+	}
+	return dropped, WriteIndex(fs, dataFile, index.Index())
+}
@@ -0,0 +1,231 @@
+package storage
+
+import (
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+	"github.com/spf13/afero"
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/zperf/tcpmon/tcpmon/gproto"
+)
+
+const IndexFilePrefix = "tcpmon-index-"
+
+var ErrIndexNotFound = errors.New("index not found")
+
+// metric types of the field numbers of gproto.Metric.Body
+var metricTypes = map[protowire.Number]gproto.MetricType{
+	1: gproto.MetricType_TCP,
+	2: gproto.MetricType_NIC,
+	3: gproto.MetricType_NET,
+	4: gproto.MetricType_HOST,
+	5: gproto.MetricType_EVENT,
+	6: gproto.MetricType_TCP_DELTA,
+	7: gproto.MetricType_ROLLUP,
+	8: gproto.MetricType_SELF,
+}
+
+// IndexFilePath returns the path of the sidecar index of the data file
+func IndexFilePath(dataFile string) string {
+	name := strings.TrimSuffix(filepath.Base(dataFile), SealFileSuffix)
+	name = strings.TrimPrefix(name, DataFilePrefix)
+	return filepath.Join(filepath.Dir(dataFile), IndexFilePrefix+name)
+}
+
+// sidecarFiles returns the files derived from the data file, they are moved and deleted with it
+func sidecarFiles(dataFile string) []string {
+	return []string{IndexFilePath(dataFile), SegmentFilePath(dataFile)}
+}
+
+// peekMetric returns the type and the timestamp of a marshaled gproto.Metric without unmarshalling it. All metric
+// bodies have the timestamp as field 1.
+func peekMetric(value []byte) (gproto.MetricType, int64, bool) {
+	num, typ, n := protowire.ConsumeTag(value)
+	if n < 0 || typ != protowire.BytesType {
+		return 0, 0, false
+	}
+	metricType, ok := metricTypes[num]
+	if !ok {
+		return 0, 0, false
+	}
+
+	body, m := protowire.ConsumeBytes(value[n:])
+	if m < 0 || n+m != len(value) {
+		// a metric has exactly one field
+		return 0, 0, false
+	}
+
+	for len(body) > 0 {
+		num, typ, n := protowire.ConsumeTag(body)
+		if n < 0 {
+			return 0, 0, false
+		}
+		body = body[n:]
+
+		if num == 1 && typ == protowire.VarintType {
+			v, n := protowire.ConsumeVarint(body)
+			if n < 0 {
+				return 0, 0, false
+			}
+			return metricType, int64(v), true
+		}
+
+		n = protowire.ConsumeFieldValue(num, typ, body)
+		if n < 0 {
+			return 0, 0, false
+		}
+		body = body[n:]
+	}
+
+	// the timestamp is 0
+	return metricType, 0, true
+}
+
+// IndexBuilder builds the index of a data file from its records
+type IndexBuilder struct {
+	index   *gproto.FileIndex
+	counts  map[gproto.MetricType]uint32
+	metrics int // records counted in the time range
+}
+
+func NewIndexBuilder() *IndexBuilder {
+	return &IndexBuilder{
+		index:  &gproto.FileIndex{},
+		counts: make(map[gproto.MetricType]uint32),
+	}
+}
+
+// Add adds the record at the offset. The host info is not counted in the time range, it's written at the beginning
+// of each file with the time it was collected.
+func (b *IndexBuilder) Add(offset int64, value []byte) {
+	b.index.Count++
+
+	typ, ts, ok := peekMetric(value)
+	if !ok {
+		return
+	}
+
+	if typ != gproto.MetricType_HOST {
+		if b.metrics == 0 || ts < b.index.MinTimestamp {
+			b.index.MinTimestamp = ts
+		}
+		if b.metrics == 0 || ts > b.index.MaxTimestamp {
+			b.index.MaxTimestamp = ts
+		}
+		b.metrics++
+	}
+	b.index.Offsets = append(b.index.Offsets, uint64(offset))
+	b.index.Timestamps = append(b.index.Timestamps, ts)
+	b.index.Types = append(b.index.Types, typ)
+	b.counts[typ]++
+}
+
+// AddCorrupt adds the record at the offset failing its checksum
+func (b *IndexBuilder) AddCorrupt(offset int64) {
+	b.index.Count++
+	b.index.CorruptOffsets = append(b.index.CorruptOffsets, uint64(offset))
+}
+
+func (b *IndexBuilder) Index() *gproto.FileIndex {
+	index := proto.Clone(b.index).(*gproto.FileIndex)
+	for typ, count := range b.counts {
+		index.TypeCounts = append(index.TypeCounts, &gproto.TypeCount{Type: typ, Count: count})
+	}
+	sort.Slice(index.TypeCounts, func(i, j int) bool {
+		return index.TypeCounts[i].Type < index.TypeCounts[j].Type
+	})
+	return index
+}
+
+// MetricRange returns the time range of the metrics in the index, false if the file has no metric but the host info
+func MetricRange(index *gproto.FileIndex) (int64, int64, bool) {
+	hosts := uint32(0)
+	for _, c := range index.GetTypeCounts() {
+		if c.GetType() == gproto.MetricType_HOST {
+			hosts = c.GetCount()
+		}
+	}
+	return index.GetMinTimestamp(), index.GetMaxTimestamp(), uint32(len(index.GetOffsets())) > hosts
+}
+
+// BuildIndex scans the data file and builds its index. The torn tail of the file is not indexed, corrupted records
+// are recorded so readers know deltas after them can't be decoded.
+func BuildIndex(fs afero.Fs, dataFile string) (*gproto.FileIndex, error) {
+	reader, err := NewDataFileReader(dataFile, fs)
+	if err != nil {
+		return nil, err
+	}
+	defer reader.Close()
+
+	b := NewIndexBuilder()
+	for {
+		rec, err := reader.scanner.Next()
+		if err != nil {
+			if errors.Is(err, io.EOF) || errors.Is(err, ErrTornRecord) || errors.Is(err, ErrInvalidHeader) {
+				break
+			}
+			if errors.Is(err, ErrChecksumMismatch) {
+				b.AddCorrupt(rec.Offset)
+				continue
+			}
+			return nil, err
+		}
+		b.Add(rec.Offset, rec.Body)
+	}
+
+	return b.Index(), nil
+}
+
+// WriteIndex writes the sidecar index of the data file
+func WriteIndex(fs afero.Fs, dataFile string, index *gproto.FileIndex) error {
+	buf, err := proto.Marshal(index)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	fh, err := fs.OpenFile(IndexFilePath(dataFile), os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0644)
+	if err != nil {
+		return errors.Wrap(err, "open index file failed")
+	}
+	defer fh.Close()
+
+	_, err = fh.Write(append(encodeRecord(buf), buf...))
+	if err != nil {
+		return errors.Wrap(err, "write index failed")
+	}
+	return nil
+}
+
+// LoadIndex reads the sidecar index of the data file, returns ErrIndexNotFound if there is no index
+func LoadIndex(fs afero.Fs, dataFile string) (*gproto.FileIndex, error) {
+	if fs == nil {
+		fs = afero.NewOsFs()
+	}
+
+	fh, err := fs.Open(IndexFilePath(dataFile))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, ErrIndexNotFound
+		}
+		return nil, errors.Wrap(err, "open index file failed")
+	}
+	defer fh.Close()
+
+	rec, err := NewRecordScanner(fh).Next()
+	if err != nil {
+		return nil, errors.Wrap(err, "read index failed")
+	}
+
+	var index gproto.FileIndex
+	err = proto.Unmarshal(rec.Body, &index)
+	if err != nil {
+		return nil, errors.Wrap(err, "parse index failed")
+	}
+	return &index, nil
+}
@@ -0,0 +1,268 @@
+package storage
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/rs/zerolog/log"
+	"github.com/samber/lo"
+	"github.com/spf13/afero"
+)
+
+// PinDir is the protected area in the base directory. Data files covering a pinned time range are moved here, so
+// they are not reclaimed until the pin expires.
+const PinDir = "pinned"
+const pinsFile = "pins.json"
+
+var ErrPinNotFound = errors.New("pin not found")
+
+type Pin struct {
+	Id      string    `json:"id"`
+	Label   string    `json:"label"`
+	From    time.Time `json:"from"`
+	To      time.Time `json:"to"`
+	Expires time.Time `json:"expires"` // zero for never
+	Created time.Time `json:"created"`
+
+	Files []string `json:"files,omitempty"` // data files protected by the pin, filled by Pins
+}
+
+func (p *Pin) expired(now time.Time) bool {
+	return !p.Expires.IsZero() && !now.Before(p.Expires)
+}
+
+// covers returns true if the time range [from, to] overlaps the pin
+func (p *Pin) covers(from, to time.Time) bool {
+	return !to.Before(p.From) && !from.After(p.To)
+}
+
+func (ds *DataStore) pinDir() string {
+	return filepath.Join(ds.baseDir, PinDir)
+}
+
+func (ds *DataStore) loadPins() error {
+	buf, err := afero.ReadFile(ds.fs, filepath.Join(ds.pinDir(), pinsFile))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return errors.Wrap(err, "read pins failed")
+	}
+
+	err = json.Unmarshal(buf, &ds.pins)
+	if err != nil {
+		return errors.Wrap(err, "parse pins failed")
+	}
+	return nil
+}
+
+func (ds *DataStore) savePins() error {
+	ensureDir(ds.pinDir(), ds.fs)
+
+	buf, err := json.MarshalIndent(ds.pins, "", "  ")
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	// write to a temporary file, so pins are not lost on a crash
+	path := filepath.Join(ds.pinDir(), pinsFile)
+	err = afero.WriteFile(ds.fs, path+".tmp", buf, 0644)
+	if err != nil {
+		return errors.Wrap(err, "write pins failed")
+	}
+	return errors.Wrap(ds.fs.Rename(path+".tmp", path), "rename pins failed")
+}
+
+// Pin protects data files covering the time range [from, to] from reclaim until expires, zero expires for never.
+// Files being written are protected once sealed.
+func (ds *DataStore) Pin(label string, from, to time.Time, expires time.Time) (Pin, error) {
+	if from.IsZero() || to.IsZero() || to.Before(from) {
+		return Pin{}, errors.Newf("invalid time range [%v, %v]", from, to)
+	}
+
+	id := make([]byte, 8)
+	_, err := rand.Read(id)
+	if err != nil {
+		return Pin{}, errors.WithStack(err)
+	}
+
+	ds.mutex.Lock()
+	defer ds.mutex.Unlock()
+
+	pin := Pin{
+		Id:      hex.EncodeToString(id),
+		Label:   label,
+		From:    from,
+		To:      to,
+		Expires: expires,
+		Created: time.Now(),
+	}
+	ds.pins = append(ds.pins, pin)
+	err = ds.savePins()
+	if err != nil {
+		return Pin{}, err
+	}
+	log.Info().Str("id", pin.Id).Str("label", label).Time("from", from).Time("to", to).Msg("Pinned")
+
+	ds.protect(time.Now())
+	return pin, nil
+}
+
+// Unpin deletes the pin, files not covered by other pins are reclaimed as usual
+func (ds *DataStore) Unpin(id string) error {
+	ds.mutex.Lock()
+	defer ds.mutex.Unlock()
+
+	_, i, ok := lo.FindIndexOf(ds.pins, func(p Pin) bool {
+		return p.Id == id
+	})
+	if !ok {
+		return errors.Wrapf(ErrPinNotFound, "pin %s", id)
+	}
+
+	ds.pins = append(ds.pins[:i], ds.pins[i+1:]...)
+	err := ds.savePins()
+	if err != nil {
+		return err
+	}
+	log.Info().Str("id", id).Msg("Unpinned")
+
+	ds.protect(time.Now())
+	return nil
+}
+
+// Pins returns the pins with the files they protect
+func (ds *DataStore) Pins() ([]Pin, error) {
+	ds.mutex.Lock()
+	defer ds.mutex.Unlock()
+
+	files, err := ds.listPinnedFiles()
+	if err != nil {
+		return nil, err
+	}
+
+	pins := make([]Pin, 0, len(ds.pins))
+	for _, p := range ds.pins {
+		p.Files = make([]string, 0)
+		for _, f := range files {
+			from, to, ok := ds.timeRange(f.path)
+			if ok && p.covers(from, to) {
+				p.Files = append(p.Files, filepath.Base(f.path))
+			}
+		}
+		pins = append(pins, p)
+	}
+	return pins, nil
+}
+
+// listPinnedFiles returns data files in the protected area
+func (ds *DataStore) listPinnedFiles() ([]dataFileInfo, error) {
+	names, err := afero.ReadDir(ds.fs, ds.pinDir())
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, errors.Wrap(err, "list pinned files failed")
+	}
+
+	files := make([]dataFileInfo, 0)
+	for _, f := range names {
+		if !strings.HasPrefix(f.Name(), DataFilePrefix) {
+			continue
+		}
+		files = append(files, dataFileInfo{
+			path:    filepath.Join(ds.pinDir(), f.Name()),
+			stream:  StreamOfFile(f.Name()),
+			no:      getFileNo(f.Name()),
+			size:    f.Size(),
+			modTime: f.ModTime(),
+			sealed:  strings.HasSuffix(f.Name(), SealFileSuffix),
+		})
+	}
+	return files, nil
+}
+
+// timeRange returns the time range of metrics in the data file
+func (ds *DataStore) timeRange(dataFile string) (time.Time, time.Time, bool) {
+	index, err := ds.fileIndex(dataFile)
+	if err != nil {
+		log.Warn().Err(err).Str("file", dataFile).Msg("Load index failed")
+		return time.Time{}, time.Time{}, false
+	}
+
+	from, to, ok := MetricRange(index)
+	return time.Unix(from, 0), time.Unix(to, 0), ok
+}
+
+// protect drops expired pins, moves sealed data files covered by pins into the protected area and moves the rest
+// back
+func (ds *DataStore) protect(now time.Time) {
+	n := len(ds.pins)
+	ds.pins = lo.Filter(ds.pins, func(p Pin, i int) bool {
+		return !p.expired(now)
+	})
+	if len(ds.pins) != n {
+		log.Info().Int("count", n-len(ds.pins)).Msg("Pins expired")
+		err := ds.savePins()
+		if err != nil {
+			log.Warn().Err(err).Msg("Save pins failed")
+		}
+	}
+
+	pinned := func(f dataFileInfo) bool {
+		from, to, ok := ds.timeRange(f.path)
+		return ok && lo.SomeBy(ds.pins, func(p Pin) bool {
+			return p.covers(from, to)
+		})
+	}
+
+	if len(ds.pins) != 0 {
+		files, err := ds.listDataFiles()
+		if err != nil {
+			log.Warn().Err(err).Msg("List data files failed")
+			return
+		}
+		for _, f := range files {
+			if f.sealed && pinned(f) {
+				ds.moveDataFile(f.path, ds.pinDir())
+			}
+		}
+	}
+
+	files, err := ds.listPinnedFiles()
+	if err != nil {
+		log.Warn().Err(err).Msg("List pinned files failed")
+		return
+	}
+	for _, f := range files {
+		if !pinned(f) {
+			ds.moveDataFile(f.path, ds.baseDir)
+		}
+	}
+}
+
+// moveDataFile moves the data file and its sidecar files to the directory
+func (ds *DataStore) moveDataFile(dataFile string, dir string) {
+	ensureDir(dir, ds.fs)
+
+	dst := filepath.Join(dir, filepath.Base(dataFile))
+	err := ds.fs.Rename(dataFile, dst)
+	if err != nil {
+		log.Warn().Err(err).Str("file", dataFile).Str("dir", dir).Msg("Move data file failed")
+		return
+	}
+
+	for _, sidecar := range sidecarFiles(dataFile) {
+		err = ds.fs.Rename(sidecar, filepath.Join(dir, filepath.Base(sidecar)))
+		if err != nil && !os.IsNotExist(err) {
+			log.Warn().Err(err).Str("file", sidecar).Str("dir", dir).Msg("Move sidecar failed")
+		}
+	}
+	log.Info().Str("file", dataFile).Str("dir", dir).Msg("Moved")
+}
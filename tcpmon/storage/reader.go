@@ -2,19 +2,26 @@ package storage
 
 import (
 	"archive/tar"
-	"encoding/binary"
 	"io"
+	"math"
+	"os"
 	"path/filepath"
 	"sort"
 	"strings"
+	"time"
 
 	"github.com/cockroachdb/errors"
 	"github.com/klauspost/compress/zstd"
 	"github.com/rs/zerolog/log"
 	"github.com/samber/lo"
 	"github.com/spf13/afero"
+
+	"github.com/zperf/tcpmon/tcpmon/gproto"
 )
 
+// ErrStopRange is returned by the callback of Range to stop reading, Range returns nil then
+var ErrStopRange = errors.New("stop range")
+
 type Reader struct {
 	baseDir afero.File
 	fs      afero.Fs
@@ -26,6 +33,8 @@ type ReaderConfig struct {
 	fs      afero.Fs
 	prefix  string
 	suffix  string
+	pinned  bool       // include files in PinDir
+	dicts   *DictCache // dictionaries shared with the datastore, a new cache is created if nil
 }
 
 func NewReaderConfig(baseDir string) *ReaderConfig {
@@ -34,6 +43,7 @@ func NewReaderConfig(baseDir string) *ReaderConfig {
 		fs:      afero.NewOsFs(),
 		prefix:  DataFilePrefix,
 		suffix:  "",
+		pinned:  true,
 	}
 }
 
@@ -52,12 +62,42 @@ func (c *ReaderConfig) WithSuffix(s string) *ReaderConfig {
 	return c
 }
 
+// WithPinned set whether to read files protected by pins, they are read by default
+func (c *ReaderConfig) WithPinned(pinned bool) *ReaderConfig {
+	c.pinned = pinned
+	return c
+}
+
+// withDicts set the cache of dictionaries, the datastore shares its cache with its readers
+func (c *ReaderConfig) withDicts(dicts *DictCache) *ReaderConfig {
+	c.dicts = dicts
+	return c
+}
+
+// NewDataStoreReader opens the data dir, or a backup archive (see IsArchive) read in place
 func NewDataStoreReader(config *ReaderConfig) (*Reader, error) {
+	if IsArchive(config.baseDir) {
+		fs, err := OpenArchive(config.baseDir, config.fs)
+		if err != nil {
+			return nil, err
+		}
+		c := *config
+		c.baseDir = ArchiveRoot
+		c.fs = fs
+		config = &c
+	}
+
 	fh, err := config.fs.Open(config.baseDir)
 	if err != nil {
 		return nil, errors.Wrap(err, "open base dir failed")
 	}
 
+	if config.dicts == nil {
+		c := *config
+		c.dicts = NewDictCache()
+		config = &c
+	}
+
 	r := &Reader{
 		baseDir: fh,
 		fs:      config.fs,
@@ -98,14 +138,28 @@ func (r *Reader) newFilter() func(f string, i int) bool {
 }
 
 func (r *Reader) files() ([]string, error) {
-	files, err := r.baseDir.Readdirnames(-1)
+	// listed again on every call, the opened base dir can only be read once
+	infos, err := afero.ReadDir(r.fs, r.baseDir.Name())
 	if err != nil {
 		return nil, errors.Wrap(err, "list files in base dir failed")
 	}
+	files := lo.Map(infos, func(f os.FileInfo, _ int) string { return f.Name() })
+
+	if r.config.pinned {
+		pinned, err := afero.ReadDir(r.fs, filepath.Join(r.config.baseDir, PinDir))
+		if err != nil && !os.IsNotExist(err) {
+			return nil, errors.Wrap(err, "list pinned files failed")
+		}
+		for _, f := range pinned {
+			files = append(files, filepath.Join(PinDir, f.Name()))
+		}
+	}
 
 	filter := r.newFilter()
 	if filter != nil {
-		files = lo.Filter(files, filter)
+		files = lo.Filter(files, func(f string, i int) bool {
+			return filter(filepath.Base(f), i)
+		})
 	}
 
 	sort.Slice(files, func(i, j int) bool {
@@ -125,36 +179,228 @@ func (r *Reader) Iterate(cb func(buf []byte) error) error {
 		filePath := filepath.Join(r.baseDir.Name(), file)
 		log.Info().Str("file", filePath).Msg("Iterate over file")
 
-		reader, err := NewDataFileReader(filePath, r.fs)
+		reader, err := newDataFileReader(filePath, r.fs, r.config.dicts)
 		if err != nil {
 			return err
 		}
+		err = iterateFile(reader, cb)
+		if err != nil {
+			log.Warn().Err(err).Msg("Error occurred, skip to the next file")
+		}
+		reader.Close()
+	}
 
-		offset := uint64(0)
-		for {
-			buf, err := reader.Read()
-			if err != nil {
-				if errors.Is(err, io.EOF) {
-					break
-				}
-				return err
+	return nil
+}
+
+// iterateFile calls cb for each record in the file. Records with a bad checksum are skipped, the error of reading the
+// rest of the file or of cb is returned.
+func iterateFile(reader *DataFileReader, cb func(buf []byte) error) error {
+	decoder := NewDeltaDecoder()
+	for {
+		buf, err := reader.Read()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			if errors.Is(err, ErrChecksumMismatch) {
+				log.Warn().Err(err).Str("file", reader.Name()).Msg("Corrupted record skipped")
+				decoder.Reset()
+				continue
 			}
-			offset += uint64(len(buf))
+			return errors.Wrapf(err, "read %s at offset %d failed", reader.Name(), reader.Offset())
+		}
 
-			if len(buf) != 0 {
+		if len(buf) != 0 {
+			buf, err = decoder.Decode(buf)
+			if errors.Is(err, ErrMissingKeyframe) {
+				// the keyframe is corrupted, skip to the next keyframe
+				continue
+			}
+			if err == nil {
 				err = cb(buf)
-				if err != nil {
-					log.Warn().Err(err).Uint64("offset", offset).
-						Msg("Error occurred, skip to the next file")
-					break
-				}
+			}
+			if err != nil {
+				return errors.Wrapf(err, "read %s at offset %d failed", reader.Name(), reader.Offset())
 			}
 		}
 	}
+}
+
+// Range calls cb for each metric in the time range [from, to] of the types, zero from or to means unbounded. All
+// types are included if no type is given, TCP snapshots are reconstructed from deltas. Only the files overlapping
+// the time range are opened, and the reader seeks to the matching records by the index of each file. cb returns
+// ErrStopRange to stop reading.
+func (r *Reader) Range(from, to time.Time, cb func(buf []byte) error, types ...gproto.MetricType) error {
+	files, err := r.files()
+	if err != nil {
+		return err
+	}
+
+	q := newRangeQuery(from, to, types)
+	for _, file := range files {
+		filePath := filepath.Join(r.baseDir.Name(), file)
+
+		index, err := r.index(filePath)
+		if err != nil {
+			log.Warn().Err(err).Str("file", filePath).Msg("Load index failed, skip the file")
+			continue
+		}
+
+		entries := q.entries(index)
+		if len(entries) == 0 {
+			continue
+		}
+		log.Info().Str("file", filePath).Int("records", len(entries)).Msg("Range over file")
+
+		reader, err := newDataFileReader(filePath, r.fs, r.config.dicts)
+		if err != nil {
+			return err
+		}
+		stopped := r.rangeFile(reader, index, entries, q, cb)
+		reader.Close()
+		if stopped {
+			return nil
+		}
+	}
 
 	return nil
 }
 
+// index loads the sidecar index of the data file, or builds it if there is no index
+func (r *Reader) index(filePath string) (*gproto.FileIndex, error) {
+	index, err := LoadIndex(r.fs, filePath)
+	if err == nil {
+		return index, nil
+	}
+	if !errors.Is(err, ErrIndexNotFound) {
+		log.Warn().Err(err).Str("file", filePath).Msg("Invalid index, rebuild it")
+	}
+	return BuildIndex(r.fs, filePath)
+}
+
+// rangeFile calls cb for the matching entries of the file, returns true if cb stops the range by ErrStopRange
+func (r *Reader) rangeFile(reader *DataFileReader, index *gproto.FileIndex, entries []int, q *rangeQuery,
+	cb func(buf []byte) error) bool {
+	decoder := NewDeltaDecoder()
+	corrupted := index.GetCorruptOffsets()
+	for _, i := range entries {
+		// a record before the entry is lost, the next delta may not be computed from the decoded snapshot
+		for len(corrupted) != 0 && corrupted[0] < index.Offsets[i] {
+			decoder.Reset()
+			corrupted = corrupted[1:]
+		}
+
+		err := reader.SkipTo(int64(index.Offsets[i]))
+		if err != nil {
+			log.Warn().Err(err).Str("file", reader.Name()).Msg("Seek failed, skip to the next file")
+			return false
+		}
+
+		buf, err := reader.Read()
+		if err != nil {
+			if errors.Is(err, ErrChecksumMismatch) {
+				log.Warn().Err(err).Str("file", reader.Name()).Msg("Corrupted record skipped")
+				decoder.Reset()
+				continue
+			}
+			log.Warn().Err(err).Str("file", reader.Name()).Int64("offset", reader.Offset()).
+				Msg("Read failed, skip to the next file")
+			return false
+		}
+
+		buf, err = decoder.Decode(buf)
+		if errors.Is(err, ErrMissingKeyframe) {
+			continue
+		}
+		if err == nil && q.match(index, i) {
+			err = cb(buf)
+		}
+		if errors.Is(err, ErrStopRange) {
+			return true
+		}
+		if err != nil {
+			log.Warn().Err(err).Str("file", reader.Name()).Int64("offset", reader.Offset()).
+				Msg("Error occurred, skip to the next file")
+			return false
+		}
+	}
+	return false
+}
+
+type rangeQuery struct {
+	from  int64
+	to    int64
+	types map[gproto.MetricType]bool // nil for all types
+}
+
+func newRangeQuery(from, to time.Time, types []gproto.MetricType) *rangeQuery {
+	q := &rangeQuery{from: math.MinInt64, to: math.MaxInt64}
+	if !from.IsZero() {
+		q.from = from.Unix()
+	}
+	if !to.IsZero() {
+		q.to = to.Unix()
+	}
+
+	if len(types) != 0 {
+		q.types = make(map[gproto.MetricType]bool)
+		for _, t := range types {
+			q.types[t] = true
+		}
+		// TCP snapshots are stored as keyframes and deltas
+		if q.types[gproto.MetricType_TCP] {
+			q.types[gproto.MetricType_TCP_DELTA] = true
+		}
+	}
+	return q
+}
+
+func (q *rangeQuery) match(index *gproto.FileIndex, i int) bool {
+	ts := index.Timestamps[i]
+	if ts < q.from || ts > q.to {
+		return false
+	}
+	return q.types == nil || q.types[index.Types[i]]
+}
+
+// entries returns the index entries to read, in the order of records. Deltas are decoded from the last keyframe
+// before them, so the keyframe and the deltas in between are read as well.
+func (q *rangeQuery) entries(index *gproto.FileIndex) []int {
+	if len(index.Offsets) == 0 || index.MaxTimestamp < q.from || index.MinTimestamp > q.to {
+		return nil
+	}
+
+	isTcp := func(i int) bool {
+		return index.Types[i] == gproto.MetricType_TCP || index.Types[i] == gproto.MetricType_TCP_DELTA
+	}
+
+	// the range of TCP records to decode
+	firstTcp, lastTcp := -1, -1
+	for i := range index.Offsets {
+		if isTcp(i) && q.match(index, i) {
+			if firstTcp == -1 {
+				firstTcp = i
+			}
+			lastTcp = i
+		}
+	}
+	for firstTcp > 0 && index.Types[firstTcp] == gproto.MetricType_TCP_DELTA {
+		firstTcp--
+		for firstTcp > 0 && !isTcp(firstTcp) {
+			firstTcp--
+		}
+	}
+
+	entries := make([]int, 0)
+	for i := range index.Offsets {
+		if q.match(index, i) || (isTcp(i) && firstTcp != -1 && i >= firstTcp && i <= lastTcp) {
+			entries = append(entries, i)
+		}
+	}
+	return entries
+}
+
 func (r *Reader) Count() (int, error) {
 	count := 0
 	err := r.Iterate(func(_ []byte) error {
@@ -170,6 +416,13 @@ func (r *Reader) Package(writer io.Writer) error {
 		return err
 	}
 
+	// dictionaries are packaged first, they are required to read the data files
+	dicts, err := listDicts(r.fs, r.config.baseDir)
+	if err != nil {
+		return err
+	}
+	files = append(lo.Map(dicts, func(d string, _ int) string { return filepath.Base(d) }), files...)
+
 	t := tar.NewWriter(writer)
 	defer t.Close()
 
@@ -186,8 +439,9 @@ func (r *Reader) Package(writer io.Writer) error {
 			return errors.Wrap(err, "stat failed")
 		}
 
+		// pinned files are packaged with the others
 		err = t.WriteHeader(&tar.Header{
-			Name:    file,
+			Name:    filepath.Base(file),
 			Mode:    0644,
 			Size:    stat.Size(),
 			ModTime: stat.ModTime(),
@@ -207,11 +461,17 @@ func (r *Reader) Package(writer io.Writer) error {
 }
 
 type DataFileReader struct {
-	fh     afero.File
-	reader io.Reader
+	fh      afero.File
+	reader  io.Reader
+	scanner *RecordScanner
 }
 
 func NewDataFileReader(filePath string, fs afero.Fs) (*DataFileReader, error) {
+	return newDataFileReader(filePath, fs, nil)
+}
+
+// newDataFileReader opens the data file, dictionaries are read through the cache
+func newDataFileReader(filePath string, fs afero.Fs, cache *DictCache) (*DataFileReader, error) {
 	if fs == nil {
 		fs = afero.NewOsFs()
 	}
@@ -221,53 +481,76 @@ func NewDataFileReader(filePath string, fs afero.Fs) (*DataFileReader, error) {
 		return nil, errors.Wrap(err, "open file failed")
 	}
 
+	var reader io.Reader = fh
 	if strings.HasSuffix(filePath, SealFileSuffix) {
-		reader, err := zstd.NewReader(fh)
+		dicts, err := cache.Load(fs, filePath)
 		if err != nil {
-			return nil, errors.Wrap(err, "create new zstd reader failed")
+			_ = fh.Close()
+			return nil, err
+		}
+
+		reader, err = NewSeekableReader(fh, dicts...)
+		if errors.Is(err, ErrNoSeekTable) {
+			// sealed by an old version, it's read from the start
+			z, err := zstd.NewReader(fh, zstd.WithDecoderDicts(dicts...))
+			if err != nil {
+				return nil, errors.Wrap(err, "create new zstd reader failed")
+			}
+			reader = z.IOReadCloser()
+		} else if err != nil {
+			_ = fh.Close()
+			return nil, err
 		}
-		return &DataFileReader{fh: fh, reader: reader}, nil
 	}
 
-	return &DataFileReader{fh: fh, reader: fh}, nil
+	return &DataFileReader{fh: fh, reader: reader, scanner: NewRecordScanner(reader)}, nil
 }
 
+// Read returns the body of the next record. If the error is ErrChecksumMismatch, the record is skipped and the
+// next record can be read.
 func (r *DataFileReader) Read() ([]byte, error) {
-	size, err := r.ReadHeader()
-	if err != nil {
-		return nil, err
-	}
-
-	buf := make([]byte, size)
-	_, err = r.reader.Read(buf)
+	rec, err := r.scanner.Next()
 	if err != nil {
 		return nil, err
 	}
+	return rec.Body, nil
+}
 
-	return buf, nil
+// Offset returns the offset of the next record in the uncompressed data
+func (r *DataFileReader) Offset() int64 {
+	return r.scanner.Offset()
 }
 
-func ReadHeader(reader io.Reader) (uint32, error) {
-	buf := make([]byte, HeaderSize)
-	_, err := reader.Read(buf)
-	if err != nil {
-		if err == io.EOF {
-			return 0, err
-		}
-		return 0, errors.Wrap(err, "read header failed")
+// SkipTo moves forward to the record at the offset in the uncompressed data
+func (r *DataFileReader) SkipTo(offset int64) error {
+	cur := r.scanner.Offset()
+	if offset < cur {
+		return errors.Newf("can't seek backward from %d to %d", cur, offset)
+	}
+	if offset == cur {
+		return nil
 	}
 
-	version := binary.LittleEndian.Uint16(buf[0:2])
-	if version != Version {
-		return 0, errors.Newf("invalid version 0x%x in header", version)
+	if seeker, ok := r.reader.(io.Seeker); ok {
+		// raw or seekable files
+		_, err := seeker.Seek(offset, io.SeekStart)
+		if err != nil {
+			return errors.Wrap(err, "seek failed")
+		}
+	} else {
+		// skip the decompressed data
+		_, err := io.CopyN(io.Discard, r.reader, offset-cur)
+		if err != nil {
+			return errors.Wrap(err, "skip failed")
+		}
 	}
 
-	size := binary.LittleEndian.Uint32(buf[2:6])
-	return size, nil
+	r.scanner.offset = offset
+	return nil
 }
 
-func (r *DataFileReader) ReadHeader() (uint32, error) {
-	return ReadHeader(r.reader)
+func (r *DataFileReader) Name() string {
+	return r.fh.Name()
 }
 
 func (r *DataFileReader) Close() {
@@ -278,7 +561,7 @@ func (r *DataFileReader) Close() {
 		}
 	}
 
-	if c, ok := r.reader.(io.Closer); ok {
+	if c, ok := r.reader.(io.Closer); ok && r.reader != io.Reader(r.fh) {
 		err := c.Close()
 		if err != nil {
 			log.Warn().Err(err).Msg("Close reader failed")
@@ -0,0 +1,156 @@
+package storage
+
+import (
+	"encoding/binary"
+	"hash/crc32"
+	"io"
+
+	"github.com/cockroachdb/errors"
+)
+
+var crc32c = crc32.MakeTable(crc32.Castagnoli)
+
+var ErrChecksumMismatch = errors.New("checksum mismatch")
+var ErrTornRecord = errors.New("torn record")
+var ErrInvalidHeader = errors.New("invalid header")
+
+// MaxRecordSize is the max size of a record body. Larger sizes in headers are corrupted, the body isn't allocated.
+const MaxRecordSize = 64 << 20
+
+// Header is the record header. Records written by VersionChecksum carry the CRC32C of the body.
+//
+//	Version:         version(uint16) + body size(uint32)
+//	VersionChecksum: version(uint16) + body size(uint32) + crc32c(uint32)
+type Header struct {
+	Version  uint16
+	Size     uint32
+	Checksum uint32
+}
+
+// Len returns the size of the header on disk
+func (h Header) Len() int {
+	if h.Version == VersionChecksum {
+		return ChecksumHeaderSize
+	}
+	return HeaderSize
+}
+
+// Verify checks the body against the checksum in the header, records without checksum are always valid
+func (h Header) Verify(body []byte) error {
+	if h.Version != VersionChecksum {
+		return nil
+	}
+
+	sum := crc32.Checksum(body, crc32c)
+	if sum != h.Checksum {
+		return errors.Wrapf(ErrChecksumMismatch, "expected 0x%08x, actual 0x%08x", h.Checksum, sum)
+	}
+	return nil
+}
+
+// encodeRecord returns the header with checksum of the body
+func encodeRecord(body []byte) []byte {
+	buf := make([]byte, ChecksumHeaderSize)
+	binary.LittleEndian.PutUint16(buf[0:2], VersionChecksum)
+	binary.LittleEndian.PutUint32(buf[2:6], uint32(len(body)))
+	binary.LittleEndian.PutUint32(buf[6:10], crc32.Checksum(body, crc32c))
+	return buf
+}
+
+// ReadHeader reads a header of any version. io.EOF is returned at the end of the stream, ErrTornRecord if the
+// stream ends in the middle of the header.
+func ReadHeader(reader io.Reader) (Header, error) {
+	var h Header
+
+	buf := make([]byte, ChecksumHeaderSize)
+	_, err := io.ReadFull(reader, buf[:HeaderSize])
+	if err != nil {
+		if err == io.EOF {
+			return h, err
+		}
+		if err == io.ErrUnexpectedEOF {
+			return h, errors.Wrap(ErrTornRecord, "read header failed")
+		}
+		return h, errors.Wrap(err, "read header failed")
+	}
+
+	h.Version = binary.LittleEndian.Uint16(buf[0:2])
+	h.Size = binary.LittleEndian.Uint32(buf[2:6])
+
+	switch h.Version {
+	case Version:
+		return h, nil
+
+	case VersionChecksum:
+		_, err = io.ReadFull(reader, buf[HeaderSize:ChecksumHeaderSize])
+		if err != nil {
+			if err == io.EOF || err == io.ErrUnexpectedEOF {
+				return h, errors.Wrap(ErrTornRecord, "read header failed")
+			}
+			return h, errors.Wrap(err, "read header failed")
+		}
+		h.Checksum = binary.LittleEndian.Uint32(buf[HeaderSize:ChecksumHeaderSize])
+		return h, nil
+
+	default:
+		return h, errors.Mark(errors.Newf("invalid version 0x%x in header", h.Version), ErrInvalidHeader)
+	}
+}
+
+// Record is a record read by RecordScanner
+type Record struct {
+	Offset int64 // offset of the header
+	Header Header
+	Body   []byte
+}
+
+// End returns the offset next to the record
+func (r *Record) End() int64 {
+	return r.Offset + int64(r.Header.Len()) + int64(r.Header.Size)
+}
+
+// RecordScanner reads records in order and tracks their offsets
+type RecordScanner struct {
+	reader io.Reader
+	offset int64
+}
+
+func NewRecordScanner(reader io.Reader) *RecordScanner {
+	return &RecordScanner{reader: reader}
+}
+
+// Offset returns the offset of the next record
+func (s *RecordScanner) Offset() int64 {
+	return s.offset
+}
+
+// Next returns the next record. If ErrChecksumMismatch is returned with the record, the scanner can go on with
+// the next record. io.EOF is returned at the end of the stream, other errors mean the rest of the stream can't be
+// read, e.g. ErrTornRecord and ErrInvalidHeader.
+func (s *RecordScanner) Next() (*Record, error) {
+	h, err := ReadHeader(s.reader)
+	if err != nil {
+		return nil, err
+	}
+
+	rec := &Record{Offset: s.offset, Header: h}
+	if h.Size > MaxRecordSize {
+		return nil, errors.Wrapf(ErrTornRecord, "%d bytes body at offset %d exceeds the max record size",
+			h.Size, rec.Offset)
+	}
+	rec.Body = make([]byte, h.Size)
+	_, err = io.ReadFull(s.reader, rec.Body)
+	if err != nil {
+		if err == io.EOF || err == io.ErrUnexpectedEOF {
+			return nil, errors.Wrapf(ErrTornRecord, "read body at offset %d failed", rec.Offset)
+		}
+		return nil, errors.Wrap(err, "read body failed")
+	}
+	s.offset = rec.End()
+
+	err = h.Verify(rec.Body)
+	if err != nil {
+		return rec, errors.Wrapf(err, "record at offset %d", rec.Offset)
+	}
+	return rec, nil
+}
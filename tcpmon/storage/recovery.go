@@ -0,0 +1,99 @@
+package storage
+
+import (
+	"io"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+	"github.com/rs/zerolog/log"
+	"github.com/samber/lo"
+)
+
+// recover repairs the data files left unsealed by the last run, e.g. after a crash or power loss. The torn tail
+// of each file is truncated, then the file is sealed. Records with a bad checksum are kept, readers skip them.
+func (ds *DataStore) recover() error {
+	dataFiles, err := ds.getDataFiles("")
+	if err != nil {
+		return err
+	}
+
+	rawFiles := lo.Filter(dataFiles, func(f string, i int) bool {
+		return !strings.HasSuffix(f, SealFileSuffix)
+	})
+	sort.Slice(rawFiles, func(i, j int) bool {
+		return getFileNo(rawFiles[i]) < getFileNo(rawFiles[j])
+	})
+
+	for _, f := range rawFiles {
+		err := ds.truncateTornTail(f)
+		if err != nil {
+			return errors.Wrapf(err, "recover %s failed", f)
+		}
+
+		// empty files are sealed as well, file numbers keep increasing
+		ds.writeIndex(f, nil)
+		err = ds.compressFile(f, f+SealFileSuffix)
+		if err != nil {
+			return errors.Wrap(err, "seal failed")
+		}
+	}
+
+	return nil
+}
+
+// truncateTornTail truncates the file after the last complete record
+func (ds *DataStore) truncateTornTail(f string) error {
+	fh, err := ds.fs.OpenFile(f, os.O_RDWR, 0644)
+	if err != nil {
+		return errors.Wrap(err, "open file failed")
+	}
+	defer fh.Close()
+
+	stat, err := fh.Stat()
+	if err != nil {
+		return errors.Wrap(err, "stat failed")
+	}
+
+	scanner := NewRecordScanner(fh)
+	corrupted := 0
+	for {
+		_, err = scanner.Next()
+		if err == nil {
+			continue
+		}
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if errors.Is(err, ErrChecksumMismatch) {
+			corrupted++
+			continue
+		}
+		if errors.Is(err, ErrTornRecord) || errors.Is(err, ErrInvalidHeader) {
+			log.Warn().Err(err).Str("file", f).Int64("offset", scanner.Offset()).
+				Int64("dropped", stat.Size()-scanner.Offset()).Msg("Torn tail truncated")
+			break
+		}
+		return err
+	}
+
+	if corrupted != 0 {
+		log.Warn().Str("file", f).Int("records", corrupted).Msg("Records with bad checksum found")
+	}
+
+	size := scanner.Offset()
+	if size != stat.Size() {
+		err = fh.Truncate(size)
+		if err != nil {
+			return errors.Wrap(err, "truncate failed")
+		}
+
+		err = fh.Sync()
+		if err != nil {
+			return errors.Wrap(err, "file sync failed")
+		}
+	}
+
+	return nil
+}
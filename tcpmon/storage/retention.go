@@ -0,0 +1,209 @@
+package storage
+
+import (
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/rs/zerolog/log"
+	"github.com/samber/lo"
+)
+
+type dataFileInfo struct {
+	path    string
+	stream  string
+	no      uint32
+	size    int64 // including the index and the columnar segment
+	modTime time.Time
+	sealed  bool
+}
+
+// listDataFiles returns data files of all streams, from the oldest to the newest
+func (ds *DataStore) listDataFiles() ([]dataFileInfo, error) {
+	files, err := ds.getDataFiles("")
+	if err != nil {
+		return nil, err
+	}
+
+	infos := make([]dataFileInfo, 0, len(files))
+	for _, f := range files {
+		stat, err := ds.fs.Stat(f)
+		if err != nil {
+			if os.IsNotExist(err) {
+				continue
+			}
+			return nil, err
+		}
+
+		size := stat.Size()
+		for _, sidecar := range sidecarFiles(f) {
+			stat, err := ds.fs.Stat(sidecar)
+			if err == nil {
+				size += stat.Size()
+			}
+		}
+
+		name := filepath.Base(f)
+		infos = append(infos, dataFileInfo{
+			path:    f,
+			stream:  StreamOfFile(name),
+			no:      getFileNo(name),
+			size:    size,
+			modTime: stat.ModTime(),
+			sealed:  strings.HasSuffix(name, SealFileSuffix),
+		})
+	}
+
+	sort.Slice(infos, func(i, j int) bool {
+		return infos[i].no < infos[j].no
+	})
+	return infos, nil
+}
+
+// lastTimestamp returns the time of the newest metric in the data file by its index, or the modification time if
+// there is no index or no metric
+func (ds *DataStore) lastTimestamp(f dataFileInfo) time.Time {
+	index, err := LoadIndex(ds.fs, f.path)
+	if err != nil {
+		return f.modTime
+	}
+	_, to, ok := MetricRange(index)
+	if !ok {
+		return f.modTime
+	}
+	return time.Unix(to, 0)
+}
+
+func (ds *DataStore) isWriterFile(f string) bool {
+	return lo.SomeBy(lo.Values(ds.streams), func(st *stream) bool {
+		return st.writerFilePath == f
+	})
+}
+
+// reclaim deletes sealed data files out of the retention, from the oldest to the newest:
+//  1. files with all records older than MaxAge, except rollups which are kept for the long-term history
+//  2. files of a stream over its own budget in StreamMaxSize
+//  3. files while the total size is over MaxSize. Streams without their own budget are reclaimed first, so they
+//     don't evict the history of other streams.
+//
+// Files covered by pins are not deleted.
+func (ds *DataStore) reclaim() {
+	run := ReclaimStats{Time: time.Now()}
+	defer func() {
+		run.Duration = time.Since(run.Time)
+		ds.recordReclaim(run)
+	}()
+	// dictionaries of the files deleted below are deleted once no file uses them
+	defer func() {
+		run.Freed += ds.gcDicts()
+	}()
+
+	files, err := ds.listDataFiles()
+	if err != nil {
+		log.Warn().Err(err).Msg("List data files failed")
+		return
+	}
+
+	// seal the files left by crashed writers
+	for i, f := range files {
+		if f.sealed || ds.isWriterFile(f.path) {
+			continue
+		}
+
+		ds.writeIndex(f.path, nil)
+		err = ds.compressFile(f.path, f.path+SealFileSuffix)
+		if err != nil {
+			log.Warn().Err(err).Str("file", f.path).Msg("Compress failed")
+			continue
+		}
+
+		stat, err := ds.fs.Stat(f.path + SealFileSuffix)
+		if err != nil {
+			log.Warn().Err(err).Str("file", f.path).Msg("Stat failed")
+			continue
+		}
+		files[i].path = f.path + SealFileSuffix
+		files[i].size = stat.Size()
+		files[i].sealed = true
+	}
+
+	// files covered by pins are moved out of the base directory, so they are not counted or deleted below
+	ds.protect(time.Now())
+	files, err = ds.listDataFiles()
+	if err != nil {
+		log.Warn().Err(err).Msg("List data files failed")
+		return
+	}
+
+	run.Files = len(files)
+
+	// dictionaries are shared by the files of a stream, they are counted in the total size only
+	run.Freed += ds.gcDicts()
+	total := ds.dictsSize()
+	streamSizes := make(map[string]int64)
+	for _, f := range files {
+		total += f.size
+		streamSizes[f.stream] += f.size
+	}
+
+	deleted := make(map[string]bool)
+	remove := func(f dataFileInfo, reason string) {
+		err := ds.fs.Remove(f.path)
+		if err != nil {
+			log.Warn().Err(err).Str("file", f.path).Msg("Delete failed")
+			return
+		}
+		log.Info().Str("file", f.path).Str("reason", reason).Msg("Deleted")
+
+		for _, sidecar := range sidecarFiles(f.path) {
+			err = ds.fs.Remove(sidecar)
+			if err != nil && !os.IsNotExist(err) {
+				log.Warn().Err(err).Str("file", sidecar).Msg("Delete sidecar failed")
+			}
+		}
+
+		deleted[f.path] = true
+		run.Deleted++
+		run.Freed += f.size
+		total -= f.size
+		streamSizes[f.stream] -= f.size
+	}
+
+	sealed := lo.Filter(files, func(f dataFileInfo, i int) bool {
+		return f.sealed
+	})
+
+	if ds.config.MaxAge > 0 {
+		deadline := time.Now().Add(-ds.config.MaxAge)
+		for _, f := range sealed {
+			if !IsRollupStream(f.stream) && ds.lastTimestamp(f).Before(deadline) {
+				remove(f, "expired")
+			}
+		}
+	}
+
+	for _, f := range sealed {
+		budget := ds.config.StreamMaxSize[f.stream]
+		if !deleted[f.path] && budget > 0 && streamSizes[f.stream] > budget {
+			remove(f, "stream size exceeded")
+		}
+	}
+
+	if total > ds.config.MaxSize {
+		log.Info().Int64("size", total).
+			Int64("maxSize", ds.config.MaxSize).Msg("Reclaiming...")
+	}
+	for _, shared := range []bool{true, false} {
+		for _, f := range sealed {
+			if total <= ds.config.MaxSize {
+				return
+			}
+			if deleted[f.path] || (shared && ds.config.StreamMaxSize[f.stream] > 0) {
+				continue
+			}
+			remove(f, "total size exceeded")
+		}
+	}
+}
@@ -0,0 +1,310 @@
+package storage
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"io"
+	"sort"
+
+	"github.com/cockroachdb/errors"
+	"github.com/klauspost/compress/zstd"
+	"github.com/spf13/afero"
+)
+
+// Sealed files are written in the zstd seekable format: independent frames of whole records, followed by a seek
+// table in a skippable frame. Plain zstd decoders read them, but the dictionary of the stream is required if the file
+// was compressed with one (see LoadDicts). SeekableReader jumps to any offset by decompressing only the frame
+// containing it.
+// See https://github.com/facebook/zstd/blob/dev/contrib/seekable_format/zstd_seekable_compression_format.md
+const (
+	skippableFrameMagic = uint32(0x184d2a5e)
+	seekableMagic       = uint32(0x8f92eab1)
+	seekTableFooterSize = 9
+	seekTableEntrySize  = 8
+	skippableHeaderSize = 8
+	checksumFlag        = 1 << 7
+)
+
+var ErrNoSeekTable = errors.New("no seek table")
+
+type seekFrame struct {
+	compressedOffset   int64
+	compressedSize     uint32
+	decompressedOffset int64
+	decompressedSize   uint32
+}
+
+// writeSeekable compresses the records read from the reader into frames of at least frameSize bytes, with the
+// dictionary if it's not nil
+func writeSeekable(reader io.Reader, writer io.Writer, level int, frameSize int, dict []byte) error {
+	options := []zstd.EOption{zstd.WithEncoderLevel(zstd.EncoderLevelFromZstd(level)), zstd.WithEncoderConcurrency(1)}
+	if dict != nil {
+		options = append(options, zstd.WithEncoderDict(dict))
+	}
+	encoder, err := zstd.NewWriter(nil, options...)
+	if err != nil {
+		return errors.Wrap(err, "create new zstd writer failed")
+	}
+	defer encoder.Close()
+
+	var frame bytes.Buffer
+	var frames []seekFrame
+	writeFrame := func() error {
+		buf := encoder.EncodeAll(frame.Bytes(), nil)
+		_, err := writer.Write(buf)
+		if err != nil {
+			return errors.Wrap(err, "write frame failed")
+		}
+		frames = append(frames, seekFrame{compressedSize: uint32(len(buf)), decompressedSize: uint32(frame.Len())})
+		frame.Reset()
+		return nil
+	}
+
+	// the scanner reads exactly the bytes of each record, so frames end at record boundaries
+	br := bufio.NewReader(reader)
+	scanner := NewRecordScanner(io.TeeReader(br, &frame))
+	for {
+		_, err = scanner.Next()
+		if err != nil && !errors.Is(err, ErrChecksumMismatch) {
+			if !errors.Is(err, io.EOF) {
+				// keep the bytes can't be parsed as they are
+				_, err = io.Copy(&frame, br)
+				if err != nil {
+					return errors.Wrap(err, "read failed")
+				}
+			}
+			break
+		}
+
+		if frame.Len() >= frameSize {
+			err = writeFrame()
+			if err != nil {
+				return err
+			}
+		}
+	}
+	if frame.Len() > 0 || len(frames) == 0 {
+		err = writeFrame()
+		if err != nil {
+			return err
+		}
+	}
+
+	return writeSeekTable(writer, frames)
+}
+
+func writeSeekTable(writer io.Writer, frames []seekFrame) error {
+	size := len(frames)*seekTableEntrySize + seekTableFooterSize
+	buf := make([]byte, skippableHeaderSize, skippableHeaderSize+size)
+	binary.LittleEndian.PutUint32(buf[0:4], skippableFrameMagic)
+	binary.LittleEndian.PutUint32(buf[4:8], uint32(size))
+	for _, f := range frames {
+		buf = binary.LittleEndian.AppendUint32(buf, f.compressedSize)
+		buf = binary.LittleEndian.AppendUint32(buf, f.decompressedSize)
+	}
+	buf = binary.LittleEndian.AppendUint32(buf, uint32(len(frames)))
+	buf = append(buf, 0) // no checksums, frames have their own
+	buf = binary.LittleEndian.AppendUint32(buf, seekableMagic)
+
+	_, err := writer.Write(buf)
+	return errors.Wrap(err, "write seek table failed")
+}
+
+// readSeekTable reads the seek table at the end of the file, ErrNoSeekTable is returned if there is none
+func readSeekTable(fh afero.File) ([]seekFrame, error) {
+	stat, err := fh.Stat()
+	if err != nil {
+		return nil, errors.Wrap(err, "stat failed")
+	}
+	if stat.Size() < skippableHeaderSize+seekTableFooterSize {
+		return nil, ErrNoSeekTable
+	}
+
+	footer := make([]byte, seekTableFooterSize)
+	_, err = fh.ReadAt(footer, stat.Size()-seekTableFooterSize)
+	if err != nil {
+		return nil, errors.Wrap(err, "read seek table footer failed")
+	}
+	if binary.LittleEndian.Uint32(footer[5:9]) != seekableMagic {
+		return nil, ErrNoSeekTable
+	}
+
+	n := int64(binary.LittleEndian.Uint32(footer[0:4]))
+	entrySize := int64(seekTableEntrySize)
+	if footer[4]&checksumFlag != 0 {
+		entrySize += 4
+	}
+	tableSize := n*entrySize + seekTableFooterSize
+	tableOffset := stat.Size() - tableSize - skippableHeaderSize
+	if tableOffset < 0 {
+		return nil, errors.Wrap(ErrNoSeekTable, "seek table exceeds the file")
+	}
+
+	buf := make([]byte, skippableHeaderSize+tableSize)
+	_, err = fh.ReadAt(buf, tableOffset)
+	if err != nil {
+		return nil, errors.Wrap(err, "read seek table failed")
+	}
+	if binary.LittleEndian.Uint32(buf[0:4]) != skippableFrameMagic ||
+		int64(binary.LittleEndian.Uint32(buf[4:8])) != tableSize {
+		return nil, errors.Wrap(ErrNoSeekTable, "invalid seek table header")
+	}
+
+	frames := make([]seekFrame, 0, n)
+	var compressedOffset, decompressedOffset int64
+	for i := int64(0); i < n; i++ {
+		entry := buf[skippableHeaderSize+i*entrySize:]
+		f := seekFrame{
+			compressedOffset:   compressedOffset,
+			compressedSize:     binary.LittleEndian.Uint32(entry[0:4]),
+			decompressedOffset: decompressedOffset,
+			decompressedSize:   binary.LittleEndian.Uint32(entry[4:8]),
+		}
+		compressedOffset += int64(f.compressedSize)
+		decompressedOffset += int64(f.decompressedSize)
+		frames = append(frames, f)
+	}
+	if compressedOffset != tableOffset {
+		return nil, errors.Wrap(ErrNoSeekTable, "frames don't match the seek table")
+	}
+	return frames, nil
+}
+
+// SeekableReader reads the decompressed data of a sealed file at any offset. Only the last decompressed frame is
+// kept in memory.
+type SeekableReader struct {
+	fh      afero.File
+	frames  []seekFrame
+	decoder *zstd.Decoder
+	offset  int64 // for Read and Seek
+
+	frame int // index of the decompressed frame, -1 for none
+	buf   []byte
+}
+
+// NewSeekableReader reads the seek table of the file, ErrNoSeekTable is returned if the file was not written in the
+// seekable format. The file is not closed by the reader. Frames compressed with a dictionary are read if it's one
+// of the dicts, see LoadDicts.
+func NewSeekableReader(fh afero.File, dicts ...[]byte) (*SeekableReader, error) {
+	err := checkEncrypted(fh)
+	if err != nil {
+		return nil, err
+	}
+
+	frames, err := readSeekTable(fh)
+	if err != nil {
+		return nil, err
+	}
+
+	decoder, err := zstd.NewReader(nil, zstd.WithDecoderConcurrency(1), zstd.WithDecoderDicts(dicts...))
+	if err != nil {
+		return nil, errors.Wrap(err, "create new zstd reader failed")
+	}
+	return &SeekableReader{fh: fh, frames: frames, decoder: decoder, frame: -1}, nil
+}
+
+// Size returns the size of the decompressed data
+func (r *SeekableReader) Size() int64 {
+	if len(r.frames) == 0 {
+		return 0
+	}
+	last := r.frames[len(r.frames)-1]
+	return last.decompressedOffset + int64(last.decompressedSize)
+}
+
+func (r *SeekableReader) Name() string {
+	return r.fh.Name()
+}
+
+func (r *SeekableReader) load(i int) error {
+	if r.frame == i {
+		return nil
+	}
+
+	f := r.frames[i]
+	compressed := make([]byte, f.compressedSize)
+	_, err := r.fh.ReadAt(compressed, f.compressedOffset)
+	if err != nil {
+		return errors.Wrapf(err, "read frame %d failed", i)
+	}
+
+	r.buf, err = r.decoder.DecodeAll(compressed, r.buf[:0])
+	if err != nil {
+		r.frame = -1
+		return errors.Wrapf(err, "decompress frame %d failed", i)
+	}
+	if len(r.buf) != int(f.decompressedSize) {
+		r.frame = -1
+		return errors.Newf("frame %d has %d bytes, %d expected", i, len(r.buf), f.decompressedSize)
+	}
+	r.frame = i
+	return nil
+}
+
+// frameEnd returns the end of the frame containing the offset
+func (r *SeekableReader) frameEnd(off int64) int64 {
+	i := sort.Search(len(r.frames), func(i int) bool {
+		return r.frames[i].decompressedOffset+int64(r.frames[i].decompressedSize) > off
+	})
+	if i == len(r.frames) {
+		return r.Size()
+	}
+	return r.frames[i].decompressedOffset + int64(r.frames[i].decompressedSize)
+}
+
+func (r *SeekableReader) ReadAt(p []byte, off int64) (int, error) {
+	if off < 0 {
+		return 0, errors.Newf("negative offset %d", off)
+	}
+
+	n := 0
+	for n < len(p) {
+		pos := off + int64(n)
+		i := sort.Search(len(r.frames), func(i int) bool {
+			return r.frames[i].decompressedOffset+int64(r.frames[i].decompressedSize) > pos
+		})
+		if i == len(r.frames) {
+			return n, io.EOF
+		}
+
+		err := r.load(i)
+		if err != nil {
+			return n, err
+		}
+		n += copy(p[n:], r.buf[pos-r.frames[i].decompressedOffset:])
+	}
+	return n, nil
+}
+
+func (r *SeekableReader) Read(p []byte) (int, error) {
+	n, err := r.ReadAt(p, r.offset)
+	r.offset += int64(n)
+	if err == io.EOF && n > 0 {
+		err = nil
+	}
+	return n, err
+}
+
+func (r *SeekableReader) Seek(offset int64, whence int) (int64, error) {
+	switch whence {
+	case io.SeekStart:
+	case io.SeekCurrent:
+		offset += r.offset
+	case io.SeekEnd:
+		offset += r.Size()
+	default:
+		return 0, errors.Newf("invalid whence %d", whence)
+	}
+	if offset < 0 {
+		return 0, errors.Newf("negative offset %d", offset)
+	}
+	r.offset = offset
+	return offset, nil
+}
+
+// Close releases the decoder, the file is closed by the caller
+func (r *SeekableReader) Close() error {
+	r.decoder.Close()
+	return nil
+}
@@ -0,0 +1,280 @@
+package storage
+
+import (
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/rs/zerolog/log"
+	"github.com/spf13/afero"
+
+	"github.com/zperf/tcpmon/tcpmon/gproto"
+)
+
+// reclaims kept in the history
+const reclaimHistorySize = 16
+
+// StorageStats is the usage of a data dir. Sizes are on disk, raw sizes are the uncompressed records.
+type StorageStats struct {
+	BaseDir          string                 `json:"baseDir"`
+	MaxSize          int64                  `json:"maxSize,omitempty"` // 0 for offline dirs
+	Size             int64                  `json:"size"`
+	RawSize          int64                  `json:"rawSize"`
+	CompressionRatio float64                `json:"compressionRatio"` // raw size / size of sealed files
+	Files            int                    `json:"files"`
+	SealedFiles      int                    `json:"sealedFiles"`
+	UnsealedFiles    int                    `json:"unsealedFiles"`
+	PinnedFiles      int                    `json:"pinnedFiles"`
+	Oldest           time.Time              `json:"oldest"`
+	Newest           time.Time              `json:"newest"`
+	Streams          map[string]*UsageStats `json:"streams"` // the default stream is named "default"
+	Types            map[string]*UsageStats `json:"types"`   // sizes are estimated by the raw size of each type
+	FileList         []FileStats            `json:"fileList"`
+
+	Reclaims []ReclaimStats `json:"reclaims,omitempty"`
+	Write    *WriteStats    `json:"write,omitempty"`
+}
+
+// UsageStats is the usage of a stream or a metric type
+type UsageStats struct {
+	Files   int   `json:"files,omitempty"`
+	Records int   `json:"records"`
+	Size    int64 `json:"size"`
+	RawSize int64 `json:"rawSize"`
+}
+
+// FileStats is the usage of a data file
+type FileStats struct {
+	Path         string         `json:"path"`
+	Stream       string         `json:"stream"`
+	Size         int64          `json:"size"`
+	RawSize      int64          `json:"rawSize"` // 0 if unknown, e.g. sealed by an old version
+	Sealed       bool           `json:"sealed"`
+	Pinned       bool           `json:"pinned"`
+	Records      int            `json:"records"`
+	Types        map[string]int `json:"types"` // records by metric type
+	MinTimestamp int64          `json:"minTimestamp,omitempty"`
+	MaxTimestamp int64          `json:"maxTimestamp,omitempty"`
+}
+
+// ReclaimStats is a run of reclaim
+type ReclaimStats struct {
+	Time     time.Time     `json:"time"`
+	Duration time.Duration `json:"duration"`
+	Files    int           `json:"files"` // data files before reclaim
+	Deleted  int           `json:"deleted"`
+	Freed    int64         `json:"freed"`
+}
+
+func typeName(t gproto.MetricType) string {
+	return strings.ToLower(t.String())
+}
+
+// DirStats returns the usage of the data dir and its pinned files by their indexes, files without index are scanned
+func DirStats(fs afero.Fs, dir string) (*StorageStats, error) {
+	if fs == nil {
+		fs = afero.NewOsFs()
+	}
+
+	files, err := DataFiles(fs, dir)
+	if err != nil {
+		return nil, err
+	}
+	return dirStats(fs, dir, files, nil), nil
+}
+
+// dirStats returns the usage of the data files, indexes are used for the files in it instead of loading or building
+// them
+func dirStats(fs afero.Fs, dir string, files []string, indexes map[string]*gproto.FileIndex) *StorageStats {
+	stats := &StorageStats{
+		BaseDir:  dir,
+		Streams:  make(map[string]*UsageStats),
+		Types:    make(map[string]*UsageStats),
+		FileList: make([]FileStats, 0, len(files)),
+	}
+	sealedSize := int64(0)
+	sealedRawSize := int64(0)
+	for _, f := range files {
+		fileStats, index, err := fileStats(fs, f, indexes[f])
+		if err != nil {
+			log.Warn().Err(err).Str("file", f).Msg("Stat data file failed")
+			continue
+		}
+		stats.FileList = append(stats.FileList, *fileStats)
+
+		stats.Files++
+		stats.Size += fileStats.Size
+		stats.RawSize += fileStats.RawSize
+		if fileStats.Sealed {
+			stats.SealedFiles++
+			if fileStats.RawSize != 0 {
+				sealedSize += fileStats.Size
+				sealedRawSize += fileStats.RawSize
+			}
+		} else {
+			stats.UnsealedFiles++
+		}
+		if fileStats.Pinned {
+			stats.PinnedFiles++
+		}
+
+		streamName := fileStats.Stream
+		if streamName == StreamDefault {
+			streamName = "default"
+		}
+		stream := stats.Streams[streamName]
+		if stream == nil {
+			stream = &UsageStats{}
+			stats.Streams[streamName] = stream
+		}
+		stream.Files++
+		stream.Records += fileStats.Records
+		stream.Size += fileStats.Size
+		stream.RawSize += fileStats.RawSize
+
+		if from, to, ok := MetricRange(index); ok {
+			oldest := time.Unix(from, 0)
+			newest := time.Unix(to, 0)
+			if stats.Oldest.IsZero() || oldest.Before(stats.Oldest) {
+				stats.Oldest = oldest
+			}
+			if newest.After(stats.Newest) {
+				stats.Newest = newest
+			}
+		}
+		addTypeStats(stats.Types, fileStats, index)
+	}
+
+	if sealedSize != 0 {
+		stats.CompressionRatio = float64(sealedRawSize) / float64(sealedSize)
+	}
+	return stats
+}
+
+// fileStats returns the usage of the data file and its index, the index is loaded or built if it's nil
+func fileStats(fs afero.Fs, dataFile string, index *gproto.FileIndex) (*FileStats, *gproto.FileIndex, error) {
+	stat, err := fs.Stat(dataFile)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "stat failed")
+	}
+
+	name := filepath.Base(dataFile)
+	s := &FileStats{
+		Path:    dataFile,
+		Stream:  StreamOfFile(name),
+		Size:    stat.Size(),
+		Sealed:  strings.HasSuffix(name, SealFileSuffix),
+		Pinned:  filepath.Base(filepath.Dir(dataFile)) == PinDir,
+		Types:   make(map[string]int),
+		RawSize: stat.Size(),
+	}
+
+	if s.Sealed {
+		s.RawSize = 0
+		fh, err := fs.Open(dataFile)
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "open file failed")
+		}
+		z, err := NewSeekableReader(fh)
+		if err == nil {
+			s.RawSize = z.Size()
+			_ = z.Close()
+		}
+		_ = fh.Close()
+	}
+
+	if index == nil {
+		index, err = LoadIndex(fs, dataFile)
+		if errors.Is(err, ErrIndexNotFound) {
+			index, err = BuildIndex(fs, dataFile)
+		}
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	s.Records = int(index.GetCount())
+	for _, c := range index.GetTypeCounts() {
+		s.Types[typeName(c.GetType())] = int(c.GetCount())
+	}
+	if from, to, ok := MetricRange(index); ok {
+		s.MinTimestamp = from
+		s.MaxTimestamp = to
+	}
+	return s, index, nil
+}
+
+// addTypeStats adds the records and the raw size of each metric type in the file. The size on disk is estimated by
+// the share of the raw size.
+func addTypeStats(types map[string]*UsageStats, s *FileStats, index *gproto.FileIndex) {
+	offsets := index.GetOffsets()
+	for i, t := range index.GetTypes() {
+		end := uint64(s.RawSize)
+		if i+1 < len(offsets) {
+			end = offsets[i+1]
+		}
+		if end < offsets[i] {
+			// the raw size is unknown
+			end = offsets[i]
+		}
+
+		name := typeName(t)
+		usage := types[name]
+		if usage == nil {
+			usage = &UsageStats{}
+			types[name] = usage
+		}
+		usage.Records++
+		raw := int64(end - offsets[i])
+		usage.RawSize += raw
+		if s.RawSize != 0 {
+			usage.Size += raw * s.Size / s.RawSize
+		}
+	}
+}
+
+// Stats returns the usage of the data dir with the retention, the reclaim history and the write statistics. Files
+// are scanned without blocking writes.
+func (ds *DataStore) Stats() (*StorageStats, error) {
+	files, active, stats, err := ds.statsSnapshot()
+	if err != nil {
+		return nil, err
+	}
+
+	r := dirStats(ds.fs, ds.baseDir, files, active)
+	r.MaxSize = ds.config.MaxSize
+	r.Reclaims = stats.Reclaims
+	r.Write = stats.Write
+	return r, nil
+}
+
+// statsSnapshot returns the data files, the indexes of the active files, the reclaim history and the write
+// statistics
+func (ds *DataStore) statsSnapshot() ([]string, map[string]*gproto.FileIndex, *StorageStats, error) {
+	ds.mutex.Lock()
+	defer ds.mutex.Unlock()
+
+	files, err := DataFiles(ds.fs, ds.baseDir)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	// the indexes of active files are in memory, they are not rebuilt by scanning the files
+	active := make(map[string]*gproto.FileIndex)
+	for _, st := range ds.streams {
+		if st.writerFilePath != "" {
+			active[st.writerFilePath] = st.index.Index()
+		}
+	}
+	write := ds.stats
+	stats := &StorageStats{Reclaims: append([]ReclaimStats{}, ds.reclaims...), Write: &write}
+	return files, active, stats, nil
+}
+
+// recordReclaim adds the run of reclaim to the history
+func (ds *DataStore) recordReclaim(r ReclaimStats) {
+	ds.reclaims = append(ds.reclaims, r)
+	if len(ds.reclaims) > reclaimHistorySize {
+		ds.reclaims = ds.reclaims[len(ds.reclaims)-reclaimHistorySize:]
+	}
+}
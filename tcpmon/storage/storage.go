@@ -1,21 +1,21 @@
 package storage
 
 import (
-	"encoding/binary"
-	"fmt"
-	"io"
+	"bytes"
 	"os"
 	"path/filepath"
 	"sort"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/cockroachdb/errors"
-	"github.com/klauspost/compress/zstd"
 	"github.com/rs/zerolog/log"
 	"github.com/samber/lo"
 	"github.com/sasha-s/go-deadlock"
 	"github.com/spf13/afero"
+
+	"github.com/zperf/tcpmon/tcpmon/gproto"
 )
 
 const DataFilePrefix = "tcpmon-dataf-"
@@ -23,15 +23,30 @@ const SealFileSuffix = ".zst"
 const Version = uint16(0xadde)
 const HeaderSize = 6
 
+// VersionChecksum records carry the CRC32C of the body in the header
+const VersionChecksum = uint16(0xaddf)
+const ChecksumHeaderSize = 10
+
 type DataStore struct {
 	baseDir string   // database base directory
 	fs      afero.Fs // filesystem interface
 	config  Config
 
-	lastFileNum    uint32     // last file num
-	writerFile     afero.File // current file
-	writerFilePath string     // current file path
-	writerCapacity uint32     // current file capacity
+	lastFileNum uint32             // last file num, shared by all streams
+	streams     map[string]*stream // opened streams
+
+	pins      []Pin                  // time ranges protected from reclaim
+	dicts     map[string]*streamDict // zstd dictionaries by stream
+	dictCache *DictCache             // dictionaries loaded to read sealed files
+	fileDicts map[string]uint32      // dictionary ids of sealed files, 0 if compressed without one
+
+	// headRecord is written at the beginning of every data file, e.g. the host info
+	headRecord []byte
+
+	lastFlush time.Time
+	lastSync  time.Time
+	stats     WriteStats
+	reclaims  []ReclaimStats // history of reclaim
 
 	// mutex Multiple goroutines may access this datastore
 	// e.g. HTTP server, monitor write
@@ -65,15 +80,37 @@ func NewDataStore(config *Config) (*DataStore, error) {
 		fs:      config.Fs,
 		config:  *config,
 
-		lastFileNum:    0,
-		writerFile:     nil,
-		writerFilePath: "",
-		writerCapacity: 0,
+		lastFileNum: 0,
+		streams:     make(map[string]*stream),
+		dicts:       make(map[string]*streamDict),
+		dictCache:   NewDictCache(),
+		fileDicts:   make(map[string]uint32),
+		lastFlush:   time.Now(),
+		lastSync:    time.Now(),
 	}
 
 	ensureDir(s.baseDir, s.fs)
+	if config.Keyring != nil {
+		s.fs = NewDecryptFs(s.fs, config.Keyring)
+	}
+
+	err := s.loadDicts()
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.recover()
+	if err != nil {
+		return nil, errors.Wrap(err, "recover data files failed")
+	}
+
+	err = s.loadPins()
+	if err != nil {
+		return nil, err
+	}
 
-	err := s.doNextFile()
+	// other streams are opened on the first record
+	_, err = s.stream(StreamDefault)
 	if err != nil {
 		return nil, errors.Wrap(err, "go to next file failed")
 	}
@@ -88,19 +125,11 @@ func (ds *DataStore) Close() error {
 }
 
 func (ds *DataStore) doClose() error {
-	if ds.writerFile != nil {
-		err := ds.writerFile.Sync()
-		if err != nil {
-			return errors.Wrap(err, "file sync failed")
-		}
-
-		err = ds.writerFile.Close()
+	for _, st := range ds.sortedStreams() {
+		err := st.close()
 		if err != nil {
-			return errors.Wrap(err, "file close failed")
+			return err
 		}
-
-		ds.writerCapacity = 0
-		ds.writerFilePath = ""
 	}
 	return nil
 }
@@ -109,33 +138,102 @@ func (ds *DataStore) BaseDir() string {
 	return ds.baseDir
 }
 
+// NewReader opens a reader of the data dir and the pinned files, sealed files are decrypted with the keyring
+func (ds *DataStore) NewReader() (*Reader, error) {
+	return NewDataStoreReader(NewReaderConfig(ds.baseDir).WithFs(ds.fs).withDicts(ds.dictCache))
+}
+
+// Keyring returns the keyring encrypting sealed files, nil if encryption is disabled
+func (ds *DataStore) Keyring() *Keyring {
+	return ds.config.Keyring
+}
+
 func (ds *DataStore) Put(value []byte) error {
 	ds.mutex.Lock()
 	defer ds.mutex.Unlock()
+	return ds.writeError(ds.put(streamOf(value), value))
+}
 
-	var err error
-	header := ds.newHeader(len(value))
+// put writes the value to the stream
+func (ds *DataStore) put(name string, value []byte) error {
+	st, err := ds.stream(name)
+	if err != nil {
+		return err
+	}
 
-	_, err = ds.writerFile.Write(header)
+	value, err = st.encoder.Encode(value)
 	if err != nil {
-		return errors.Wrap(err, "write header failed")
+		return errors.Wrap(err, "encode failed")
+	}
+	if len(value) > MaxRecordSize {
+		return errors.Newf("%d bytes record exceeds the max record size", len(value))
 	}
 
-	_, err = ds.writerFile.Write(value)
+	offset := st.writerOffset
+	err = st.writeRecord(value)
 	if err != nil {
-		return errors.Wrap(err, "write failed")
+		return err
 	}
 
-	ds.writerCapacity++
+	st.writerCapacity++
+	ds.stats.Records++
+	ds.stats.Bytes += uint64(st.writerOffset - offset)
+	ds.stats.PendingRecords++
 
-	if ds.writerCapacity >= ds.config.MaxEntriesPerFile {
-		err = ds.doNextFile()
+	if st.writerCapacity >= ds.config.MaxEntriesPerFile {
+		err = ds.nextFile(st)
 		if err != nil {
 			return errors.Wrap(err, "rotate file failed")
 		}
 	}
 
-	return nil
+	return ds.maybeFlush(time.Now())
+}
+
+// SetHeadRecord writes the value to the current file of each stream, and to the beginning of every new data file
+// afterward. Head records are not counted in MaxEntriesPerFile. If it failed, calling it again writes the value to
+// the streams that don't have it yet.
+func (ds *DataStore) SetHeadRecord(value []byte) error {
+	ds.mutex.Lock()
+	defer ds.mutex.Unlock()
+
+	ds.headRecord = value
+	for _, st := range ds.sortedStreams() {
+		if bytes.Equal(st.head, value) {
+			continue
+		}
+		err := st.writeRecord(value)
+		if err != nil {
+			return err
+		}
+		st.head = value
+	}
+	return ds.maybeFlush(time.Now())
+}
+
+// stream returns the stream, a new file is created if the stream isn't opened
+func (ds *DataStore) stream(name string) (*stream, error) {
+	st, ok := ds.streams[name]
+	if ok {
+		return st, nil
+	}
+
+	st = newStream(name, ds.config.KeyframeInterval)
+	ds.streams[name] = st
+	err := ds.nextFile(st)
+	if err != nil {
+		delete(ds.streams, name)
+		return nil, err
+	}
+	return st, nil
+}
+
+func (ds *DataStore) sortedStreams() []*stream {
+	streams := lo.Values(ds.streams)
+	sort.Slice(streams, func(i, j int) bool {
+		return streams[i].name < streams[j].name
+	})
+	return streams
 }
 
 func getFileNo(fileName string) uint32 {
@@ -166,6 +264,18 @@ func (ds *DataStore) GetLatestFileNo() uint32 {
 		log.Fatal().Err(err).Msg("List files in base dir failed")
 	}
 
+	// pinned files are moved back once unpinned, their numbers are not reused
+	pinned, err := ds.listPinnedFiles()
+	if err != nil {
+		log.Fatal().Err(err).Msg("List pinned files failed")
+	}
+	files = append(files, lo.Map(pinned, func(f dataFileInfo, i int) string {
+		return filepath.Base(f.path)
+	})...)
+
+	files = lo.Filter(files, func(f string, i int) bool {
+		return strings.HasPrefix(f, DataFilePrefix) || strings.HasPrefix(f, IndexFilePrefix)
+	})
 	if len(files) == 0 {
 		return 0
 	}
@@ -182,6 +292,8 @@ func (ds *DataStore) GetLatestFileNo() uint32 {
 	return getFileNo(lastFile)
 }
 
+// TotalSize returns the size of the data files in the base dir, with their indexes, columnar segments and the
+// dictionaries, as counted against MaxSize
 func (ds *DataStore) TotalSize() (int64, error) {
 	baseDir, err := ds.fs.Open(ds.baseDir)
 	if err != nil {
@@ -194,7 +306,8 @@ func (ds *DataStore) TotalSize() (int64, error) {
 	}
 
 	files = lo.Filter(files, func(f os.FileInfo, index int) bool {
-		return strings.HasPrefix(f.Name(), DataFilePrefix)
+		return strings.HasPrefix(f.Name(), DataFilePrefix) || strings.HasPrefix(f.Name(), IndexFilePrefix) ||
+			strings.HasPrefix(f.Name(), SegmentFilePrefix) || strings.HasPrefix(f.Name(), DictPrefix)
 	})
 
 	return lo.SumBy(files, func(file os.FileInfo) int64 {
@@ -202,24 +315,31 @@ func (ds *DataStore) TotalSize() (int64, error) {
 	}), nil
 }
 
+// NextFile seals the current file of each stream and creates new ones
 func (ds *DataStore) NextFile() error {
 	ds.mutex.Lock()
 	defer ds.mutex.Unlock()
 
-	return ds.doNextFile()
+	for _, st := range ds.sortedStreams() {
+		err := ds.nextFile(st)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
-func (ds *DataStore) doNextFile() error {
+func (ds *DataStore) nextFile(st *stream) error {
 	if ds.lastFileNum == 0 {
 		ds.lastFileNum = ds.GetLatestFileNo() + 1
 	}
 
-	err := ds.seal()
+	err := ds.seal(st)
 	if err != nil {
 		return errors.Wrap(err, "close current file failed")
 	}
 
-	nextFilePath := filepath.Join(ds.baseDir, fmt.Sprintf("%s%d", DataFilePrefix, ds.lastFileNum))
+	nextFilePath := filepath.Join(ds.baseDir, DataFileName(st.name, ds.lastFileNum))
 	fh, err := ds.fs.Create(nextFilePath)
 	if err != nil {
 		log.Fatal().Err(err).Msg("Create new file failed")
@@ -227,30 +347,49 @@ func (ds *DataStore) doNextFile() error {
 	ds.lastFileNum++
 	log.Info().Str("nextFilePath", nextFilePath).Msg("Next file created")
 
-	ds.writerFile = fh
-	ds.writerFilePath = nextFilePath
-	ds.writerCapacity = 0
+	st.reset(fh, nextFilePath)
 
+	if ds.headRecord != nil {
+		err = st.writeRecord(ds.headRecord)
+		if err != nil {
+			return errors.Wrap(err, "write head record failed")
+		}
+		st.head = ds.headRecord
+	}
+
+	// retention runs once per rotation, after the sealed file is in place
 	ds.reclaim()
 
 	return nil
 }
 
-func (ds *DataStore) seal() error {
-	lastFileName := ds.writerFilePath
+func (ds *DataStore) seal(st *stream) error {
+	lastFileName := st.writerFilePath
 
-	err := ds.doClose()
+	err := st.close()
 	if err != nil {
 		return err
 	}
 
 	if lastFileName != "" {
+		ds.writeIndex(lastFileName, st.index.Index())
+		start := time.Now()
+		if ds.config.Columnar && st.name == StreamTcp {
+			err = WriteSegment(ds.fs, lastFileName, ds.config.CompressionLevel)
+			if err != nil {
+				log.Warn().Err(err).Str("file", lastFileName).Msg("Write segment failed")
+			}
+		}
+
+		compressStart := time.Now()
 		err = ds.compressFile(lastFileName, lastFileName+SealFileSuffix)
+		ds.stats.TotalCompression += time.Since(compressStart)
 		if err != nil {
+			_ = ds.writeError(err)
 			log.Warn().Err(err).Str("file", lastFileName).Msg("seal failed")
 		}
-
-		ds.reclaim()
+		ds.stats.Seals++
+		ds.stats.TotalSealLatency += time.Since(start)
 	}
 
 	return nil
@@ -282,75 +421,22 @@ func (ds *DataStore) getDataFiles(suffix string) ([]string, error) {
 	}), nil
 }
 
-func (ds *DataStore) reclaim() {
-	size, err := ds.TotalSize()
-	if err != nil {
-		log.Warn().Err(err).Msg("Retrieve total size failed")
-	}
-
-	if size > ds.config.MaxSize {
-		log.Info().Int64("size", size).
-			Int64("maxSize", ds.config.MaxSize).Msg("Reclaiming...")
-
-		// compress all data files
-		dataFiles, err := ds.getDataFiles("")
-		if err != nil {
-			log.Fatal().Err(err).Msg("List data files failed")
-		}
-		rawFiles := lo.Filter(dataFiles, func(f string, i int) bool {
-			return !strings.HasSuffix(f, SealFileSuffix)
-		})
-
-		for _, f := range rawFiles {
-			if f == ds.writerFilePath {
-				continue
-			}
-
-			err := ds.compressFile(f, f+SealFileSuffix)
-			if err != nil {
-				log.Warn().Err(err).Msg("Compress all failed")
-			}
-		}
-
-		files, err := ds.getDataFiles(SealFileSuffix)
+// writeIndex writes the sidecar index of the data file, the index is built by scanning the file if it's nil.
+// Readers build the index if it's missing, so failures are not fatal.
+func (ds *DataStore) writeIndex(dataFile string, index *gproto.FileIndex) {
+	var err error
+	if index == nil {
+		index, err = BuildIndex(ds.fs, dataFile)
 		if err != nil {
-			log.Fatal().Err(err).Msg("Get files failed")
-		}
-
-		sort.Slice(files, func(i, j int) bool {
-			return getFileNo(files[i]) < getFileNo(files[j])
-		})
-
-		fileSizes := lo.Map(files, func(f string, i int) int64 {
-			info, err := os.Stat(f)
-			if err != nil {
-				log.Fatal().Err(err).Str("file", f).Msg("stat failed")
-			}
-			return info.Size()
-		})
-
-		i := 0
-		for i < len(fileSizes) && size > ds.config.MaxSize {
-			size -= fileSizes[i]
-			i++
-		}
-
-		toDelete := files[0:i]
-		for _, file := range toDelete {
-			err = ds.fs.Remove(file)
-			if err != nil {
-				log.Warn().Err(err).Str("file", file).Msg("Delete failed")
-			}
-			log.Info().Str("file", file).Msg("Deleted")
+			log.Warn().Err(err).Str("file", dataFile).Msg("Build index failed")
+			return
 		}
 	}
-}
 
-func (ds *DataStore) newHeader(size int) []byte {
-	buf := make([]byte, HeaderSize)
-	binary.LittleEndian.PutUint16(buf[0:2], Version)      // version
-	binary.LittleEndian.PutUint32(buf[2:6], uint32(size)) // body size
-	return buf
+	err = WriteIndex(ds.fs, dataFile, index)
+	if err != nil {
+		log.Warn().Err(err).Str("file", dataFile).Msg("Write index failed")
+	}
 }
 
 func (ds *DataStore) compressFile(src string, dst string) error {
@@ -358,17 +444,32 @@ func (ds *DataStore) compressFile(src string, dst string) error {
 	if err != nil {
 		return errors.Wrap(err, "open input file failed")
 	}
+	stat, err := in.Stat()
+	if err != nil {
+		return errors.Wrap(err, "stat input file failed")
+	}
 
-	out, err := ds.fs.OpenFile(dst, os.O_CREATE|os.O_WRONLY, 0644)
+	out, err := ds.fs.OpenFile(dst, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0644)
 	if err != nil {
 		return errors.Wrap(err, "open output file failed")
 	}
 
-	err = ds.compress(in, out)
+	w, err := EncryptTo(ds.config.Keyring, out)
+	if err != nil {
+		return errors.Wrap(err, "encrypt file failed")
+	}
+
+	d := ds.dict(src)
+	err = writeSeekable(in, w, ds.config.CompressionLevel, ds.config.FrameSize, d)
 	if err != nil {
 		return errors.Wrap(err, "compress file failed")
 	}
 
+	err = w.Close()
+	if err != nil {
+		return errors.Wrap(err, "encrypt file failed")
+	}
+
 	err = out.Close()
 	if err != nil {
 		return errors.Wrap(err, "close output file failed")
@@ -384,23 +485,38 @@ func (ds *DataStore) compressFile(src string, dst string) error {
 		return errors.Wrap(err, "delete input file failed")
 	}
 
+	ds.fileDicts[dst] = 0
+	if d != nil {
+		ds.fileDicts[dst], _ = dictID(d)
+		compressed, err := ds.fs.Stat(dst)
+		if err == nil {
+			ds.checkDictRatio(src, stat.Size(), compressed.Size())
+		}
+	}
+
 	log.Info().Str("dst", dst).Msg("Compressed")
 	return nil
 }
 
-func (ds *DataStore) compress(reader io.Reader, writer io.Writer) error {
-	z, err := zstd.NewWriter(writer)
+// writeFile writes the file, encrypted if the keyring is set
+func (ds *DataStore) writeFile(path string, data []byte) error {
+	out, err := ds.fs.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0644)
 	if err != nil {
-		return errors.Wrap(err, "create new zstd writer failed")
+		return errors.Wrap(err, "open file failed")
 	}
-	defer z.Close()
 
-	_, err = io.Copy(z, reader)
+	w, err := EncryptTo(ds.config.Keyring, out)
+	if err == nil {
+		_, err = w.Write(data)
+	}
+	if err == nil {
+		err = w.Close()
+	}
 	if err != nil {
-		return errors.Wrap(err, "copy to writer failed")
+		_ = out.Close()
+		return errors.Wrap(err, "write file failed")
 	}
-
-	return nil
+	return errors.Wrap(out.Close(), "close file failed")
 }
 
 type MetricContext struct {
@@ -0,0 +1,190 @@
+package storage
+
+import (
+	"bufio"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/spf13/afero"
+
+	"github.com/zperf/tcpmon/tcpmon/gproto"
+)
+
+// Streams split records by metric type. Each stream has its own data files, rotation and retention budget, so
+// high-volume socket tables don't evict the history of other metrics.
+const (
+	StreamDefault = "" // host info and records other than metrics
+	StreamTcp     = "tcp"
+	StreamNic     = "nic"
+	StreamNet     = "net"
+	StreamEvent   = "event"
+)
+
+const writeBufferSize = 64 << 10
+
+const rollupStreamPrefix = "rollup-"
+
+var Streams = []string{StreamDefault, StreamTcp, StreamNic, StreamNet, StreamEvent}
+
+var metricStreams = map[gproto.MetricType]string{
+	gproto.MetricType_TCP:       StreamTcp,
+	gproto.MetricType_TCP_DELTA: StreamTcp,
+	gproto.MetricType_NIC:       StreamNic,
+	gproto.MetricType_NET:       StreamNet,
+	gproto.MetricType_EVENT:     StreamEvent,
+}
+
+// streamOf returns the stream of the record
+func streamOf(value []byte) string {
+	typ, _, ok := peekMetric(value)
+	if !ok {
+		return StreamDefault
+	}
+	return metricStreams[typ]
+}
+
+// RollupStream returns the stream of rollups of the resolution, e.g. rollup-1m
+func RollupStream(resolution time.Duration) string {
+	switch {
+	case resolution%time.Hour == 0:
+		return fmt.Sprintf("%s%dh", rollupStreamPrefix, resolution/time.Hour)
+	case resolution%time.Minute == 0:
+		return fmt.Sprintf("%s%dm", rollupStreamPrefix, resolution/time.Minute)
+	default:
+		return fmt.Sprintf("%s%ds", rollupStreamPrefix, resolution/time.Second)
+	}
+}
+
+// IsRollupStream returns true if the stream stores rollups
+func IsRollupStream(stream string) bool {
+	return strings.HasPrefix(stream, rollupStreamPrefix)
+}
+
+// DataFileName returns the name of the Nth data file, e.g. tcpmon-dataf-tcp-12. Files of the default stream are
+// named without the stream, e.g. tcpmon-dataf-12.
+func DataFileName(stream string, n uint32) string {
+	if stream == StreamDefault {
+		return fmt.Sprintf("%s%d", DataFilePrefix, n)
+	}
+	return fmt.Sprintf("%s%s-%d", DataFilePrefix, stream, n)
+}
+
+// StreamOfFile returns the stream of the data file
+func StreamOfFile(fileName string) string {
+	name := strings.TrimSuffix(fileName, SealFileSuffix)
+	name = strings.TrimPrefix(name, DataFilePrefix)
+	p := strings.LastIndex(name, "-")
+	if p == -1 {
+		return StreamDefault
+	}
+	return name[:p]
+}
+
+// stream is the writer state of a stream
+type stream struct {
+	name string
+
+	writerFile     afero.File // current file
+	writer         *bufio.Writer
+	writerFilePath string // current file path
+	writerCapacity uint32 // current file capacity
+	writerOffset   int64  // size of the current file
+
+	encoder *DeltaEncoder
+	index   *IndexBuilder // index of the current file
+	head    []byte        // head record written to the current file, nil if there is none
+}
+
+func newStream(name string, keyframeInterval uint32) *stream {
+	return &stream{
+		name:    name,
+		encoder: NewDeltaEncoder(keyframeInterval),
+		index:   NewIndexBuilder(),
+	}
+}
+
+// reset starts writing the new file
+func (st *stream) reset(fh afero.File, filePath string) {
+	st.writerFile = fh
+	st.writer = bufio.NewWriterSize(fh, writeBufferSize)
+	st.writerFilePath = filePath
+	st.writerCapacity = 0
+	st.writerOffset = 0
+	st.encoder.Reset()
+	st.index = NewIndexBuilder()
+	st.head = nil
+}
+
+func (st *stream) writeRecord(value []byte) error {
+	if st.writerFile == nil {
+		return errors.Newf("stream %q is closed", st.name)
+	}
+
+	header := encodeRecord(value)
+
+	_, err := st.writer.Write(header)
+	if err != nil {
+		return errors.Wrap(err, "write header failed")
+	}
+
+	_, err = st.writer.Write(value)
+	if err != nil {
+		return errors.Wrap(err, "write failed")
+	}
+
+	st.index.Add(st.writerOffset, value)
+	st.writerOffset += int64(len(header) + len(value))
+	return nil
+}
+
+// flush writes the buffered records to the file
+func (st *stream) flush() error {
+	if st.writerFile == nil {
+		return nil
+	}
+
+	err := st.writer.Flush()
+	if err != nil {
+		return errors.Wrap(err, "flush failed")
+	}
+	return nil
+}
+
+func (st *stream) sync() error {
+	if st.writerFile == nil {
+		return nil
+	}
+
+	err := st.writerFile.Sync()
+	if err != nil {
+		return errors.Wrap(err, "file sync failed")
+	}
+	return nil
+}
+
+func (st *stream) close() error {
+	if st.writerFile != nil {
+		err := st.flush()
+		if err != nil {
+			return err
+		}
+
+		err = st.writerFile.Sync()
+		if err != nil {
+			return errors.Wrap(err, "file sync failed")
+		}
+
+		err = st.writerFile.Close()
+		if err != nil {
+			return errors.Wrap(err, "file close failed")
+		}
+
+		st.writerFile = nil
+		st.writer = nil
+		st.writerCapacity = 0
+		st.writerFilePath = ""
+	}
+	return nil
+}
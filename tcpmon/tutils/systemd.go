@@ -0,0 +1,53 @@
+package tutils
+
+import (
+	"net"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/cockroachdb/errors"
+)
+
+// SdNotify sends the state to systemd, e.g. READY=1. Returns false if tcpmon isn't started by a systemd service of
+// Type=notify.
+func SdNotify(state string) (bool, error) {
+	socket := os.Getenv("NOTIFY_SOCKET")
+	if socket == "" {
+		return false, nil
+	}
+	// abstract socket
+	if socket[0] == '@' {
+		socket = "\x00" + socket[1:]
+	}
+
+	conn, err := net.DialUnix("unixgram", nil, &net.UnixAddr{Name: socket, Net: "unixgram"})
+	if err != nil {
+		return false, errors.Wrap(err, "connect to systemd failed")
+	}
+	defer conn.Close()
+
+	_, err = conn.Write([]byte(state))
+	if err != nil {
+		return false, errors.Wrap(err, "notify systemd failed")
+	}
+	return true, nil
+}
+
+// SdWatchdogInterval returns the interval systemd expects WATCHDOG=1 in, 0 if the watchdog is disabled
+func SdWatchdogInterval() (time.Duration, error) {
+	s := os.Getenv("WATCHDOG_USEC")
+	if s == "" {
+		return 0, nil
+	}
+	// the watchdog is for another process
+	if pid := os.Getenv("WATCHDOG_PID"); pid != "" && pid != strconv.Itoa(os.Getpid()) {
+		return 0, nil
+	}
+
+	usec, err := strconv.ParseInt(s, 10, 64)
+	if err != nil || usec <= 0 {
+		return 0, errors.Newf("invalid WATCHDOG_USEC %q", s)
+	}
+	return time.Duration(usec) * time.Microsecond, nil
+}
@@ -0,0 +1,4 @@
+package tutils
+
+// Version is the tcpmon version, overridden at build time by the Makefile
+var Version = "dev"
@@ -0,0 +1,108 @@
+package test
+
+import (
+	"bytes"
+	"io"
+	"path/filepath"
+	"time"
+
+	"github.com/klauspost/compress/zstd"
+	"github.com/spf13/afero"
+
+	"github.com/zperf/tcpmon/tcpmon/export/influxdb"
+	"github.com/zperf/tcpmon/tcpmon/storage"
+)
+
+// writeBackup writes NIC metrics and packages the sealed files as GetBackup does, returns the archive
+func (s *StorageV2TestSuite) writeBackup(n int) []byte {
+	ds, err := storage.NewDataStore(storage.NewConfig(s.baseDir).WithFs(s.fs).WithMaxEntriesPerFile(40))
+	s.Require().NoError(err)
+	s.Require().NoError(ds.SetHeadRecord(newHostInfo("node-1")))
+	for i := 0; i < n; i++ {
+		s.Require().NoError(ds.Put(marshalNic(rollupBase + int64(i))))
+	}
+	s.Require().NoError(ds.NextFile())
+	s.Require().NoError(ds.Close())
+
+	r, err := storage.NewDataStoreReader(storage.NewReaderConfig(s.baseDir).WithFs(s.fs).
+		WithSuffix(storage.SealFileSuffix))
+	s.Require().NoError(err)
+	defer r.Close()
+
+	var buf bytes.Buffer
+	s.Require().NoError(r.Package(&buf))
+	return buf.Bytes()
+}
+
+func (s *StorageV2TestSuite) TestArchive() {
+	archive := s.writeBackup(100)
+	s.Require().NoError(afero.WriteFile(s.fs, "/backup/node-1.tar", archive, 0644))
+
+	var compressed bytes.Buffer
+	encoder, err := zstd.NewWriter(&compressed)
+	s.Require().NoError(err)
+	_, err = encoder.Write(archive)
+	s.Require().NoError(err)
+	s.Require().NoError(encoder.Close())
+	s.Require().NoError(afero.WriteFile(s.fs, "/backup/node-1.tar.zst", compressed.Bytes(), 0644))
+
+	for _, path := range []string{"/backup/node-1.tar", "/backup/node-1.tar.zst"} {
+		s.Require().True(storage.IsArchive(path))
+
+		r, err := storage.NewDataStoreReader(storage.NewReaderConfig(path).WithFs(s.fs))
+		s.Require().NoError(err)
+		count, err := r.Count()
+		s.Require().NoError(err)
+		// host info at the head of each file
+		s.Require().Greater(count, 100)
+
+		metrics := 0
+		err = r.Range(time.Unix(rollupBase+10, 0), time.Unix(rollupBase+19, 0), func(buf []byte) error {
+			metrics++
+			return nil
+		})
+		s.Require().NoError(err)
+		s.Require().Equal(10, metrics)
+		r.Close()
+
+		// export reads the files in the archive as well
+		fs, err := storage.OpenArchive(path, s.fs)
+		s.Require().NoError(err)
+		files, err := afero.Glob(fs, filepath.Join(storage.ArchiveRoot, storage.DataFilePrefix+"nic-*"))
+		s.Require().NoError(err)
+		s.Require().NotEmpty(files)
+
+		// files are read from their sections of the archive, each with its own offset
+		s.Require().Greater(len(files), 1)
+		first, err := afero.ReadFile(fs, files[0])
+		s.Require().NoError(err)
+		f0, err := fs.Open(files[0])
+		s.Require().NoError(err)
+		f1, err := fs.Open(files[1])
+		s.Require().NoError(err)
+		head := make([]byte, 16)
+		_, err = f0.Read(head)
+		s.Require().NoError(err)
+		_, err = f1.Read(make([]byte, 16))
+		s.Require().NoError(err)
+		rest, err := io.ReadAll(f0)
+		s.Require().NoError(err)
+		s.Require().Equal(first, append(head, rest...))
+		s.Require().NoError(f0.Close())
+		s.Require().NoError(f1.Close())
+		s.Require().Error(afero.WriteFile(fs, files[0], nil, 0644))
+
+		exporter, err := influxdb.NewFastExporter(files[0], fs)
+		s.Require().NoError(err)
+		_, err = exporter.Scan()
+		s.Require().NoError(err)
+		var out bytes.Buffer
+		s.Require().NoError(exporter.Export(&out, &influxdb.ExportOptions{}))
+		s.Require().Contains(out.String(), "Hostname=node-1")
+		exporter.Close()
+	}
+
+	s.Require().NoError(afero.WriteFile(s.fs, "/backup/broken.tar", archive[:len(archive)/2], 0644))
+	_, err = storage.NewDataStoreReader(storage.NewReaderConfig("/backup/broken.tar").WithFs(s.fs))
+	s.Require().Error(err)
+}
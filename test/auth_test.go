@@ -0,0 +1,152 @@
+package test
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/spf13/afero"
+
+	"github.com/zperf/tcpmon/tcpmon/server"
+	"github.com/zperf/tcpmon/tcpmon/storage"
+)
+
+// newAuthServer returns the HTTP handler of a monitor with the config
+func (s *StorageV2TestSuite) newAuthServer(config server.MonitorConfig) (http.Handler, *server.Monitor) {
+	config.QuorumPort = -1
+	config.DataStoreConfig = *storage.NewConfig(s.baseDir).WithFs(s.fs)
+	config.EventBufferSize = 1
+	mon, err := server.New(config)
+	s.Require().NoError(err)
+
+	gin.SetMode(gin.TestMode)
+	engine := gin.New()
+	server.RegisterRoutes(engine, mon)
+	return engine, mon
+}
+
+func (s *StorageV2TestSuite) TestAuth() {
+	s.Require().NoError(afero.WriteFile(s.fs, "/etc/tokens", []byte("# tokens\nread r-token\nadmin a-token\n"), 0600))
+	auth := server.NewAuth()
+	s.Require().NoError(auth.LoadTokenFile(s.fs, "/etc/tokens"))
+	h, mon := s.newAuthServer(server.MonitorConfig{Auth: auth})
+	defer mon.Close()
+
+	for _, c := range []struct {
+		method, path, token string
+		code                int
+	}{
+		{http.MethodGet, "/storage", "", http.StatusUnauthorized},
+		{http.MethodGet, "/storage", "unknown", http.StatusUnauthorized},
+		{http.MethodGet, "/storage", "r-token", http.StatusOK},
+		{http.MethodGet, "/storage", "a-token", http.StatusOK},
+		{http.MethodGet, "/pins", "r-token", http.StatusOK},
+		{http.MethodPost, "/pins", "r-token", http.StatusForbidden},
+		{http.MethodPost, "/pins", "a-token", http.StatusBadRequest}, // no time range
+		{http.MethodDelete, "/pins/1", "r-token", http.StatusForbidden},
+		{http.MethodGet, "/debug/pprof/", "r-token", http.StatusForbidden},
+		{http.MethodGet, "/debug/pprof/", "a-token", http.StatusOK},
+	} {
+		req := httptest.NewRequest(c.method, c.path, nil)
+		if c.token != "" {
+			req.Header.Set("Authorization", "Bearer "+c.token)
+		}
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, req)
+		s.Equal(c.code, w.Code, "%s %s %s", c.method, c.path, c.token)
+		if c.code == http.StatusUnauthorized {
+			s.Contains(w.Header().Get("WWW-Authenticate"), "Bearer")
+		}
+	}
+
+	for _, content := range []string{"", "# no token\n", "write w-token\n", "admin\n", "read a b\n"} {
+		s.Require().NoError(afero.WriteFile(s.fs, "/etc/tokens", []byte(content), 0600))
+		s.Error(server.NewAuth().LoadTokenFile(s.fs, "/etc/tokens"), content)
+	}
+}
+
+// writeCert writes a certificate and its key signed by the parent, or a self-signed CA if parent is nil
+func (s *StorageV2TestSuite) writeCert(name string, parent *x509.Certificate, parentKey *ecdsa.PrivateKey,
+	usage x509.ExtKeyUsage) (*x509.Certificate, *ecdsa.PrivateKey) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	s.Require().NoError(err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      pkix.Name{CommonName: name},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:  []x509.ExtKeyUsage{usage},
+		IPAddresses:  []net.IP{net.ParseIP("127.0.0.1")},
+	}
+	if parent == nil {
+		template.IsCA = true
+		template.BasicConstraintsValid = true
+		parent, parentKey = template, key
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, parent, &key.PublicKey, parentKey)
+	s.Require().NoError(err)
+	cert, err := x509.ParseCertificate(der)
+	s.Require().NoError(err)
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	s.Require().NoError(err)
+
+	s.Require().NoError(afero.WriteFile(s.fs, "/etc/tls/"+name+".pem",
+		pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0644))
+	s.Require().NoError(afero.WriteFile(s.fs, "/etc/tls/"+name+"-key.pem",
+		pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}), 0600))
+	return cert, key
+}
+
+func (s *StorageV2TestSuite) TestTLS() {
+	ca, caKey := s.writeCert("ca", nil, nil, x509.ExtKeyUsageAny)
+	s.writeCert("server", ca, caKey, x509.ExtKeyUsageServerAuth)
+	s.writeCert("client", ca, caKey, x509.ExtKeyUsageClientAuth)
+
+	config, err := server.NewTLSConfig(s.fs, "/etc/tls/server.pem", "/etc/tls/server-key.pem", "/etc/tls/ca.pem")
+	s.Require().NoError(err)
+	h, mon := s.newAuthServer(server.MonitorConfig{TLSConfig: config})
+	defer mon.Close()
+	srv := httptest.NewUnstartedServer(h)
+	srv.TLS = config
+	srv.StartTLS()
+	defer srv.Close()
+
+	pool, err := server.LoadCertPool(s.fs, "/etc/tls/ca.pem")
+	s.Require().NoError(err)
+	get := func(clientConfig *tls.Config) (*http.Response, error) {
+		client := &http.Client{Transport: &http.Transport{TLSClientConfig: clientConfig}}
+		return client.Get(srv.URL + "/")
+	}
+
+	// clients without a certificate are rejected
+	_, err = get(&tls.Config{RootCAs: pool})
+	s.Require().Error(err)
+
+	certPEM, err := afero.ReadFile(s.fs, "/etc/tls/client.pem")
+	s.Require().NoError(err)
+	keyPEM, err := afero.ReadFile(s.fs, "/etc/tls/client-key.pem")
+	s.Require().NoError(err)
+	cert, err := tls.X509KeyPair(certPEM, keyPEM)
+	s.Require().NoError(err)
+	resp, err := get(&tls.Config{RootCAs: pool, Certificates: []tls.Certificate{cert}})
+	s.Require().NoError(err)
+	_ = resp.Body.Close()
+	s.Equal(http.StatusOK, resp.StatusCode)
+
+	config, err = server.NewTLSConfig(s.fs, "", "", "")
+	s.Require().NoError(err)
+	s.Nil(config)
+	_, err = server.NewTLSConfig(s.fs, "", "", "/etc/tls/ca.pem")
+	s.Error(err)
+}
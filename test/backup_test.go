@@ -0,0 +1,158 @@
+package test
+
+import (
+	"archive/tar"
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"time"
+
+	"github.com/klauspost/compress/zstd"
+	"github.com/spf13/afero"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/zperf/tcpmon/tcpmon/gproto"
+	"github.com/zperf/tcpmon/tcpmon/storage"
+)
+
+// readBackup checks the checksums of the files in the archive against the manifest at the end of it
+func (s *StorageV2TestSuite) readBackup(archive []byte) *storage.Manifest {
+	sums := make(map[string]string)
+	var manifest storage.Manifest
+	t := tar.NewReader(bytes.NewReader(archive))
+	for {
+		h, err := t.Next()
+		if err == io.EOF {
+			break
+		}
+		s.Require().NoError(err)
+		buf, err := io.ReadAll(t)
+		s.Require().NoError(err)
+		if h.Name == storage.ManifestFileName {
+			s.Require().NoError(json.Unmarshal(buf, &manifest))
+			continue
+		}
+		s.Require().Empty(manifest.Files, "the manifest is the last file")
+		sum := sha256.Sum256(buf)
+		sums[h.Name] = hex.EncodeToString(sum[:])
+	}
+
+	s.Require().Len(sums, len(manifest.Files)+len(manifest.Dicts))
+	for _, f := range append(manifest.Files, manifest.Dicts...) {
+		s.Equal(sums[f.Name], f.SHA256, f.Name)
+	}
+	return &manifest
+}
+
+// rangeBackup returns the timestamps of the metrics of the type in the archive
+func (s *StorageV2TestSuite) rangeBackup(path string, from, to int64, typ gproto.MetricType) []int64 {
+	r, err := storage.NewDataStoreReader(storage.NewReaderConfig(path).WithFs(s.fs))
+	s.Require().NoError(err)
+	defer r.Close()
+
+	timestamps := make([]int64, 0)
+	err = r.Range(time.Unix(from, 0), time.Unix(to, 0), func(buf []byte) error {
+		var m gproto.Metric
+		s.Require().NoError(proto.Unmarshal(buf, &m))
+		timestamps = append(timestamps, m.GetNic().GetTimestamp()+m.GetTcp().GetTimestamp())
+		return nil
+	}, typ)
+	s.Require().NoError(err)
+	return timestamps
+}
+
+func (s *StorageV2TestSuite) TestBackup() {
+	ds, err := storage.NewDataStore(storage.NewConfig(s.baseDir).WithFs(s.fs).WithMaxEntriesPerFile(8))
+	s.Require().NoError(err)
+	defer ds.Close()
+	s.Require().NoError(ds.SetHeadRecord(newHostInfo("node-1")))
+	for i := 0; i < 30; i++ {
+		s.Require().NoError(ds.Put(marshalNic(queryBase + int64(i))))
+		s.Require().NoError(ds.Put(marshalTcp(&gproto.TcpMetric{Timestamp: queryBase + int64(i),
+			Type: gproto.MetricType_TCP})))
+	}
+	fileNo := ds.GetLatestFileNo()
+
+	var buf bytes.Buffer
+	manifest, err := ds.Backup(&buf, storage.NewBackupConfig())
+	s.Require().NoError(err)
+	s.Equal(fileNo, ds.GetLatestFileNo(), "no file is sealed for the backup")
+	s.Equal(manifest.Files, s.readBackup(buf.Bytes()).Files)
+	s.Contains(string(manifest.Host), "node-1")
+	s.Require().NoError(afero.WriteFile(s.fs, "/backup/all.tar", buf.Bytes(), 0644))
+	// records in the file being written are included
+	s.Len(s.rangeBackup("/backup/all.tar", queryBase, queryBase+29, gproto.MetricType_NIC), 30)
+	unsealed := 0
+	for _, f := range manifest.Files {
+		if !f.Sealed {
+			unsealed++
+		}
+	}
+	s.Equal(3, unsealed, "a file of each of the default, nic and tcp streams")
+
+	// records written after the backup starts are not
+	s.Require().NoError(ds.Put(marshalNic(queryBase + 30)))
+	buf.Reset()
+	config := storage.NewBackupConfig().WithTimeRange(time.Unix(queryBase+10, 0), time.Unix(queryBase+12, 0)).
+		WithTypes("nic")
+	manifest, err = ds.Backup(&buf, config)
+	s.Require().NoError(err)
+	s.readBackup(buf.Bytes())
+	s.Require().NotEmpty(manifest.Files)
+	for _, f := range manifest.Files {
+		s.Equal(storage.StreamNic, f.Stream)
+		s.LessOrEqual(f.MinTimestamp, int64(queryBase+12))
+		s.GreaterOrEqual(f.MaxTimestamp, int64(queryBase+10))
+	}
+	s.Require().NoError(afero.WriteFile(s.fs, "/backup/nic.tar", buf.Bytes(), 0644))
+	s.Equal([]int64{queryBase + 10, queryBase + 11, queryBase + 12},
+		s.rangeBackup("/backup/nic.tar", queryBase+10, queryBase+12, gproto.MetricType_NIC))
+	s.Empty(s.rangeBackup("/backup/nic.tar", queryBase, queryBase+29, gproto.MetricType_TCP))
+	s.Less(len(s.rangeBackup("/backup/nic.tar", queryBase, queryBase+30, gproto.MetricType_NIC)), 30)
+
+	_, err = ds.Backup(io.Discard, storage.NewBackupConfig().WithTypes("disk"))
+	s.Require().Error(err)
+}
+
+func (s *StorageV2TestSuite) TestBackupHTTP() {
+	h, mon := s.newQueryServer()
+	defer mon.Close()
+
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/backup?types=nic&from=1700000020&format=tar.zst", nil))
+	s.Require().Equal(http.StatusOK, w.Code, w.Body.String())
+	s.True(strings.HasSuffix(w.Header().Get("Content-Disposition"), `.tar.zst"`))
+	s.Empty(w.Header().Get("Content-Encoding"))
+	s.Require().NoError(afero.WriteFile(s.fs, "/backup/http.tar.zst", w.Body.Bytes(), 0644))
+	s.Len(s.rangeBackup("/backup/http.tar.zst", queryBase+20, queryBase+29, gproto.MetricType_NIC), 10)
+
+	req := httptest.NewRequest(http.MethodGet, "/backup?types=tcp,host", nil)
+	req.Header.Set("Accept-Encoding", "gzip, zstd;q=0.5")
+	w = httptest.NewRecorder()
+	h.ServeHTTP(w, req)
+	s.Require().Equal(http.StatusOK, w.Code, w.Body.String())
+	s.Equal("zstd", w.Header().Get("Content-Encoding"))
+	s.True(strings.HasSuffix(w.Header().Get("Content-Disposition"), `.tar"`))
+	z, err := zstd.NewReader(w.Body)
+	s.Require().NoError(err)
+	archive, err := io.ReadAll(z)
+	z.Close()
+	s.Require().NoError(err)
+	manifest := s.readBackup(archive)
+	for _, f := range manifest.Files {
+		s.Contains([]string{storage.StreamTcp, storage.StreamDefault}, f.Stream)
+	}
+
+	for _, params := range []string{"types=disk", "from=bad", "from=1700000020&to=1700000010", "format=zip",
+		"pinned=maybe"} {
+		w = httptest.NewRecorder()
+		h.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/backup?"+params, nil))
+		s.Equal(http.StatusBadRequest, w.Code, params)
+		s.Empty(w.Header().Get("Content-Disposition"), params)
+	}
+}
@@ -0,0 +1,92 @@
+package test
+
+import (
+	"path/filepath"
+
+	"github.com/cockroachdb/errors"
+
+	"github.com/zperf/tcpmon/tcpmon/storage"
+)
+
+func (s *StorageV2TestSuite) TestColumnar() {
+	snapshots, err := newWorkload(60)
+	s.Require().NoError(err)
+	for _, m := range snapshots {
+		m.Sockets = m.Sockets[:20]
+	}
+
+	ds, err := storage.NewDataStore(storage.NewConfig(s.baseDir).WithFs(s.fs).WithMaxEntriesPerFile(40).
+		WithKeyframeInterval(10).WithDictSize(0).WithColumnar(true))
+	s.Require().NoError(err)
+	for _, m := range snapshots {
+		s.Require().NoError(ds.Put(marshalTcp(m)))
+		s.Require().NoError(ds.Put(marshalNic(m.GetTimestamp())))
+	}
+	s.Require().NoError(ds.NextFile())
+	s.Require().NoError(ds.Close())
+
+	// snapshots are reconstructed from deltas, one row per socket
+	file := filepath.Join(s.baseDir, storage.DataFileName(storage.StreamTcp, 2)+storage.SealFileSuffix)
+	segment, err := storage.OpenSegment(s.fs, file)
+	s.Require().NoError(err)
+	defer segment.Close()
+	s.Require().Equal(40*20, segment.Rows())
+	s.Require().Contains(segment.Columns(), "rtt")
+	s.Require().NotContains(segment.Columns(), "processes")
+
+	timestamps, err := segment.Timestamps()
+	s.Require().NoError(err)
+	rtt, err := segment.Float64s("rtt")
+	s.Require().NoError(err)
+	acked, err := segment.Uint64s("bytes_acked")
+	s.Require().NoError(err)
+	peers, err := segment.Strings("peer_addr")
+	s.Require().NoError(err)
+	states, err := segment.Int64s("state")
+	s.Require().NoError(err)
+	for i := 0; i < segment.Rows(); i++ {
+		m := snapshots[i/20]
+		socket := m.GetSockets()[i%20]
+		s.Require().Equal(m.GetTimestamp(), timestamps[i])
+		s.Require().Equal(socket.GetRtt(), rtt[i])
+		s.Require().Equal(socket.GetBytesAcked(), acked[i])
+		s.Require().Equal(socket.GetPeerAddr(), peers[i])
+		s.Require().EqualValues(socket.GetState(), states[i])
+	}
+
+	_, err = segment.Float64s("no_such_field")
+	s.Require().True(errors.Is(err, storage.ErrColumnNotFound))
+	_, err = segment.Int64s("rtt")
+	s.Require().Error(err)
+
+	// only socket tables have segments
+	_, err = storage.OpenSegment(s.fs, filepath.Join(s.baseDir,
+		storage.DataFileName(storage.StreamNic, 3)+storage.SealFileSuffix))
+	s.Require().True(errors.Is(err, storage.ErrSegmentNotFound))
+
+	// written for sealed files on demand as well
+	s.Require().NoError(s.fs.Remove(storage.SegmentFilePath(file)))
+	s.Require().NoError(storage.WriteSegment(s.fs, file, 1))
+	rebuilt, err := storage.OpenSegment(s.fs, file)
+	s.Require().NoError(err)
+	defer rebuilt.Close()
+	values, err := rebuilt.Float64s("rtt")
+	s.Require().NoError(err)
+	s.Require().Equal(rtt, values)
+
+	// not written if the rest of the file can't be read
+	dir := filepath.Join(s.baseDir, "raw")
+	ds, err = storage.NewDataStore(storage.NewConfig(dir).WithFs(s.fs).WithKeyframeInterval(10))
+	s.Require().NoError(err)
+	for _, m := range snapshots[:20] {
+		s.Require().NoError(ds.Put(marshalTcp(m)))
+	}
+	s.Require().NoError(ds.Close())
+	raw := filepath.Join(dir, storage.DataFileName(storage.StreamTcp, 2))
+	index, err := storage.BuildIndex(s.fs, raw)
+	s.Require().NoError(err)
+	s.corrupt(raw, int64(index.GetOffsets()[5]), []byte{0xff, 0xff})
+	s.Require().Error(storage.WriteSegment(s.fs, raw, 1))
+	_, err = storage.OpenSegment(s.fs, raw)
+	s.Require().True(errors.Is(err, storage.ErrSegmentNotFound))
+}
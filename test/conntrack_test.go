@@ -0,0 +1,135 @@
+package test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/zperf/tcpmon/tcpmon/conntrack"
+	"github.com/zperf/tcpmon/tcpmon/gproto"
+	"github.com/zperf/tcpmon/tcpmon/server"
+)
+
+type ConntrackTestSuite struct {
+	suite.Suite
+}
+
+func TestConntrack(t *testing.T) {
+	suite.Run(t, &ConntrackTestSuite{})
+}
+
+func newSocket(local, peer string, cookie uint64, state gproto.SocketState) *gproto.SocketMetric {
+	return &gproto.SocketMetric{
+		LocalAddr: local,
+		PeerAddr:  peer,
+		Cookie:    cookie,
+		State:     state,
+	}
+}
+
+func (s *ConntrackTestSuite) TestKey() {
+	s.Equal("sk:1f", conntrack.Key(newSocket("10.0.0.1:22", "10.0.0.2:5000", 0x1f, gproto.SocketState_TCP_ESTABLISHED)))
+	s.Equal("10.0.0.1:22|10.0.0.2:5000",
+		conntrack.Key(newSocket("10.0.0.1:22", "10.0.0.2:5000", 0, gproto.SocketState_TCP_ESTABLISHED)))
+}
+
+func (s *ConntrackTestSuite) TestStitch() {
+	tracker := conntrack.NewTracker()
+
+	// the same address pair is reused by another socket at ts=3
+	tracker.Observe(&gproto.TcpMetric{Timestamp: 1, Sockets: []*gproto.SocketMetric{
+		newSocket("10.0.0.1:22", "10.0.0.2:5000", 1, gproto.SocketState_TCP_ESTABLISHED),
+	}})
+	tracker.Observe(&gproto.TcpMetric{Timestamp: 2, Sockets: []*gproto.SocketMetric{
+		newSocket("10.0.0.1:22", "10.0.0.2:5000", 1, gproto.SocketState_TCP_CLOSE_WAIT),
+	}})
+	tracker.Observe(&gproto.TcpMetric{Timestamp: 3, Sockets: []*gproto.SocketMetric{
+		newSocket("10.0.0.1:22", "10.0.0.2:5000", 2, gproto.SocketState_TCP_ESTABLISHED),
+	}})
+
+	conns := tracker.Connections()
+	s.Require().Len(conns, 2)
+
+	s.Equal("sk:1", conns[0].Key)
+	s.Equal(int64(1), conns[0].FirstSeen.Unix())
+	s.Equal(int64(2), conns[0].LastSeen.Unix())
+	s.Equal(2, conns[0].Samples)
+	s.Equal(gproto.SocketState_TCP_CLOSE_WAIT, conns[0].State)
+
+	s.Equal("sk:2", conns[1].Key)
+	s.Equal(int64(3), conns[1].FirstSeen.Unix())
+	s.Equal(1, conns[1].Samples)
+}
+
+func (s *ConntrackTestSuite) TestDiff() {
+	d := conntrack.NewDiffer(2 * time.Second)
+
+	// baseline, no events
+	events := d.Diff(&gproto.TcpMetric{Timestamp: 1, Sockets: []*gproto.SocketMetric{
+		newSocket("10.0.0.1:22", "10.0.0.2:5000", 1, gproto.SocketState_TCP_ESTABLISHED),
+		newSocket("10.0.0.1:22", "10.0.0.3:5000", 2, gproto.SocketState_TCP_ESTABLISHED),
+	}})
+	s.Empty(events)
+
+	events = d.Diff(&gproto.TcpMetric{Timestamp: 2, Sockets: []*gproto.SocketMetric{
+		newSocket("10.0.0.1:22", "10.0.0.2:5000", 1, gproto.SocketState_TCP_CLOSE_WAIT),
+		newSocket("10.0.0.1:22", "10.0.0.4:5000", 3, gproto.SocketState_TCP_SYN_RECV),
+	}})
+	s.Require().Len(events, 3)
+	s.Equal(gproto.ConnectionEventType_STATE_CHANGED, events[0].GetType())
+	s.Equal(gproto.SocketState_TCP_ESTABLISHED, events[0].GetPrevState())
+	s.Equal(gproto.SocketState_TCP_CLOSE_WAIT, events[0].GetState())
+	s.Equal(int64(1000), events[0].GetDurationMs())
+	s.Equal(gproto.ConnectionEventType_OPENED, events[1].GetType())
+	s.Equal(uint64(3), events[1].GetCookie())
+	s.Equal(gproto.ConnectionEventType_DISAPPEARED, events[2].GetType())
+	s.Equal(uint64(2), events[2].GetCookie())
+	s.Equal(int64(2), events[2].GetTimestamp())
+
+	// stuck in CLOSE-WAIT, reported once
+	events = d.Diff(&gproto.TcpMetric{Timestamp: 3, Sockets: []*gproto.SocketMetric{
+		newSocket("10.0.0.1:22", "10.0.0.2:5000", 1, gproto.SocketState_TCP_CLOSE_WAIT),
+		newSocket("10.0.0.1:22", "10.0.0.4:5000", 3, gproto.SocketState_TCP_ESTABLISHED),
+	}})
+	s.Require().Len(events, 1)
+	s.Equal(gproto.ConnectionEventType_STATE_CHANGED, events[0].GetType())
+
+	events = d.Diff(&gproto.TcpMetric{Timestamp: 4, Sockets: []*gproto.SocketMetric{
+		newSocket("10.0.0.1:22", "10.0.0.2:5000", 1, gproto.SocketState_TCP_CLOSE_WAIT),
+		newSocket("10.0.0.1:22", "10.0.0.4:5000", 3, gproto.SocketState_TCP_ESTABLISHED),
+	}})
+	s.Require().Len(events, 1)
+	s.Equal(gproto.ConnectionEventType_STUCK, events[0].GetType())
+	s.Equal(int64(2000), events[0].GetDurationMs())
+
+	events = d.Diff(&gproto.TcpMetric{Timestamp: 5, Sockets: []*gproto.SocketMetric{
+		newSocket("10.0.0.1:22", "10.0.0.2:5000", 1, gproto.SocketState_TCP_LAST_ACK),
+		newSocket("10.0.0.1:22", "10.0.0.4:5000", 3, gproto.SocketState_TCP_ESTABLISHED),
+	}})
+	s.Require().Len(events, 1)
+
+	// closed after the handshake
+	events = d.Diff(&gproto.TcpMetric{Timestamp: 6, Sockets: []*gproto.SocketMetric{
+		newSocket("10.0.0.1:22", "10.0.0.4:5000", 3, gproto.SocketState_TCP_ESTABLISHED),
+	}})
+	s.Require().Len(events, 1)
+	s.Equal(gproto.ConnectionEventType_CLOSED, events[0].GetType())
+	s.Equal(int64(1), events[0].GetFirstSeen())
+	s.Equal(int64(4000), events[0].GetDurationMs())
+}
+
+func (s *ConntrackTestSuite) TestEventBuffer() {
+	b := server.NewEventBuffer(3)
+	for i := 1; i <= 5; i++ {
+		b.Add([]*gproto.ConnectionEvent{{Timestamp: int64(i)}})
+	}
+
+	events := b.List(func(e *gproto.ConnectionEvent) bool { return true })
+	s.Require().Len(events, 3)
+	s.Equal(int64(3), events[0].GetTimestamp())
+	s.Equal(int64(5), events[2].GetTimestamp())
+
+	events = b.List(func(e *gproto.ConnectionEvent) bool { return e.GetTimestamp() >= 4 })
+	s.Require().Len(events, 2)
+}
@@ -0,0 +1,252 @@
+package test
+
+import (
+	"bytes"
+	"path/filepath"
+
+	"github.com/cockroachdb/errors"
+	"github.com/klauspost/compress/zstd"
+	"github.com/spf13/afero"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/zperf/tcpmon/tcpmon/gproto"
+	"github.com/zperf/tcpmon/tcpmon/storage"
+)
+
+func newKeyring(ids ...string) *storage.Keyring {
+	keyring := storage.NewKeyring()
+	for _, id := range ids {
+		key := bytes.Repeat([]byte(id[len(id)-1:]), storage.KeySize)
+		err := keyring.Add(id, key)
+		if err != nil {
+			panic(err)
+		}
+	}
+	return keyring
+}
+
+// writeEncrypted writes NIC metrics from the timestamp and seals them with the keyring
+func (s *StorageV2TestSuite) writeEncrypted(keyring *storage.Keyring, from int64, n int) {
+	ds, err := storage.NewDataStore(storage.NewConfig(s.baseDir).WithFs(s.fs).WithMaxEntriesPerFile(40).
+		WithKeyring(keyring))
+	s.Require().NoError(err)
+	for i := 0; i < n; i++ {
+		s.Require().NoError(ds.Put(marshalNic(from + int64(i))))
+	}
+	s.Require().NoError(ds.NextFile())
+	s.Require().NoError(ds.Close())
+}
+
+func (s *StorageV2TestSuite) countNic(fs afero.Fs, path string) (int, error) {
+	r, err := storage.NewDataStoreReader(storage.NewReaderConfig(path).WithFs(fs))
+	if err != nil {
+		return 0, err
+	}
+	defer r.Close()
+
+	count := 0
+	err = r.Iterate(func(buf []byte) error {
+		var m gproto.Metric
+		err := proto.Unmarshal(buf, &m)
+		if err == nil && m.GetNic() != nil {
+			count++
+		}
+		return err
+	})
+	return count, err
+}
+
+func (s *StorageV2TestSuite) sealedFiles() []string {
+	files, err := afero.Glob(s.fs, filepath.Join(s.baseDir, "*"+storage.SealFileSuffix))
+	s.Require().NoError(err)
+	s.Require().NotEmpty(files)
+	return files
+}
+
+func (s *StorageV2TestSuite) TestEncryption() {
+	k1 := newKeyring("k1")
+	s.writeEncrypted(k1, rollupBase, 100)
+
+	for _, f := range s.sealedFiles() {
+		buf, err := afero.ReadFile(s.fs, f)
+		s.Require().NoError(err)
+		s.Require().True(bytes.HasPrefix(buf, []byte("tcpmonE1")), f)
+	}
+
+	_, err := s.countNic(s.fs, s.baseDir)
+	s.Require().True(errors.Is(err, storage.ErrEncrypted))
+
+	count, err := s.countNic(storage.NewDecryptFs(s.fs, k1), s.baseDir)
+	s.Require().NoError(err)
+	s.Require().Equal(100, count)
+
+	// rotated, old files are read with the old key
+	k12 := newKeyring("k1", "k2")
+	s.Require().Equal("k2", k12.Current())
+	s.writeEncrypted(k12, rollupBase+100, 100)
+
+	count, err = s.countNic(storage.NewDecryptFs(s.fs, k12), s.baseDir)
+	s.Require().NoError(err)
+	s.Require().Equal(200, count)
+
+	_, err = s.countNic(storage.NewDecryptFs(s.fs, newKeyring("k2")), s.baseDir)
+	s.Require().True(errors.Is(err, storage.ErrKeyNotFound))
+
+	// a tampered file can't be decrypted
+	file := filepath.Join(s.baseDir, storage.DataFileName(storage.StreamNic, 2)+storage.SealFileSuffix)
+	fs := storage.NewDecryptFs(s.fs, k12)
+	ciphertext, err := afero.ReadFile(s.fs, file)
+	s.Require().NoError(err)
+	s.corrupt(file, 200, []byte{ciphertext[200] ^ 1})
+	_, err = storage.CheckFile(fs, file, false)
+	s.Require().Error(err)
+	s.Require().NoError(afero.WriteFile(s.fs, file, ciphertext, 0644))
+
+	// corrupted records in an encrypted file are repaired, the file is still encrypted
+	compressed, err := afero.ReadFile(fs, file)
+	s.Require().NoError(err)
+	compressed[len(compressed)/2] ^= 0xff
+	var buf bytes.Buffer
+	w, err := k12.Encrypt(&buf)
+	s.Require().NoError(err)
+	_, err = w.Write(compressed)
+	s.Require().NoError(err)
+	s.Require().NoError(w.Close())
+	s.Require().NoError(afero.WriteFile(s.fs, file, buf.Bytes(), 0644))
+
+	report, err := storage.CheckFile(fs, file, true)
+	s.Require().NoError(err)
+	s.Require().True(report.Repaired)
+	report, err = storage.CheckFile(fs, file, false)
+	s.Require().NoError(err)
+	s.Require().True(report.Ok())
+	repaired, err := afero.ReadFile(s.fs, file)
+	s.Require().NoError(err)
+	s.Require().True(bytes.HasPrefix(repaired, []byte("tcpmonE1")))
+}
+
+func (s *StorageV2TestSuite) TestEncryptionPassphrase() {
+	keyring := storage.NewKeyring()
+	s.Require().NoError(keyring.SetPassphrase([]byte("secret")))
+	s.writeEncrypted(keyring, rollupBase, 50)
+
+	// keys are derived with the salt in the file header
+	other := storage.NewKeyring()
+	s.Require().NoError(other.SetPassphrase([]byte("secret")))
+	count, err := s.countNic(storage.NewDecryptFs(s.fs, other), s.baseDir)
+	s.Require().NoError(err)
+	s.Require().Equal(50, count)
+
+	wrong := storage.NewKeyring()
+	s.Require().NoError(wrong.SetPassphrase([]byte("wrong")))
+	_, err = s.countNic(storage.NewDecryptFs(s.fs, wrong), s.baseDir)
+	s.Require().Error(err)
+}
+
+func (s *StorageV2TestSuite) TestEncryptionTruncated() {
+	keyring := newKeyring("k1")
+	plaintext := bytes.Repeat([]byte("tcpmon"), 20000)
+
+	var buf bytes.Buffer
+	w, err := keyring.Encrypt(&buf)
+	s.Require().NoError(err)
+	_, err = w.Write(plaintext)
+	s.Require().NoError(err)
+	s.Require().NoError(w.Close())
+	s.Require().NoError(afero.WriteFile(s.fs, "/encrypted", buf.Bytes(), 0644))
+
+	fs := storage.NewDecryptFs(s.fs, keyring)
+	decrypted, err := afero.ReadFile(fs, "/encrypted")
+	s.Require().NoError(err)
+	s.Require().Equal(plaintext, decrypted)
+
+	// the final chunk is lost, the first chunk is not marked as the final one
+	truncated := buf.Bytes()[:buf.Len()-(len(plaintext)-64<<10)-16]
+	s.Require().NoError(afero.WriteFile(s.fs, "/encrypted", truncated, 0644))
+	_, err = afero.ReadFile(fs, "/encrypted")
+	s.Require().Error(err)
+
+	// a flipped bit
+	corrupted := bytes.Clone(buf.Bytes())
+	corrupted[len(corrupted)-20] ^= 1
+	s.Require().NoError(afero.WriteFile(s.fs, "/encrypted", corrupted, 0644))
+	_, err = afero.ReadFile(fs, "/encrypted")
+	s.Require().Error(err)
+}
+
+func (s *StorageV2TestSuite) TestEncryptionHeader() {
+	keyring := newKeyring("k1")
+	var buf bytes.Buffer
+	w, err := keyring.Encrypt(&buf)
+	s.Require().NoError(err)
+	_, err = w.Write([]byte("tcpmon"))
+	s.Require().NoError(err)
+	s.Require().NoError(w.Close())
+
+	fs := storage.NewDecryptFs(s.fs, keyring)
+	// magic + key id length + key id + salt + nonce + wrapped data key
+	chunkSize := 8 + 1 + 2 + 16 + 12 + 48
+	for _, c := range []struct {
+		offset int
+		value  []byte
+	}{
+		{chunkSize, []byte{0, 0, 0, 0}},
+		{chunkSize, []byte{0xff, 0xff, 0xff, 0xff}},
+		{chunkSize, []byte{0, 0, 2, 0}},
+		// salt
+		{8 + 1 + 2, []byte{1}},
+	} {
+		altered := bytes.Clone(buf.Bytes())
+		copy(altered[c.offset:], c.value)
+		s.Require().NoError(afero.WriteFile(s.fs, "/encrypted", altered, 0644))
+		_, err = afero.ReadFile(fs, "/encrypted")
+		s.Require().Error(err)
+	}
+}
+
+func (s *StorageV2TestSuite) TestEncryptedBackup() {
+	keyring := newKeyring("k1")
+	s.writeEncrypted(keyring, rollupBase, 100)
+
+	r, err := storage.NewDataStoreReader(storage.NewReaderConfig(s.baseDir).WithFs(s.fs).
+		WithSuffix(storage.SealFileSuffix))
+	s.Require().NoError(err)
+	var buf bytes.Buffer
+	w, err := storage.EncryptTo(keyring, &buf)
+	s.Require().NoError(err)
+	s.Require().NoError(r.Package(w))
+	s.Require().NoError(w.Close())
+	r.Close()
+
+	path := "/backup/node-1.tar.enc"
+	s.Require().True(storage.IsArchive(path))
+	s.Require().NoError(afero.WriteFile(s.fs, path, buf.Bytes(), 0644))
+
+	_, err = storage.OpenArchive(path, s.fs)
+	s.Require().True(errors.Is(err, storage.ErrEncrypted))
+
+	count, err := s.countNic(storage.NewDecryptFs(s.fs, keyring), path)
+	s.Require().NoError(err)
+	s.Require().Equal(100, count)
+
+	// compressed before it's encrypted
+	ds, err := storage.NewDataStore(storage.NewConfig(s.baseDir).WithFs(s.fs).WithKeyring(keyring))
+	s.Require().NoError(err)
+	defer ds.Close()
+	buf.Reset()
+	w, err = storage.EncryptTo(keyring, &buf)
+	s.Require().NoError(err)
+	z, err := zstd.NewWriter(w)
+	s.Require().NoError(err)
+	_, err = ds.Backup(z, storage.NewBackupConfig().WithTypes("nic"))
+	s.Require().NoError(err)
+	s.Require().NoError(z.Close())
+	s.Require().NoError(w.Close())
+
+	path = "/backup/node-1.tar.zst.enc"
+	s.Require().True(storage.IsArchive(path))
+	s.Require().NoError(afero.WriteFile(s.fs, path, buf.Bytes(), 0644))
+	count, err = s.countNic(storage.NewDecryptFs(s.fs, keyring), path)
+	s.Require().NoError(err)
+	s.Require().Equal(100, count)
+}
@@ -0,0 +1,312 @@
+package test
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/klauspost/compress/zstd"
+	"github.com/spf13/afero"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/zperf/tcpmon/tcpmon/export/influxdb"
+	"github.com/zperf/tcpmon/tcpmon/gproto"
+	"github.com/zperf/tcpmon/tcpmon/parsing"
+	"github.com/zperf/tcpmon/tcpmon/storage"
+	"github.com/zperf/tcpmon/tcpmon/tutils"
+)
+
+// newWorkload replays the recorded socket table in parsing/ss.txt for n seconds. Every second a part of the
+// connections make progress, a few connections are closed and a few are opened.
+func newWorkload(n int) ([]*gproto.TcpMetric, error) {
+	buf, err := os.ReadFile("parsing/ss.txt")
+	if err != nil {
+		return nil, err
+	}
+
+	var base gproto.TcpMetric
+	base.Type = gproto.MetricType_TCP
+	err = parsing.ParseSS(&base, strings.FieldsFunc(string(buf), tutils.SplitNewline))
+	if err != nil {
+		return nil, err
+	}
+
+	snapshots := make([]*gproto.TcpMetric, 0, n)
+	prev := &base
+	for i := 0; i < n; i++ {
+		cur := proto.Clone(prev).(*gproto.TcpMetric)
+		cur.Timestamp = int64(1700000000 + i)
+
+		for j, s := range cur.Sockets {
+			s.Lastsnd += 1000
+			s.Lastrcv += 1000
+			s.Lastack += 1000
+			if (i+j)%5 == 0 {
+				s.BytesAcked += uint64(1000 + j)
+				s.BytesReceived += uint64(2000 + j)
+				s.SegsOut += 3
+				s.SegsIn += 2
+				s.Rtt = float64(j%100) / 10
+			}
+		}
+
+		// close 10 connections, open 10 connections
+		cur.Sockets = cur.Sockets[10:]
+		for j := 0; j < 10; j++ {
+			cur.Sockets = append(cur.Sockets, &gproto.SocketMetric{
+				State:     gproto.SocketState_TCP_ESTABLISHED,
+				LocalAddr: "10.0.0.1:443",
+				PeerAddr:  fmt.Sprintf("10.0.%d.%d:%d", i%256, j, 30000+i),
+				Cookie:    uint64(i*10 + j + 1),
+				Mss:       1448,
+				Cwnd:      10,
+			})
+		}
+
+		snapshots = append(snapshots, cur)
+		prev = cur
+	}
+
+	return snapshots, nil
+}
+
+func marshalTcp(t *gproto.TcpMetric) []byte {
+	buf, err := proto.Marshal(&gproto.Metric{Body: &gproto.Metric_Tcp{Tcp: t}})
+	if err != nil {
+		panic(err)
+	}
+	return buf
+}
+
+// writeWorkload writes the snapshots into a single data file, returns the raw and the compressed file size
+func (s *StorageV2TestSuite) writeWorkload(snapshots []*gproto.TcpMetric, keyframeInterval uint32) (int, int) {
+	s.Require().NoError(s.fs.RemoveAll(s.baseDir))
+
+	cfg := storage.NewConfig(s.baseDir).
+		WithFs(s.fs).
+		WithMaxSize(1 << 40).
+		WithMaxEntriesPerFile(uint32(len(snapshots) + 1)).
+		WithKeyframeInterval(keyframeInterval)
+
+	ds, err := storage.NewDataStore(cfg)
+	s.Require().NoError(err)
+	for _, t := range snapshots {
+		s.Require().NoError(ds.Put(marshalTcp(t)))
+	}
+	s.Require().NoError(ds.Close())
+
+	files, err := afero.Glob(s.fs, filepath.Join(s.baseDir, storage.DataFilePrefix+storage.StreamTcp+"-*"))
+	s.Require().NoError(err)
+	s.Require().Len(files, 1)
+
+	raw, err := afero.ReadFile(s.fs, files[0])
+	s.Require().NoError(err)
+
+	var compressed bytes.Buffer
+	z, err := zstd.NewWriter(&compressed)
+	s.Require().NoError(err)
+	_, err = z.Write(raw)
+	s.Require().NoError(err)
+	s.Require().NoError(z.Close())
+
+	return len(raw), compressed.Len()
+}
+
+func (s *StorageV2TestSuite) TestDeltaRoundTrip() {
+	snapshots, err := newWorkload(12)
+	s.Require().NoError(err)
+
+	cfg := storage.NewConfig(s.baseDir).
+		WithFs(s.fs).
+		WithMaxSize(1 << 40).
+		WithMaxEntriesPerFile(5).
+		WithKeyframeInterval(3)
+
+	ds, err := storage.NewDataStore(cfg)
+	s.Require().NoError(err)
+	s.Require().NoError(ds.SetHeadRecord(newHostInfo("node-1")))
+	for i, t := range snapshots {
+		s.Require().NoError(ds.Put(marshalTcp(t)))
+		if i%4 == 0 {
+			// opaque records in between
+			s.Require().NoError(ds.Put(randBuf(64)))
+		}
+	}
+	s.Require().NoError(ds.Close())
+
+	r, err := storage.NewDataStoreReader(storage.NewReaderConfig(s.baseDir).WithFs(s.fs))
+	s.Require().NoError(err)
+	defer r.Close()
+
+	i := 0
+	err = r.Iterate(func(buf []byte) error {
+		var m gproto.Metric
+		if proto.Unmarshal(buf, &m) != nil || m.GetTcp() == nil {
+			return nil
+		}
+		s.Require().Less(i, len(snapshots))
+		s.Require().True(proto.Equal(snapshots[i], m.GetTcp()), "snapshot %d", i)
+		i++
+		return nil
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(len(snapshots), i)
+}
+
+func (s *StorageV2TestSuite) TestDeltaDecoderWithoutKeyframe() {
+	snapshots, err := newWorkload(2)
+	s.Require().NoError(err)
+
+	e := storage.NewDeltaEncoder(60)
+	_, err = e.Encode(marshalTcp(snapshots[0]))
+	s.Require().NoError(err)
+	delta, err := e.Encode(marshalTcp(snapshots[1]))
+	s.Require().NoError(err)
+
+	_, err = storage.NewDeltaDecoder().Decode(delta)
+	s.Require().Error(err)
+}
+
+func (s *StorageV2TestSuite) TestDeltaDecoderLostRecord() {
+	snapshots, err := newWorkload(5)
+	s.Require().NoError(err)
+
+	e := storage.NewDeltaEncoder(60)
+	records := make([][]byte, 0, len(snapshots))
+	for _, t := range snapshots {
+		buf, err := e.Encode(marshalTcp(t))
+		s.Require().NoError(err)
+		records = append(records, buf)
+	}
+
+	// the second delta is lost
+	d := storage.NewDeltaDecoder()
+	for _, i := range []int{0, 1} {
+		_, err = d.Decode(records[i])
+		s.Require().NoError(err)
+	}
+	for _, i := range []int{3, 4} {
+		_, err = d.Decode(records[i])
+		s.Require().ErrorIs(err, storage.ErrMissingKeyframe, "record %d", i)
+	}
+
+	// a keyframe is lost
+	d = storage.NewDeltaDecoder()
+	_, err = d.Decode(records[0])
+	s.Require().NoError(err)
+	e.Reset()
+	_, err = e.Encode(marshalTcp(snapshots[3]))
+	s.Require().NoError(err)
+	delta, err := e.Encode(marshalTcp(snapshots[4]))
+	s.Require().NoError(err)
+	_, err = d.Decode(delta)
+	s.Require().ErrorIs(err, storage.ErrMissingKeyframe)
+}
+
+// writeCorruptedDeltas writes 6 socket tables as a keyframe and deltas, and flips a bit in the body of the second
+// delta. bytes_acked of the socket is 100 per second.
+func (s *StorageV2TestSuite) writeCorruptedDeltas() (string, []*gproto.TcpMetric) {
+	ds, err := storage.NewDataStore(storage.NewConfig(s.baseDir).WithFs(s.fs).WithKeyframeInterval(60))
+	s.Require().NoError(err)
+	snapshots := make([]*gproto.TcpMetric, 0, 6)
+	for i := 0; i < 6; i++ {
+		socket := newSocket("10.0.0.1:80", "10.0.0.2:5000", 1, gproto.SocketState_TCP_ESTABLISHED)
+		socket.BytesAcked = uint64(i * 100)
+		t := &gproto.TcpMetric{Timestamp: 5000 + int64(i), Type: gproto.MetricType_TCP,
+			Sockets: []*gproto.SocketMetric{socket}}
+		snapshots = append(snapshots, t)
+		s.Require().NoError(ds.Put(marshalTcp(t)))
+	}
+	s.Require().NoError(ds.Close())
+
+	file := filepath.Join(s.baseDir, storage.DataFileName(storage.StreamTcp, 2))
+	index, err := storage.BuildIndex(s.fs, file)
+	s.Require().NoError(err)
+	s.Require().Len(index.GetOffsets(), 6)
+	s.corrupt(file, int64(index.GetOffsets()[3])-1, []byte{0xff})
+	return file, snapshots
+}
+
+func (s *StorageV2TestSuite) TestDeltaCorrupted() {
+	file, snapshots := s.writeCorruptedDeltas()
+
+	index, err := storage.BuildIndex(s.fs, file)
+	s.Require().NoError(err)
+	s.Require().Len(index.GetOffsets(), 5)
+	s.Require().EqualValues(6, index.GetCount())
+	s.Require().Len(index.GetCorruptOffsets(), 1)
+
+	// the deltas after the corrupted one are not decoded on another base
+	metrics := s.rangeMetrics(0, 0, gproto.MetricType_TCP)
+	s.Require().Len(metrics, 2)
+	for i, m := range metrics {
+		s.Require().True(proto.Equal(snapshots[i], m.GetTcp()), "snapshot %d", i)
+	}
+
+	exporter, err := influxdb.NewFastExporter(file, s.fs)
+	s.Require().NoError(err)
+	defer exporter.Close()
+	var out strings.Builder
+	s.Require().NoError(exporter.Export(&out, &influxdb.ExportOptions{Hostname: "node-1"}))
+	s.Require().Equal(2, strings.Count(out.String(), " BytesAcked="), out.String())
+	s.Require().Contains(out.String(), " BytesAcked=100 5001\n")
+
+	// the deltas after the corrupted one are dropped by repair
+	report, err := storage.CheckFile(s.fs, file, true)
+	s.Require().NoError(err)
+	s.Require().True(report.Repaired)
+	s.Require().Equal(3, report.Dropped)
+	report, err = storage.CheckFile(s.fs, file, false)
+	s.Require().NoError(err)
+	s.Require().True(report.Ok())
+	s.Require().Equal(2, report.Records)
+	s.Require().Len(s.rangeMetrics(0, 0, gproto.MetricType_TCP), 2)
+}
+
+// TestDeltaRetention measures the file size of 1 minute of the replayed workload
+func (s *StorageV2TestSuite) TestDeltaRetention() {
+	snapshots, err := newWorkload(60)
+	s.Require().NoError(err)
+
+	raw, compressed := s.writeWorkload(snapshots, 0)
+	deltaRaw, deltaCompressed := s.writeWorkload(snapshots, 60)
+
+	s.T().Logf("full snapshots: raw %d, compressed %d", raw, compressed)
+	s.T().Logf("delta encoded: raw %d (%.1fx), compressed %d (%.1fx)",
+		deltaRaw, float64(raw)/float64(deltaRaw),
+		deltaCompressed, float64(compressed)/float64(deltaCompressed))
+
+	s.Require().Greater(float64(raw)/float64(deltaRaw), 3.0)
+	s.Require().Greater(float64(compressed)/float64(deltaCompressed), 3.0)
+}
+
+func (s *StorageV2TestSuite) TestExportDelta() {
+	snapshots, err := newWorkload(5)
+	s.Require().NoError(err)
+
+	ds, err := storage.NewDataStore(storage.NewConfig(s.baseDir).WithFs(s.fs).WithKeyframeInterval(60))
+	s.Require().NoError(err)
+	for _, t := range snapshots {
+		s.Require().NoError(ds.Put(marshalTcp(t)))
+	}
+	s.Require().NoError(ds.Close())
+
+	files, err := afero.Glob(s.fs, filepath.Join(s.baseDir, storage.DataFilePrefix+storage.StreamTcp+"-*"))
+	s.Require().NoError(err)
+	s.Require().Len(files, 1)
+
+	exporter, err := influxdb.NewFastExporter(files[0], s.fs)
+	s.Require().NoError(err)
+	defer exporter.Close()
+
+	var out strings.Builder
+	s.Require().NoError(exporter.Export(&out, &influxdb.ExportOptions{Hostname: "node-1"}))
+
+	sockets := 0
+	for _, t := range snapshots {
+		sockets += len(t.GetSockets())
+	}
+	s.Require().Equal(sockets, strings.Count(out.String(), " State="))
+}
@@ -0,0 +1,201 @@
+package test
+
+import (
+	"archive/tar"
+	"bytes"
+	"crypto/rand"
+	"encoding/hex"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/klauspost/compress/zstd"
+	"github.com/spf13/afero"
+
+	"github.com/zperf/tcpmon/tcpmon/export/influxdb"
+	"github.com/zperf/tcpmon/tcpmon/gproto"
+	"github.com/zperf/tcpmon/tcpmon/storage"
+)
+
+func (s *StorageV2TestSuite) dicts() []string {
+	files, err := afero.Glob(s.fs, filepath.Join(s.baseDir, storage.DictPrefix+"*"))
+	s.Require().NoError(err)
+	return files
+}
+
+func (s *StorageV2TestSuite) TestDict() {
+	snapshots, err := newWorkload(120)
+	s.Require().NoError(err)
+	for _, m := range snapshots {
+		m.Sockets = m.Sockets[:20]
+	}
+
+	cfg := storage.NewConfig(s.baseDir).
+		WithFs(s.fs).
+		WithMaxEntriesPerFile(40).
+		WithKeyframeInterval(0)
+	ds, err := storage.NewDataStore(cfg)
+	s.Require().NoError(err)
+	s.Require().NoError(ds.SetHeadRecord(newHostInfo("node-1")))
+	for _, m := range snapshots[:80] {
+		s.Require().NoError(ds.Put(marshalTcp(m)))
+	}
+
+	// trained from the first sealed file of the stream
+	dicts := s.dicts()
+	s.Require().Len(dicts, 1)
+	s.Require().True(strings.HasPrefix(filepath.Base(dicts[0]), storage.DictPrefix+storage.StreamTcp+"-"))
+
+	// reused after restart
+	s.Require().NoError(ds.Close())
+	ds, err = storage.NewDataStore(cfg)
+	s.Require().NoError(err)
+	for _, m := range snapshots[80:] {
+		s.Require().NoError(ds.Put(marshalTcp(m)))
+	}
+	s.Require().NoError(ds.NextFile())
+	s.Require().NoError(ds.Close())
+	s.Require().Equal(dicts, s.dicts())
+
+	// sealed files can't be read without the dictionary
+	files, err := afero.Glob(s.fs, filepath.Join(s.baseDir, "tcpmon-dataf-tcp-*.zst"))
+	s.Require().NoError(err)
+	s.Require().NotEmpty(files)
+	sealed := files[0]
+	compressed, err := afero.ReadFile(s.fs, sealed)
+	s.Require().NoError(err)
+	decoder, err := zstd.NewReader(nil)
+	s.Require().NoError(err)
+	_, err = decoder.DecodeAll(compressed, nil)
+	decoder.Close()
+	s.Require().Error(err)
+
+	metrics := s.rangeMetrics(0, 0, gproto.MetricType_TCP)
+	s.Require().Len(metrics, len(snapshots))
+	s.Require().Equal(snapshots[100].GetTimestamp(), getMetricTimestamp(metrics[100]))
+
+	exporter, err := influxdb.NewFastExporter(sealed, s.fs)
+	s.Require().NoError(err)
+	defer exporter.Close()
+	_, err = exporter.Scan()
+	s.Require().NoError(err)
+	var out bytes.Buffer
+	s.Require().NoError(exporter.Export(&out, &influxdb.ExportOptions{}))
+	s.Require().Contains(out.String(), "Hostname=node-1")
+
+	// dictionaries are packaged first
+	r, err := storage.NewDataStoreReader(storage.NewReaderConfig(s.baseDir).WithFs(s.fs).
+		WithSuffix(storage.SealFileSuffix))
+	s.Require().NoError(err)
+	defer r.Close()
+	var backup bytes.Buffer
+	s.Require().NoError(r.Package(&backup))
+	header, err := tar.NewReader(&backup).Next()
+	s.Require().NoError(err)
+	s.Require().Equal(filepath.Base(dicts[0]), header.Name)
+}
+
+func (s *StorageV2TestSuite) TestDictDisabled() {
+	ds, err := storage.NewDataStore(storage.NewConfig(s.baseDir).WithFs(s.fs).WithMaxEntriesPerFile(40).
+		WithDictSize(0))
+	s.Require().NoError(err)
+	for i := 0; i < 80; i++ {
+		s.Require().NoError(ds.Put(marshalNic(int64(1700000000 + i))))
+	}
+	s.Require().NoError(ds.Close())
+	s.Require().Empty(s.dicts())
+}
+
+func (s *StorageV2TestSuite) TestDictRetrain() {
+	snapshots, err := newWorkload(160)
+	s.Require().NoError(err)
+	for _, m := range snapshots {
+		m.Sockets = m.Sockets[:20]
+	}
+
+	cfg := storage.NewConfig(s.baseDir).
+		WithFs(s.fs).
+		WithMaxEntriesPerFile(40).
+		WithKeyframeInterval(0).
+		WithDictRetrainInterval(0)
+	ds, err := storage.NewDataStore(cfg)
+	s.Require().NoError(err)
+	for _, m := range snapshots[:80] {
+		s.Require().NoError(ds.Put(marshalTcp(m)))
+	}
+	s.Require().Len(s.dicts(), 1)
+
+	// the workload changed, the file compressed with the dictionary is much larger
+	for _, m := range snapshots[80:] {
+		for _, socket := range m.Sockets {
+			buf := make([]byte, 16)
+			_, err = rand.Read(buf)
+			s.Require().NoError(err)
+			socket.PeerAddr = hex.EncodeToString(buf)
+		}
+	}
+	for _, m := range snapshots[80:] {
+		s.Require().NoError(ds.Put(marshalTcp(m)))
+	}
+	s.Require().NoError(ds.NextFile())
+	s.Require().NoError(ds.Close())
+	s.Require().Len(s.dicts(), 2)
+	s.Require().Len(s.rangeMetrics(0, 0, gproto.MetricType_TCP), len(snapshots))
+
+	// retrained periodically, dictionaries of the remaining files are kept
+	ds, err = storage.NewDataStore(cfg.WithDictRetrainInterval(time.Nanosecond))
+	s.Require().NoError(err)
+	for _, m := range snapshots[:80] {
+		s.Require().NoError(ds.Put(marshalTcp(m)))
+	}
+	s.Require().NoError(ds.Close())
+	s.Require().Len(s.dicts(), 4)
+	s.Require().Len(s.rangeMetrics(0, 0, gproto.MetricType_TCP), len(snapshots)+80)
+}
+
+func (s *StorageV2TestSuite) TestDictGC() {
+	snapshots, err := newWorkload(400)
+	s.Require().NoError(err)
+	for _, m := range snapshots {
+		m.Sockets = m.Sockets[:20]
+	}
+
+	cfg := storage.NewConfig(s.baseDir).
+		WithFs(s.fs).
+		WithMaxEntriesPerFile(40).
+		WithKeyframeInterval(0).
+		WithDictRetrainInterval(time.Nanosecond).
+		WithMaxSize(64 << 10)
+	ds, err := storage.NewDataStore(cfg)
+	s.Require().NoError(err)
+	for _, m := range snapshots {
+		s.Require().NoError(ds.Put(marshalTcp(m)))
+	}
+
+	// retrained for every file, the dictionaries of the deleted files are deleted
+	files, err := afero.Glob(s.fs, filepath.Join(s.baseDir, "tcpmon-dataf-tcp-*.zst"))
+	s.Require().NoError(err)
+	s.Require().NotEmpty(files)
+	s.Require().Less(len(files), 9)
+	dicts := s.dicts()
+	s.Require().NotEmpty(dicts)
+	s.Require().LessOrEqual(len(dicts), len(files)+1)
+
+	size := int64(0)
+	for _, f := range append(dicts, files...) {
+		stat, err := s.fs.Stat(f)
+		s.Require().NoError(err)
+		size += stat.Size()
+	}
+	s.Require().LessOrEqual(size, int64(64<<10))
+
+	var buf bytes.Buffer
+	manifest, err := ds.Backup(&buf, storage.NewBackupConfig())
+	s.Require().NoError(err)
+	s.Require().Len(manifest.Dicts, len(files))
+	s.Require().NoError(ds.Close())
+
+	metrics := s.rangeMetrics(0, 0, gproto.MetricType_TCP)
+	s.Require().NotEmpty(metrics)
+	s.Require().Equal(snapshots[len(snapshots)-1].GetTimestamp(), getMetricTimestamp(metrics[len(metrics)-1]))
+}
@@ -0,0 +1,130 @@
+package test
+
+import (
+	"path/filepath"
+	"sync/atomic"
+	"time"
+
+	"github.com/spf13/afero"
+
+	"github.com/zperf/tcpmon/tcpmon/storage"
+)
+
+// syncCountingFs counts fsync calls of created files
+type syncCountingFs struct {
+	afero.Fs
+	syncs *atomic.Int64
+}
+
+type syncCountingFile struct {
+	afero.File
+	syncs *atomic.Int64
+}
+
+func (f *syncCountingFile) Sync() error {
+	f.syncs.Add(1)
+	return f.File.Sync()
+}
+
+func (fs *syncCountingFs) Create(name string) (afero.File, error) {
+	f, err := fs.Fs.Create(name)
+	if err != nil {
+		return nil, err
+	}
+	return &syncCountingFile{File: f, syncs: fs.syncs}, nil
+}
+
+func (s *StorageV2TestSuite) fileSize(f string) int64 {
+	stat, err := s.fs.Stat(filepath.Join(s.baseDir, f))
+	s.Require().NoError(err)
+	return stat.Size()
+}
+
+func (s *StorageV2TestSuite) TestBufferedWrite() {
+	cfg := storage.NewConfig(s.baseDir).
+		WithFs(s.fs).
+		WithWriteInterval(time.Hour)
+
+	ds, err := storage.NewDataStore(cfg)
+	s.Require().NoError(err)
+	for i := 0; i < 3; i++ {
+		s.Require().NoError(ds.Put(newRecord(i)))
+	}
+	s.Require().Zero(s.fileSize("tcpmon-dataf-1"))
+	s.Require().EqualValues(3, ds.WriteStats().PendingRecords)
+
+	s.Require().NoError(ds.Flush())
+	recordSize := int64(storage.ChecksumHeaderSize + len(newRecord(0)))
+	s.Require().Equal(3*recordSize, s.fileSize("tcpmon-dataf-1"))
+
+	stats := ds.WriteStats()
+	s.Require().EqualValues(3, stats.Records)
+	s.Require().EqualValues(0, stats.PendingRecords)
+	s.Require().EqualValues(1, stats.Flushes)
+	s.Require().GreaterOrEqual(stats.MaxFlushLatency, stats.LastFlushLatency)
+
+	// Close flushes
+	s.Require().NoError(ds.Put(newRecord(3)))
+	s.Require().NoError(ds.Close())
+	s.Require().Equal(4*recordSize, s.fileSize("tcpmon-dataf-1"))
+}
+
+func (s *StorageV2TestSuite) TestWriteBatchSize() {
+	cfg := storage.NewConfig(s.baseDir).
+		WithFs(s.fs).
+		WithWriteInterval(time.Hour).
+		WithWriteBatchSize(4)
+
+	ds, err := storage.NewDataStore(cfg)
+	s.Require().NoError(err)
+	defer ds.Close()
+
+	recordSize := int64(storage.ChecksumHeaderSize + len(newRecord(0)))
+	for i := 0; i < 3; i++ {
+		s.Require().NoError(ds.Put(newRecord(i)))
+	}
+	s.Require().Zero(s.fileSize("tcpmon-dataf-1"))
+
+	s.Require().NoError(ds.Put(newRecord(3)))
+	s.Require().Equal(4*recordSize, s.fileSize("tcpmon-dataf-1"))
+}
+
+func (s *StorageV2TestSuite) TestSyncPolicy() {
+	for _, tc := range []struct {
+		policy storage.SyncPolicy
+		syncs  int64 // by the policy, files are synced on close as well
+	}{
+		{storage.SyncNever, 0},
+		{storage.SyncInterval, 0},
+		{storage.SyncEveryBatch, 5},
+	} {
+		s.SetupTest()
+		var syncs atomic.Int64
+		cfg := storage.NewConfig(s.baseDir).
+			WithFs(&syncCountingFs{Fs: s.fs, syncs: &syncs}).
+			WithWriteInterval(time.Hour).
+			WithWriteBatchSize(2).
+			WithSyncPolicy(tc.policy)
+
+		ds, err := storage.NewDataStore(cfg)
+		s.Require().NoError(err)
+		for i := 0; i < 10; i++ {
+			s.Require().NoError(ds.Put(newRecord(i)))
+		}
+		s.Require().EqualValues(5, ds.WriteStats().Flushes, tc.policy)
+		s.Require().EqualValues(tc.syncs, ds.WriteStats().Syncs, tc.policy)
+		s.Require().Equal(tc.syncs, syncs.Load(), tc.policy)
+
+		s.Require().NoError(ds.Close())
+		s.Require().Equal(tc.syncs+1, syncs.Load(), tc.policy)
+	}
+}
+
+func (s *StorageV2TestSuite) TestParseSyncPolicy() {
+	p, err := storage.ParseSyncPolicy("every-batch")
+	s.Require().NoError(err)
+	s.Require().Equal(storage.SyncEveryBatch, p)
+
+	_, err = storage.ParseSyncPolicy("always")
+	s.Require().Error(err)
+}
@@ -0,0 +1,103 @@
+package test
+
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/cockroachdb/errors"
+	"github.com/spf13/afero"
+
+	"github.com/zperf/tcpmon/tcpmon/gproto"
+	"github.com/zperf/tcpmon/tcpmon/storage"
+)
+
+// corrupt overwrites the bytes of the file at the offset
+func (s *StorageV2TestSuite) corrupt(file string, offset int64, buf []byte) {
+	fh, err := s.fs.OpenFile(file, os.O_WRONLY, 0644)
+	s.Require().NoError(err)
+	_, err = fh.WriteAt(buf, offset)
+	s.Require().NoError(err)
+	s.Require().NoError(fh.Close())
+}
+
+func (s *StorageV2TestSuite) TestFsck() {
+	ds, err := storage.NewDataStore(storage.NewConfig(s.baseDir).WithFs(s.fs))
+	s.Require().NoError(err)
+	for i := 0; i < 20; i++ {
+		s.Require().NoError(ds.Put(marshalNic(rollupBase + int64(i))))
+	}
+	s.Require().NoError(ds.Close())
+
+	file := filepath.Join(s.baseDir, storage.DataFileName(storage.StreamNic, 2))
+	index, err := storage.BuildIndex(s.fs, file)
+	s.Require().NoError(err)
+	offsets := index.GetOffsets()
+	s.Require().Len(offsets, 20)
+
+	report, err := storage.CheckFile(s.fs, file, false)
+	s.Require().NoError(err)
+	s.Require().True(report.Ok())
+	s.Require().Equal(20, report.Records)
+	s.Require().Equal(map[gproto.MetricType]int{gproto.MetricType_NIC: 20}, report.Counts)
+	s.Require().Equal(rollupBase, report.MinTimestamp)
+	s.Require().Equal(rollupBase+19, report.MaxTimestamp)
+
+	// an invalid version in the header of the 5th record, a flipped bit in the body of the 10th record
+	s.corrupt(file, int64(offsets[5]), []byte{0xff, 0xff})
+	s.corrupt(file, int64(offsets[10])+storage.ChecksumHeaderSize, []byte{0xff})
+
+	report, err = storage.CheckFile(s.fs, file, false)
+	s.Require().NoError(err)
+	s.Require().Equal(18, report.Records)
+	s.Require().Len(report.Corrupted, 2)
+	s.Require().EqualValues(offsets[5], report.Corrupted[0].Offset)
+	s.Require().EqualValues(offsets[6], report.Corrupted[0].End)
+	s.Require().True(errors.Is(report.Corrupted[0].Err, storage.ErrInvalidHeader))
+	s.Require().EqualValues(offsets[10], report.Corrupted[1].Offset)
+	s.Require().EqualValues(offsets[11], report.Corrupted[1].End)
+	s.Require().True(errors.Is(report.Corrupted[1].Err, storage.ErrChecksumMismatch))
+
+	report, err = storage.CheckFile(s.fs, file, true)
+	s.Require().NoError(err)
+	s.Require().True(report.Repaired)
+
+	report, err = storage.CheckFile(s.fs, file, false)
+	s.Require().NoError(err)
+	s.Require().True(report.Ok())
+	s.Require().Equal(18, report.Records)
+	s.Require().Len(s.rangeMetrics(0, 0, gproto.MetricType_NIC), 18)
+}
+
+func (s *StorageV2TestSuite) TestFsckSealed() {
+	file := s.writeSealedNic(100)
+	compressed, err := afero.ReadFile(s.fs, file)
+	s.Require().NoError(err)
+
+	// break a frame in the middle of the file
+	s.corrupt(file, int64(len(compressed)/2), []byte{0xde, 0xad, 0xbe, 0xef, 0xde, 0xad, 0xbe, 0xef})
+
+	report, err := storage.CheckFile(s.fs, file, false)
+	s.Require().NoError(err)
+	s.Require().False(report.Ok())
+	s.Require().Less(report.Records, 101)
+	s.Require().Greater(report.Records, 50)
+	records := report.Records
+
+	files, err := storage.DataFiles(s.fs, s.baseDir)
+	s.Require().NoError(err)
+	s.Require().Contains(files, file)
+
+	report, err = storage.CheckFile(s.fs, file, true)
+	s.Require().NoError(err)
+	s.Require().True(report.Repaired)
+
+	report, err = storage.CheckFile(s.fs, file, false)
+	s.Require().NoError(err)
+	s.Require().True(report.Ok())
+	s.Require().Equal(records, report.Records)
+
+	// the index is rebuilt with the records left
+	index, err := storage.LoadIndex(s.fs, file)
+	s.Require().NoError(err)
+	s.Require().Len(index.GetOffsets(), records)
+}
@@ -0,0 +1,178 @@
+package test
+
+import (
+	"encoding/json"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/spf13/afero"
+
+	"github.com/zperf/tcpmon/tcpmon/server"
+	"github.com/zperf/tcpmon/tcpmon/storage"
+	"github.com/zperf/tcpmon/tcpmon/tutils"
+)
+
+func (s *StorageV2TestSuite) healthz(h http.Handler, path string) (int, *server.HealthReport) {
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, httptest.NewRequest(http.MethodGet, path, nil))
+	var r server.HealthReport
+	s.Require().NoError(json.Unmarshal(w.Body.Bytes(), &r), w.Body.String())
+	return w.Code, &r
+}
+
+// failedChecks returns the names of the failed checks
+func failedChecks(r *server.HealthReport) []string {
+	names := make([]string, 0)
+	for _, c := range r.Checks {
+		if !c.OK {
+			names = append(names, c.Name)
+		}
+	}
+	return names
+}
+
+func (s *StorageV2TestSuite) TestHealth() {
+	auth := server.NewAuth()
+	s.Require().NoError(auth.Add(server.ScopeAdmin, "a-token"))
+	h, mon := s.newAuthServer(server.MonitorConfig{
+		Auth:                auth,
+		HealthMaxFailures:   2,
+		HealthMaxAge:        time.Minute,
+		HealthMaxQueueDepth: 10,
+	})
+	defer mon.Close()
+
+	// healthy before the first collection, but not ready
+	code, r := s.healthz(h, "/healthz")
+	s.Equal(http.StatusOK, code)
+	s.True(r.OK)
+	s.Equal(256, r.QueueCapacity)
+	s.Nil(r.Quorum)
+	code, r = s.healthz(h, "/readyz")
+	s.Equal(http.StatusServiceUnavailable, code)
+	s.Equal([]string{"running", "collector:host", "collector:net", "collector:nic", "collector:socket"},
+		failedChecks(r))
+
+	now := time.Now()
+	health := mon.Health()
+	for _, name := range []string{server.CollectorHost, server.CollectorNet, server.CollectorNic,
+		server.CollectorSocket} {
+		health.Success(name, now)
+	}
+	s.Equal([]string{"running"}, failedChecks(mon.HealthReport(now, true)))
+
+	// ss keeps failing
+	health.Failure(server.CollectorSocket, now, errors.New("ss timed out"))
+	s.Empty(failedChecks(mon.HealthReport(now, false)))
+	health.Failure(server.CollectorSocket, now, errors.New("ss timed out"))
+	code, r = s.healthz(h, "/healthz")
+	s.Equal(http.StatusServiceUnavailable, code)
+	s.Equal([]string{"collector:socket"}, failedChecks(r))
+	for _, c := range r.Checks {
+		if c.Name == "collector:socket" {
+			s.Equal("2 failures in a row: ss timed out", c.Message)
+		}
+	}
+	s.Equal(2, r.Collectors[server.CollectorSocket].ConsecutiveFailures)
+	s.EqualValues(1, r.Collectors[server.CollectorSocket].Successes)
+
+	health.Success(server.CollectorSocket, now)
+	s.Empty(failedChecks(mon.HealthReport(now, false)))
+	// collection is stuck
+	s.Equal([]string{"collector:host", "collector:net", "collector:nic", "collector:socket"},
+		failedChecks(mon.HealthReport(now.Add(2*time.Minute), false)))
+}
+
+// failingFs fails writes of created files while fail is set, only of the files whose names contain match if it's
+// not empty
+type failingFs struct {
+	afero.Fs
+	fail  *atomic.Bool
+	match string
+}
+
+type failingFile struct {
+	afero.File
+	fail *atomic.Bool
+}
+
+func (f *failingFile) Write(p []byte) (int, error) {
+	if f.fail.Load() {
+		return 0, errors.New("disk is full")
+	}
+	return f.File.Write(p)
+}
+
+func (fs *failingFs) Create(name string) (afero.File, error) {
+	f, err := fs.Fs.Create(name)
+	if err != nil {
+		return nil, err
+	}
+	if !strings.Contains(filepath.Base(name), fs.match) {
+		return f, nil
+	}
+	return &failingFile{File: f, fail: fs.fail}, nil
+}
+
+func (s *StorageV2TestSuite) TestHostWriteFailed() {
+	fail := &atomic.Bool{}
+	fail.Store(true)
+	mon, err := server.New(server.MonitorConfig{
+		QuorumPort:      -1,
+		DataStoreConfig: *storage.NewConfig(s.baseDir).WithFs(&failingFs{Fs: s.fs, fail: fail}),
+		EventBufferSize: 1,
+	})
+	s.Require().NoError(err)
+	defer mon.Close()
+
+	// the daemon keeps running, the host info is written again on the next tick even if it hasn't changed
+	now := time.Now()
+	mon.CollectHost(now)
+	mon.CollectHost(now)
+	r := mon.HealthReport(now, false)
+	s.Equal(2, r.Collectors[server.CollectorHost].ConsecutiveFailures)
+	s.Contains(r.Collectors[server.CollectorHost].LastError, "disk is full")
+}
+
+func (s *StorageV2TestSuite) TestSdNotify() {
+	s.T().Setenv("NOTIFY_SOCKET", "")
+	ok, err := tutils.SdNotify("READY=1")
+	s.Require().NoError(err)
+	s.False(ok)
+
+	dir, err := os.MkdirTemp("", "tcpmon-sd")
+	s.Require().NoError(err)
+	defer os.RemoveAll(dir)
+	socket := filepath.Join(dir, "notify.sock")
+	conn, err := net.ListenUnixgram("unixgram", &net.UnixAddr{Name: socket, Net: "unixgram"})
+	s.Require().NoError(err)
+	defer conn.Close()
+
+	s.T().Setenv("NOTIFY_SOCKET", socket)
+	ok, err = tutils.SdNotify("READY=1")
+	s.Require().NoError(err)
+	s.True(ok)
+	buf := make([]byte, 64)
+	s.Require().NoError(conn.SetReadDeadline(time.Now().Add(time.Second)))
+	n, err := conn.Read(buf)
+	s.Require().NoError(err)
+	s.Equal("READY=1", string(buf[:n]))
+
+	s.T().Setenv("WATCHDOG_USEC", "30000000")
+	s.T().Setenv("WATCHDOG_PID", strconv.Itoa(os.Getpid()))
+	interval, err := tutils.SdWatchdogInterval()
+	s.Require().NoError(err)
+	s.Equal(30*time.Second, interval)
+	s.T().Setenv("WATCHDOG_PID", "1")
+	interval, err = tutils.SdWatchdogInterval()
+	s.Require().NoError(err)
+	s.Zero(interval)
+}
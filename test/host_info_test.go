@@ -0,0 +1,198 @@
+package test
+
+import (
+	"bytes"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/spf13/afero"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/zperf/tcpmon/tcpmon/collector"
+	"github.com/zperf/tcpmon/tcpmon/export/influxdb"
+	"github.com/zperf/tcpmon/tcpmon/gproto"
+	"github.com/zperf/tcpmon/tcpmon/storage"
+)
+
+func newHostInfo(hostname string) []byte {
+	buf, err := proto.Marshal(&gproto.Metric{Body: &gproto.Metric_Host{Host: &gproto.HostInfo{
+		Timestamp: time.Now().Unix(),
+		Type:      gproto.MetricType_HOST,
+		Hostname:  hostname,
+		CpuCount:  4,
+	}}})
+	if err != nil {
+		panic(err)
+	}
+	return buf
+}
+
+func (s *StorageV2TestSuite) TestHeadRecord() {
+	cfg := storage.NewConfig(s.baseDir).
+		WithFs(s.fs).
+		WithMaxSize(10 * (1 << 20)).
+		WithMaxEntriesPerFile(3)
+
+	ds, err := storage.NewDataStore(cfg)
+	s.Require().NoError(err)
+
+	head := newHostInfo("node-1")
+	s.Require().NoError(ds.SetHeadRecord(head))
+
+	const bufSize = 1 << 10
+	for i := 0; i < 7; i++ {
+		s.Require().NoError(ds.Put(randBuf(bufSize)))
+	}
+	s.Require().NoError(ds.Close())
+
+	// 2 full files and 1 file with 1 record, every file begins with the head record
+	r, err := storage.NewDataStoreReader(storage.NewReaderConfig(s.baseDir).WithFs(s.fs))
+	s.Require().NoError(err)
+	defer r.Close()
+
+	heads := 0
+	records := 0
+	err = r.Iterate(func(buf []byte) error {
+		if len(buf) == bufSize {
+			records++
+		} else {
+			s.Require().Equal(head, buf)
+			heads++
+		}
+		return nil
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(7, records)
+	s.Require().Equal(3, heads)
+}
+
+func (s *StorageV2TestSuite) TestHeadRecordRetry() {
+	fail := &atomic.Bool{}
+	cfg := storage.NewConfig(s.baseDir).
+		WithFs(&failingFs{Fs: s.fs, fail: fail, match: "-tcp-"}).
+		WithWriteInterval(0)
+	ds, err := storage.NewDataStore(cfg)
+	s.Require().NoError(err)
+	s.Require().NoError(ds.Put(randBuf(64)))
+	s.Require().NoError(ds.Put(marshalNic(1700000000)))
+	s.Require().NoError(ds.Put(marshalTcp(newRandomTcp(1700000000))))
+
+	// written to the default and NIC streams, the TCP stream failed
+	head := newHostInfo("node-1")
+	fail.Store(true)
+	s.Require().Error(ds.SetHeadRecord(head))
+	fail.Store(false)
+	s.Require().Error(ds.SetHeadRecord(head))
+	s.Require().Error(ds.Flush())
+
+	// the retry doesn't write the head record again to the streams having it, the buffered TCP writer keeps failing
+	for _, f := range []string{"tcpmon-dataf-1", "tcpmon-dataf-nic-2"} {
+		reader, err := storage.NewDataFileReader(filepath.Join(s.baseDir, f), s.fs)
+		s.Require().NoError(err)
+		heads := 0
+		for {
+			buf, err := reader.Read()
+			if err != nil {
+				break
+			}
+			if bytes.Equal(head, buf) {
+				heads++
+			}
+		}
+		reader.Close()
+		s.Equal(1, heads, f)
+	}
+}
+
+func (s *StorageV2TestSuite) TestExportHostname() {
+	ds, err := storage.NewDataStore(storage.NewConfig(s.baseDir).WithFs(s.fs))
+	s.Require().NoError(err)
+	s.Require().NoError(ds.SetHeadRecord(newHostInfo("node-1")))
+	s.Require().NoError(ds.Close())
+
+	files, err := afero.Glob(s.fs, filepath.Join(s.baseDir, storage.DataFilePrefix+"*"))
+	s.Require().NoError(err)
+	s.Require().Len(files, 1)
+
+	exporter, err := influxdb.NewFastExporter(files[0], s.fs)
+	s.Require().NoError(err)
+	defer exporter.Close()
+
+	hostname, err := exporter.Hostname()
+	s.Require().NoError(err)
+	s.Require().Equal("node-1", hostname)
+}
+
+func (s *StorageV2TestSuite) TestExportHostnameNotFound() {
+	ds, err := storage.NewDataStore(storage.NewConfig(s.baseDir).WithFs(s.fs))
+	s.Require().NoError(err)
+	s.Require().NoError(ds.Put(randBuf(16)))
+	s.Require().NoError(ds.Close())
+
+	files, err := afero.Glob(s.fs, filepath.Join(s.baseDir, storage.DataFilePrefix+"*"))
+	s.Require().NoError(err)
+	s.Require().Len(files, 1)
+
+	exporter, err := influxdb.NewFastExporter(files[0], s.fs)
+	s.Require().NoError(err)
+	defer exporter.Close()
+
+	_, err = exporter.Hostname()
+	s.Require().Error(err)
+}
+
+func (s *StorageV2TestSuite) TestExportTimeRange() {
+	snapshots := s.writeIndexed(12)
+	export := func(file string, target int64) error {
+		exporter, err := influxdb.NewFastExporter(filepath.Join(s.baseDir, file), s.fs)
+		s.Require().NoError(err)
+		defer exporter.Close()
+		var out strings.Builder
+		return exporter.Export(&out, &influxdb.ExportOptions{Hostname: "node-1", Target: time.Unix(target, 0)})
+	}
+
+	// the head record of the second NIC file is collected with the first snapshot, it's not in the time range
+	file := storage.DataFileName(storage.StreamNic, 5)
+	s.Require().ErrorIs(export(file, snapshots[3].GetTimestamp()), influxdb.ErrTimePointNotIncluded)
+	s.Require().NoError(export(file, snapshots[9].GetTimestamp()))
+
+	// files without metrics
+	s.Require().ErrorIs(export(storage.DataFileName(storage.StreamDefault, 1), snapshots[0].GetTimestamp()),
+		influxdb.ErrNoMetric)
+	s.Require().NoError(afero.WriteFile(s.fs, filepath.Join(s.baseDir, storage.DataFileName(storage.StreamNic, 99)),
+		nil, 0644))
+	s.Require().ErrorIs(export(storage.DataFileName(storage.StreamNic, 99), 0), influxdb.ErrNoMetric)
+}
+
+func (s *StorageV2TestSuite) TestSameHost() {
+	a := &gproto.HostInfo{Timestamp: 1, Hostname: "node-1", Interfaces: []string{"lo", "eth0"}}
+	b := &gproto.HostInfo{Timestamp: 2, Hostname: "node-1", Interfaces: []string{"lo", "eth0"}}
+	s.Require().True(collector.SameHost(a, b))
+	s.Require().Equal(int64(1), a.GetTimestamp())
+
+	b.Interfaces = []string{"lo"}
+	s.Require().False(collector.SameHost(a, b))
+}
+
+func (s *StorageV2TestSuite) TestConfigHash() {
+	x, err := collector.ConfigHash(map[string]any{"db": "/tmp/db", "members": map[string]string{"a": "b"}})
+	s.Require().NoError(err)
+	y, err := collector.ConfigHash(map[string]any{"db": "/tmp/db"})
+	s.Require().NoError(err)
+	s.Require().Equal(x, y)
+
+	z, err := collector.ConfigHash(map[string]any{"db": "/data/db"})
+	s.Require().NoError(err)
+	s.Require().NotEqual(x, z)
+
+	// secrets are not hashed
+	z, err = collector.ConfigHash(map[string]any{"db": "/tmp/db", "api-token": "secret", "token": "secret",
+		"passphrase-file": "/etc/tcpmon/passphrase"})
+	s.Require().NoError(err)
+	s.Require().Equal(x, z)
+	z, err = collector.ConfigHash(map[string]any{"db": "/tmp/db", "db-max-age": "24h"})
+	s.Require().NoError(err)
+	s.Require().NotEqual(x, z)
+}
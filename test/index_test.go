@@ -0,0 +1,176 @@
+package test
+
+import (
+	"path/filepath"
+	"time"
+
+	"github.com/spf13/afero"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/zperf/tcpmon/tcpmon/gproto"
+	"github.com/zperf/tcpmon/tcpmon/storage"
+)
+
+func marshalNic(ts int64) []byte {
+	buf, err := proto.Marshal(&gproto.Metric{Body: &gproto.Metric_Nic{Nic: &gproto.NicMetric{
+		Timestamp: ts,
+		Type:      gproto.MetricType_NIC,
+	}}})
+	if err != nil {
+		panic(err)
+	}
+	return buf
+}
+
+// writeIndexed writes a TCP snapshot and a NIC metric for each snapshot, returns the snapshots. Files are created in
+// the order of tcpmon-dataf-1, tcpmon-dataf-tcp-2, tcpmon-dataf-nic-3, tcpmon-dataf-tcp-4, ...
+func (s *StorageV2TestSuite) writeIndexed(n int) []*gproto.TcpMetric {
+	snapshots, err := newWorkload(n)
+	s.Require().NoError(err)
+
+	cfg := storage.NewConfig(s.baseDir).
+		WithFs(s.fs).
+		WithMaxSize(1 << 40).
+		WithMaxEntriesPerFile(8).
+		WithKeyframeInterval(3)
+
+	ds, err := storage.NewDataStore(cfg)
+	s.Require().NoError(err)
+	head, err := proto.Marshal(&gproto.Metric{Body: &gproto.Metric_Host{Host: &gproto.HostInfo{
+		Timestamp: snapshots[0].GetTimestamp(),
+		Type:      gproto.MetricType_HOST,
+		Hostname:  "node-1",
+	}}})
+	s.Require().NoError(err)
+	s.Require().NoError(ds.SetHeadRecord(head))
+	for _, t := range snapshots {
+		s.Require().NoError(ds.Put(marshalTcp(t)))
+		s.Require().NoError(ds.Put(marshalNic(t.GetTimestamp())))
+	}
+	s.Require().NoError(ds.Close())
+	return snapshots
+}
+
+func (s *StorageV2TestSuite) rangeMetrics(from, to int64, types ...gproto.MetricType) []*gproto.Metric {
+	r, err := storage.NewDataStoreReader(storage.NewReaderConfig(s.baseDir).WithFs(s.fs))
+	s.Require().NoError(err)
+	defer r.Close()
+
+	var fromTime, toTime time.Time
+	if from != 0 {
+		fromTime = time.Unix(from, 0)
+	}
+	if to != 0 {
+		toTime = time.Unix(to, 0)
+	}
+
+	metrics := make([]*gproto.Metric, 0)
+	err = r.Range(fromTime, toTime, func(buf []byte) error {
+		var m gproto.Metric
+		err := proto.Unmarshal(buf, &m)
+		if err != nil {
+			return err
+		}
+		metrics = append(metrics, &m)
+		return nil
+	}, types...)
+	s.Require().NoError(err)
+	return metrics
+}
+
+func (s *StorageV2TestSuite) TestIndex() {
+	snapshots := s.writeIndexed(10)
+
+	// 1 head record + 8 socket tables in the first file of the TCP stream
+	f := filepath.Join(s.baseDir, storage.DataFileName(storage.StreamTcp, 2)+storage.SealFileSuffix)
+	index, err := storage.LoadIndex(s.fs, f)
+	s.Require().NoError(err)
+	s.Require().EqualValues(9, index.GetCount())
+	s.Require().Equal(snapshots[0].GetTimestamp(), index.GetMinTimestamp())
+	s.Require().Equal(snapshots[7].GetTimestamp(), index.GetMaxTimestamp())
+	s.Require().Len(index.GetOffsets(), 9)
+
+	counts := make(map[gproto.MetricType]uint32)
+	for _, c := range index.GetTypeCounts() {
+		counts[c.GetType()] = c.GetCount()
+	}
+	s.Require().Equal(map[gproto.MetricType]uint32{
+		gproto.MetricType_TCP:       3,
+		gproto.MetricType_TCP_DELTA: 5,
+		gproto.MetricType_HOST:      1,
+	}, counts)
+
+	// offsets point to the records
+	r, err := storage.NewDataFileReader(f, s.fs)
+	s.Require().NoError(err)
+	defer r.Close()
+	for i, offset := range index.GetOffsets() {
+		s.Require().NoError(r.SkipTo(int64(offset)))
+		buf, err := r.Read()
+		s.Require().NoError(err)
+
+		var m gproto.Metric
+		s.Require().NoError(proto.Unmarshal(buf, &m))
+		s.Require().Equal(index.GetTimestamps()[i], getMetricTimestamp(&m))
+	}
+
+	// the unsealed file has no index yet
+	_, err = storage.LoadIndex(s.fs, filepath.Join(s.baseDir, storage.DataFileName(storage.StreamTcp, 4)))
+	s.Require().ErrorIs(err, storage.ErrIndexNotFound)
+}
+
+func (s *StorageV2TestSuite) TestRange() {
+	snapshots := s.writeIndexed(12)
+	ts := func(i int) int64 {
+		return snapshots[i].GetTimestamp()
+	}
+
+	for _, rebuild := range []bool{false, true} {
+		if rebuild {
+			indexes, err := afero.Glob(s.fs, filepath.Join(s.baseDir, storage.IndexFilePrefix+"*"))
+			s.Require().NoError(err)
+			s.Require().NotEmpty(indexes)
+			for _, f := range indexes {
+				s.Require().NoError(s.fs.Remove(f))
+			}
+		}
+
+		// deltas are decoded from the keyframe before the range
+		metrics := s.rangeMetrics(ts(5), ts(9), gproto.MetricType_TCP)
+		s.Require().Len(metrics, 5)
+		for i, m := range metrics {
+			s.Require().True(proto.Equal(snapshots[5+i], m.GetTcp()), "snapshot %d", 5+i)
+		}
+
+		metrics = s.rangeMetrics(ts(2), 0, gproto.MetricType_NIC)
+		s.Require().Len(metrics, 10)
+		for i, m := range metrics {
+			s.Require().Equal(ts(2+i), m.GetNic().GetTimestamp())
+		}
+
+		// all types, including the head records of 5 files: 1 of the default stream, 2 of TCP and 2 of NIC
+		metrics = s.rangeMetrics(0, 0)
+		s.Require().Len(metrics, 12*2+5)
+
+		s.Require().Empty(s.rangeMetrics(ts(11)+1, 0))
+
+		// the head records are not counted in the time range of files, only the first TCP and NIC files are read
+		metrics = s.rangeMetrics(ts(0), ts(1))
+		s.Require().Len(metrics, 2*2+2)
+	}
+}
+
+func getMetricTimestamp(m *gproto.Metric) int64 {
+	switch {
+	case m.GetTcp() != nil:
+		return m.GetTcp().GetTimestamp()
+	case m.GetTcpDelta() != nil:
+		return m.GetTcpDelta().GetTimestamp()
+	case m.GetNic() != nil:
+		return m.GetNic().GetTimestamp()
+	case m.GetHost() != nil:
+		return m.GetHost().GetTimestamp()
+	default:
+		return -1
+	}
+}
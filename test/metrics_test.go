@@ -0,0 +1,139 @@
+package test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/zperf/tcpmon/tcpmon/export/prometheus"
+	"github.com/zperf/tcpmon/tcpmon/gproto"
+	"github.com/zperf/tcpmon/tcpmon/server"
+)
+
+type MetricsTestSuite struct {
+	suite.Suite
+}
+
+func TestMetrics(t *testing.T) {
+	suite.Run(t, &MetricsTestSuite{})
+}
+
+func newMetricsSnapshot() *prometheus.Snapshot {
+	listener := newSocket("0.0.0.0:80", "0.0.0.0:*", 1, gproto.SocketState_TCP_LISTEN)
+	listener.RecvQ = 3
+	listener.SendQ = 128
+	listener.Processes = []*gproto.ProcessInfo{{Name: `ngi"nx`, Pid: 1}}
+
+	tcp := &gproto.TcpMetric{Timestamp: 1700000000, Type: gproto.MetricType_TCP,
+		Sockets: []*gproto.SocketMetric{listener}}
+	for i, retrans := range []uint32{0, 7, 2} {
+		s := newSocket("10.0.0.1:80", "10.0.0.2:500"+string(rune('0'+i)), uint64(i+2),
+			gproto.SocketState_TCP_ESTABLISHED)
+		s.Rtt = 0.2 * float64(i+1)
+		s.RetransTotal = retrans
+		tcp.Sockets = append(tcp.Sockets, s)
+	}
+	tcp.Sockets = append(tcp.Sockets, newSocket("10.0.0.1:80", "10.0.0.3:5000", 9, gproto.SocketState_TCP_TIME_WAIT))
+
+	return &prometheus.Snapshot{
+		Tcp: tcp,
+		Nic: &gproto.NicMetric{Timestamp: 1700000000, Type: gproto.MetricType_NIC,
+			Ifaces: []*gproto.IfaceMetric{{Name: "eth0", RxDropped: 5}}},
+		Net: &gproto.NetstatMetric{Timestamp: 1700000000, Type: gproto.MetricType_NET,
+			TcpRetransSegs: 42, TcpCurrEstab: 3},
+	}
+}
+
+func (s *MetricsTestSuite) write(snapshot *prometheus.Snapshot, format prometheus.Format,
+	config prometheus.Config) string {
+	var buf bytes.Buffer
+	s.Require().NoError(prometheus.Write(&buf, snapshot, format, config))
+	return buf.String()
+}
+
+func (s *MetricsTestSuite) TestText() {
+	text := s.write(newMetricsSnapshot(), prometheus.FormatText, prometheus.Config{})
+	lines := strings.Split(text, "\n")
+
+	s.Contains(lines, `tcpmon_collect_timestamp_seconds{type="tcp"} 1.7e+09`)
+	s.Contains(lines, `# TYPE tcpmon_netstat_tcp_retrans_segs_total counter`)
+	s.Contains(lines, `tcpmon_netstat_tcp_retrans_segs_total 42`)
+	s.Contains(lines, `# TYPE tcpmon_netstat_tcp_curr_estab gauge`)
+	s.Contains(lines, `tcpmon_netstat_tcp_curr_estab 3`)
+	s.Contains(lines, `tcpmon_nic_rx_dropped_total{iface="eth0"} 5`)
+
+	s.Contains(lines, `tcpmon_sockets{state="established"} 3`)
+	s.Contains(lines, `tcpmon_sockets{state="time_wait"} 1`)
+	s.Contains(lines, `tcpmon_sockets{state="listen"} 1`)
+	s.Contains(lines, `tcpmon_sockets{state="close_wait"} 0`)
+
+	// the listener and the socket without TCP info are not observed
+	s.Contains(lines, `tcpmon_sockets_rtt_milliseconds_bucket{le="0.5"} 2`)
+	s.Contains(lines, `tcpmon_sockets_rtt_milliseconds_bucket{le="+Inf"} 3`)
+	s.Contains(lines, `tcpmon_sockets_rtt_milliseconds_count 3`)
+	s.Contains(lines, `tcpmon_sockets_retrans_bucket{le="0"} 1`)
+	s.Contains(lines, `tcpmon_sockets_retrans_bucket{le="5"} 2`)
+	s.Contains(lines, `tcpmon_sockets_retrans_sum 9`)
+
+	s.Contains(lines, `tcpmon_listener_accept_queue{local="0.0.0.0:80",process="ngi\"nx"} 3`)
+	s.Contains(lines, `tcpmon_listener_accept_queue_max{local="0.0.0.0:80",process="ngi\"nx"} 128`)
+	s.Contains(lines, `tcpmon_scrape_dropped_series 0`)
+	s.NotContains(text, "tcpmon_socket_rtt_milliseconds")
+	s.NotContains(text, "# EOF")
+}
+
+func (s *MetricsTestSuite) TestOpenMetrics() {
+	s.Equal(prometheus.FormatOpenMetrics,
+		prometheus.NegotiateFormat("application/openmetrics-text;version=1.0.0,text/plain;q=0.5"))
+	s.Equal(prometheus.FormatText, prometheus.NegotiateFormat("text/plain"))
+	s.Equal(prometheus.FormatText, prometheus.NegotiateFormat(""))
+
+	text := s.write(newMetricsSnapshot(), prometheus.FormatOpenMetrics, prometheus.Config{})
+	lines := strings.Split(text, "\n")
+
+	// counter families are named without _total
+	s.Contains(lines, `# TYPE tcpmon_netstat_tcp_retrans_segs counter`)
+	s.Contains(lines, `tcpmon_netstat_tcp_retrans_segs_total 42`)
+	s.Contains(lines, `# TYPE tcpmon_nic_rx_dropped counter`)
+	s.True(strings.HasSuffix(text, "# EOF\n"))
+}
+
+func (s *MetricsTestSuite) TestTopSockets() {
+	snapshot := newMetricsSnapshot()
+
+	text := s.write(snapshot, prometheus.FormatText, prometheus.Config{TopN: 2})
+	lines := strings.Split(text, "\n")
+	s.Contains(lines, `tcpmon_socket_retrans{local="10.0.0.1:80",peer="10.0.0.2:5001",process=""} 7`)
+	s.Contains(lines, `tcpmon_socket_retrans{local="10.0.0.1:80",peer="10.0.0.2:5002",process=""} 2`)
+	s.NotContains(text, `peer="10.0.0.2:5000"`)
+	s.Contains(lines, `tcpmon_scrape_dropped_series 0`)
+
+	// the listener takes one of the two label sets
+	text = s.write(snapshot, prometheus.FormatText, prometheus.Config{TopN: 2, MaxSeries: 2})
+	lines = strings.Split(text, "\n")
+	s.Contains(lines, `tcpmon_listener_accept_queue{local="0.0.0.0:80",process="ngi\"nx"} 3`)
+	s.Contains(lines, `tcpmon_socket_retrans{local="10.0.0.1:80",peer="10.0.0.2:5001",process=""} 7`)
+	s.NotContains(text, `peer="10.0.0.2:5002"`)
+	s.Contains(lines, `tcpmon_scrape_dropped_series 1`)
+}
+
+func (s *MetricsTestSuite) TestLatestMetrics() {
+	latest := server.NewLatestMetrics()
+	empty := s.write(latest.Snapshot(), prometheus.FormatText, prometheus.Config{})
+	s.Contains(empty, "# TYPE tcpmon_collect_timestamp_seconds gauge")
+	s.NotContains(empty, "tcpmon_sockets")
+
+	snapshot := newMetricsSnapshot()
+	latest.SetTcp(snapshot.Tcp)
+	latest.SetNic(snapshot.Nic)
+	latest.SetNet(snapshot.Net)
+	s.Equal(snapshot, latest.Snapshot())
+
+	// the snapshot is a copy, later metrics don't change it
+	previous := latest.Snapshot()
+	latest.SetTcp(&gproto.TcpMetric{Timestamp: 1700000001})
+	s.Same(snapshot.Tcp, previous.Tcp)
+	s.EqualValues(1700000001, latest.Snapshot().Tcp.GetTimestamp())
+}
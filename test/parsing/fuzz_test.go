@@ -0,0 +1,51 @@
+package parsing
+
+import (
+	"os"
+	"testing"
+
+	"github.com/rs/zerolog"
+)
+
+// fuzzParser seeds the corpus with fixtures in testdata/<kind> and checks the parser never panics or exits.
+// Run with: go test ./test/parsing -run '^$' -fuzz FuzzParseSS
+func fuzzParser(f *testing.F, kind string) {
+	// parsers log warnings for every malformed number, keep the fuzzer output readable
+	level := zerolog.GlobalLevel()
+	zerolog.SetGlobalLevel(zerolog.Disabled)
+	f.Cleanup(func() {
+		zerolog.SetGlobalLevel(level)
+	})
+
+	files, err := fixtures(kind)
+	if err != nil {
+		f.Fatal(err)
+	}
+	for _, file := range files {
+		data, err := os.ReadFile(file)
+		if err != nil {
+			f.Fatal(err)
+		}
+		f.Add(data)
+	}
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		_, _ = parseFixture(kind, data)
+	})
+}
+
+func FuzzParseSS(f *testing.F) {
+	fuzzParser(f, "ss")
+}
+
+func FuzzParseIfconfigOutput(f *testing.F) {
+	fuzzParser(f, "ifconfig")
+}
+
+func FuzzParseSnmp(f *testing.F) {
+	fuzzParser(f, "snmp")
+}
+
+func FuzzParseNetstat(f *testing.F) {
+	fuzzParser(f, "netstat")
+}
@@ -0,0 +1,105 @@
+package parsing
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/zperf/tcpmon/tcpmon/gproto"
+	"github.com/zperf/tcpmon/tcpmon/parsing"
+	"github.com/zperf/tcpmon/tcpmon/tutils"
+)
+
+// go test ./test/parsing -run TestParsing/TestGolden -update
+var updateGolden = flag.Bool("update", false, "update golden files in testdata/golden")
+
+// parseFixture parses the output of the given kind, kind is the sub directory name in testdata
+func parseFixture(kind string, data []byte) (proto.Message, error) {
+	switch kind {
+	case "ss":
+		var m gproto.TcpMetric
+		m.Type = gproto.MetricType_TCP
+		err := parsing.ParseSS(&m, strings.FieldsFunc(string(data), tutils.SplitNewline))
+		return &m, err
+	case "ifconfig":
+		var m gproto.NicMetric
+		m.Type = gproto.MetricType_NIC
+		err := parsing.ParseIfconfigOutput(&m, strings.FieldsFunc(string(data), tutils.SplitNewline))
+		return &m, err
+	case "snmp":
+		var m gproto.NetstatMetric
+		m.Type = gproto.MetricType_NET
+		err := parsing.ParseSnmp(bytes.NewReader(data), &m)
+		return &m, err
+	case "netstat":
+		var m gproto.NetstatMetric
+		m.Type = gproto.MetricType_NET
+		err := parsing.ParseNetstat(bytes.NewReader(data), &m)
+		return &m, err
+	default:
+		panic("unknown fixture kind " + kind)
+	}
+}
+
+// toStableJSON marshals the message to JSON, protojson randomizes whitespaces so the output is re-indented
+func toStableJSON(m proto.Message) ([]byte, error) {
+	buf, err := protojson.MarshalOptions{EmitUnpopulated: true}.Marshal(m)
+	if err != nil {
+		return nil, err
+	}
+
+	var compact bytes.Buffer
+	err = json.Compact(&compact, buf)
+	if err != nil {
+		return nil, err
+	}
+
+	var out bytes.Buffer
+	err = json.Indent(&out, compact.Bytes(), "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	out.WriteByte('\n')
+	return out.Bytes(), nil
+}
+
+func fixtures(kind string) ([]string, error) {
+	return filepath.Glob(filepath.Join("testdata", kind, "*.txt"))
+}
+
+func (s *ParsingTestSuite) TestGolden() {
+	for _, kind := range []string{"ss", "ifconfig", "snmp", "netstat"} {
+		files, err := fixtures(kind)
+		s.Require().NoError(err)
+		s.Require().NotEmpty(files, kind)
+
+		for _, file := range files {
+			data, err := os.ReadFile(file)
+			s.Require().NoError(err)
+
+			m, err := parseFixture(kind, data)
+			s.Require().NoError(err, file)
+
+			actual, err := toStableJSON(m)
+			s.Require().NoError(err)
+
+			golden := filepath.Join("testdata", "golden", kind,
+				strings.TrimSuffix(filepath.Base(file), ".txt")+".json")
+			if *updateGolden {
+				s.Require().NoError(os.MkdirAll(filepath.Dir(golden), 0755))
+				s.Require().NoError(os.WriteFile(golden, actual, 0644))
+				continue
+			}
+
+			expected, err := os.ReadFile(golden)
+			s.Require().NoError(err, "golden file missing, run with -update to create it")
+			s.Assert().Equal(string(expected), string(actual), file)
+		}
+	}
+}
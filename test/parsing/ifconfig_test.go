@@ -43,7 +43,8 @@ lo: flags=73<UP,LOOPBACK,RUNNING>  mtu 65536
 	var nic NicMetric
 	nic.Type = MetricType_NIC
 	nic.Timestamp = time.Now().UnixMilli()
-	ParseIfconfigOutput(&nic, lines)
+	err := ParseIfconfigOutput(&nic, lines)
+	s.Require().NoError(err)
 
 	assert.Equal(uint64(7), nic.Ifaces[0].TxOverruns)
 	assert.Equal(uint64(99999), nic.Ifaces[1].TxDropped)
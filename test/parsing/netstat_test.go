@@ -9,7 +9,7 @@ import (
 )
 
 func (s *ParsingTestSuite) TestParseNetstat() {
-	buf, err := os.ReadFile("netstat.txt")
+	buf, err := os.ReadFile("testdata/netstat/baseline.txt")
 	s.Require().NoError(err)
 
 	netstat := string(buf)
@@ -9,7 +9,7 @@ import (
 )
 
 func (s *ParsingTestSuite) TestParseSnmp() {
-	buf, err := os.ReadFile("snmp.txt")
+	buf, err := os.ReadFile("testdata/snmp/baseline.txt")
 	s.Require().NoError(err)
 
 	snmp := string(buf)
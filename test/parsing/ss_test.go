@@ -21,7 +21,8 @@ func (s *ParsingTestSuite) TestParseSS() {
 	var t TcpMetric
 	t.Timestamp = time.Now().Unix()
 	t.Type = MetricType_TCP
-	ParseSS(&t, lines)
+	err = ParseSS(&t, lines)
+	s.Require().NoError(err)
 
 	// check result count
 	c := lo.CountBy(t.Sockets, func(item *SocketMetric) bool {
@@ -73,3 +74,29 @@ func (s *ParsingTestSuite) TestParseSS() {
 	s.Assert().Equal("::ffff:10.255.0.102:35648", zoo.PeerAddr)
 	s.Assert().Equal(uint64(169202297), zoo.BytesAcked)
 }
+
+func (s *ParsingTestSuite) TestParseSSIdentity() {
+	lines := []string{
+		"State  Recv-Q Send-Q Local Address:Port  Peer Address:Port Process",
+		`ESTAB  0      0          127.0.0.1:48271    127.0.0.1:54700 users:(("envoy",pid=129,fd=10)) uid:65534 ino:26234 sk:5 cgroup:/ <->`,
+		`ESTAB  0      0      10.0.0.1:22     10.0.0.2:51514  users:(("sshd",pid=1234,fd=3)) timer:(keepalive,104min,0) ino:3456789 sk:ffff88003a4c1e00 <->`,
+		`ESTAB  0      0      10.0.0.1:22     10.0.0.2:51515  users:(("sshd",pid=1235,fd=3)) ino:3456790 sk:xyz <->`,
+	}
+
+	var t TcpMetric
+	err := ParseSS(&t, lines)
+	s.Require().NoError(err)
+	s.Require().Len(t.Sockets, 3)
+
+	s.Assert().Equal(uint64(5), t.Sockets[0].GetCookie())
+	s.Assert().Equal(uint64(26234), t.Sockets[0].GetInode())
+	s.Assert().Equal(uint32(65534), t.Sockets[0].GetUid())
+
+	// el7 prints the kernel address of the socket
+	s.Assert().Equal(uint64(0xffff88003a4c1e00), t.Sockets[1].GetCookie())
+	s.Assert().Equal(uint64(3456789), t.Sockets[1].GetInode())
+	s.Assert().Len(t.Sockets[1].GetTimers(), 1)
+
+	s.Assert().Equal(uint64(0), t.Sockets[2].GetCookie())
+	s.Assert().Equal(uint64(3456790), t.Sockets[2].GetInode())
+}
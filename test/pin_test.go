@@ -0,0 +1,81 @@
+package test
+
+import (
+	"path/filepath"
+	"time"
+
+	"github.com/zperf/tcpmon/tcpmon/gproto"
+	"github.com/zperf/tcpmon/tcpmon/storage"
+)
+
+func (s *StorageV2TestSuite) TestPin() {
+	cfg := storage.NewConfig(s.baseDir).
+		WithFs(s.fs).
+		WithMaxSize(32 << 10).
+		WithMaxEntriesPerFile(4).
+		WithKeyframeInterval(0)
+
+	ds, err := storage.NewDataStore(cfg)
+	s.Require().NoError(err)
+
+	const base = int64(1700000000)
+	for i := 0; i < 4; i++ {
+		s.Require().NoError(ds.Put(marshalTcp(newRandomTcp(base + int64(i)))))
+	}
+	pin, err := ds.Pin("packet loss", time.Unix(base+1, 0), time.Unix(base+2, 0), time.Time{})
+	s.Require().NoError(err)
+
+	// the pinned file is kept while the total size is over MaxSize
+	for i := 4; i < 40; i++ {
+		s.Require().NoError(ds.Put(marshalTcp(newRandomTcp(base + int64(i)))))
+	}
+	s.Require().False(s.exists("tcpmon-dataf-tcp-2.zst"))
+	s.Require().True(s.exists(filepath.Join(storage.PinDir, "tcpmon-dataf-tcp-2.zst")))
+	s.Require().True(s.exists(filepath.Join(storage.PinDir, "tcpmon-index-tcp-2")))
+	s.Require().False(s.exists("tcpmon-dataf-tcp-3.zst"))
+
+	pins, err := ds.Pins()
+	s.Require().NoError(err)
+	s.Require().Len(pins, 1)
+	s.Require().Equal("packet loss", pins[0].Label)
+	s.Require().Equal([]string{"tcpmon-dataf-tcp-2.zst"}, pins[0].Files)
+
+	// pinned records are read
+	metrics := s.rangeMetrics(base, base+3, gproto.MetricType_TCP)
+	s.Require().Len(metrics, 4)
+
+	// pins are persisted
+	s.Require().NoError(ds.Close())
+	ds, err = storage.NewDataStore(cfg)
+	s.Require().NoError(err)
+	pins, err = ds.Pins()
+	s.Require().NoError(err)
+	s.Require().Len(pins, 1)
+
+	// unpinned files are moved back
+	s.Require().NoError(ds.Unpin(pin.Id))
+	s.Require().ErrorIs(ds.Unpin(pin.Id), storage.ErrPinNotFound)
+	s.Require().True(s.exists("tcpmon-dataf-tcp-2.zst"))
+	s.Require().False(s.exists(filepath.Join(storage.PinDir, "tcpmon-dataf-tcp-2.zst")))
+	s.Require().NoError(ds.Close())
+}
+
+func (s *StorageV2TestSuite) TestPinExpires() {
+	ds, err := storage.NewDataStore(storage.NewConfig(s.baseDir).WithFs(s.fs).WithMaxEntriesPerFile(4))
+	s.Require().NoError(err)
+	defer ds.Close()
+
+	for i := 0; i < 4; i++ {
+		s.Require().NoError(ds.Put(marshalNic(int64(1700000000 + i))))
+	}
+	_, err = ds.Pin("", time.Unix(1700000000, 0), time.Unix(1700000003, 0), time.Now().Add(-time.Second))
+	s.Require().NoError(err)
+
+	pins, err := ds.Pins()
+	s.Require().NoError(err)
+	s.Require().Empty(pins)
+	s.Require().True(s.exists("tcpmon-dataf-nic-2.zst"))
+
+	_, err = ds.Pin("", time.Unix(1700000003, 0), time.Unix(1700000000, 0), time.Time{})
+	s.Require().Error(err)
+}
@@ -0,0 +1,215 @@
+package test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/zperf/tcpmon/tcpmon/gproto"
+	"github.com/zperf/tcpmon/tcpmon/server"
+	"github.com/zperf/tcpmon/tcpmon/storage"
+)
+
+const queryBase = 1700000000
+
+type queryResult struct {
+	Rows  []map[string]any `json:"rows"`
+	Len   int              `json:"len"`
+	Next  string           `json:"next"`
+	Error string           `json:"error"`
+}
+
+// newQueryServer writes 30 socket tables and NIC metrics, returns the HTTP handler of a monitor on the data dir
+func (s *StorageV2TestSuite) newQueryServer() (http.Handler, *server.Monitor) {
+	cfg := storage.NewConfig(s.baseDir).WithFs(s.fs).WithMaxEntriesPerFile(8).WithKeyframeInterval(4)
+	ds, err := storage.NewDataStore(cfg)
+	s.Require().NoError(err)
+	for i := 0; i < 30; i++ {
+		listener := newSocket("0.0.0.0:80", "0.0.0.0:*", 1, gproto.SocketState_TCP_LISTEN)
+		established := newSocket("10.0.0.1:80", "10.0.0.2:5000", 2, gproto.SocketState_TCP_ESTABLISHED)
+		established.Rtt = float64(i)
+		established.Processes = []*gproto.ProcessInfo{{Name: "nginx", Pid: 10}}
+		timeWait := newSocket("10.0.0.1:80", "10.0.0.3:6000", 3, gproto.SocketState_TCP_TIME_WAIT)
+		s.Require().NoError(ds.Put(marshalTcp(&gproto.TcpMetric{
+			Timestamp: queryBase + int64(i),
+			Type:      gproto.MetricType_TCP,
+			Sockets:   []*gproto.SocketMetric{listener, established, timeWait},
+		})))
+		nic, err := proto.Marshal(newNicMetric(queryBase+int64(i), uint64(i)))
+		s.Require().NoError(err)
+		s.Require().NoError(ds.Put(nic))
+	}
+	s.Require().NoError(ds.Close())
+
+	mon, err := server.New(server.MonitorConfig{
+		QuorumPort:      -1,
+		DataStoreConfig: *storage.NewConfig(s.baseDir).WithFs(s.fs).WithMaxEntriesPerFile(8),
+		EventBufferSize: 1,
+		QueryMaxResults: 10,
+	})
+	s.Require().NoError(err)
+
+	gin.SetMode(gin.TestMode)
+	engine := gin.New()
+	server.RegisterRoutes(engine, mon)
+	return engine, mon
+}
+
+func (s *StorageV2TestSuite) query(h http.Handler, params string) (int, string) {
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/api/v1/query?"+params, nil))
+	return w.Code, w.Body.String()
+}
+
+func (s *StorageV2TestSuite) queryJSON(h http.Handler, params string) *queryResult {
+	code, body := s.query(h, params)
+	s.Require().Equal(http.StatusOK, code, body)
+	var r queryResult
+	s.Require().NoError(json.Unmarshal([]byte(body), &r), body)
+	s.Require().Empty(r.Error)
+	s.Require().Len(r.Rows, r.Len)
+	return &r
+}
+
+func (s *StorageV2TestSuite) TestQuery() {
+	h, mon := s.newQueryServer()
+	defer mon.Close()
+
+	// fields and socket filters
+	r := s.queryJSON(h, "type=tcp&from=1700000010&to=1700000019&state=established&fields=rtt,skmem_rmem_alloc")
+	s.Require().Equal(10, r.Len)
+	s.Empty(r.Next)
+	for i, row := range r.Rows {
+		s.EqualValues(queryBase+10+i, row["timestamp"])
+		s.EqualValues(10+i, row["rtt"])
+		s.EqualValues(0, row["skmem_rmem_alloc"])
+		s.Equal("10.0.0.2:5000", row["peer_addr"])
+		s.Equal("established", row["state"])
+		s.Equal("nginx", row["process"])
+		s.NotContains(row, "cwnd")
+	}
+
+	r = s.queryJSON(h, "type=tcp&from=1700000000&to=1700000004&peer=:6000")
+	s.Require().Equal(5, r.Len)
+	s.Equal("time_wait", r.Rows[0]["state"])
+	r = s.queryJSON(h, "type=tcp&from=1700000000&to=1700000004&local=10.0.0.1&process=ngi")
+	s.Require().Equal(5, r.Len)
+	r = s.queryJSON(h, "type=nic&from=1700000020&fields=rx_errors")
+	s.Require().Equal(10, r.Len)
+	s.Equal(map[string]any{"timestamp": float64(queryBase + 20), "name": "eth0", "rx_errors": float64(20)},
+		r.Rows[0])
+
+	// pages of 4 rows over 5 socket tables of 3 sockets
+	seen := make(map[string]bool)
+	cursor := ""
+	pages := 0
+	for {
+		r = s.queryJSON(h, "type=tcp&from=1700000000&to=1700000004&limit=4&cursor="+cursor)
+		for _, row := range r.Rows {
+			key := fmt.Sprint(row["peer_addr"], "@", row["timestamp"])
+			s.False(seen[key], key)
+			seen[key] = true
+		}
+		pages++
+		if r.Next == "" {
+			s.Equal(3, r.Len)
+			break
+		}
+		s.Equal(4, r.Len)
+		cursor = r.Next
+	}
+	s.Equal(4, pages)
+	s.Len(seen, 15)
+
+	// the limit is capped by the max results
+	r = s.queryJSON(h, "type=tcp&limit=100")
+	s.Equal(10, r.Len)
+	s.Equal("1700000003-1", r.Next)
+
+	code, body := s.query(h, "type=tcp&from=1700000000&to=1700000001&state=established&fields=rtt&format=line")
+	s.Require().Equal(http.StatusOK, code)
+	lines := strings.Split(strings.TrimSpace(body), "\n")
+	s.Require().Len(lines, 2)
+	s.True(strings.HasPrefix(lines[0], "tcp,Hostname="), lines[0])
+	s.Contains(lines[0], ",local_addr=10.0.0.1:80,peer_addr=10.0.0.2:5000,state=established,process=nginx rtt=0 ")
+	s.True(strings.HasSuffix(lines[1], " rtt=1 1700000001"), lines[1])
+	code, body = s.query(h, "type=nic&limit=2&format=line")
+	s.Require().Equal(http.StatusOK, code)
+	s.True(strings.HasSuffix(body, "# next: 1700000002-0\n"), body)
+
+	for _, params := range []string{"", "type=unknown", "type=nic&state=established", "type=tcp&fields=unknown",
+		"type=tcp&state=unknown", "type=tcp&cursor=bad", "type=tcp&limit=0", "type=tcp&format=csv"} {
+		code, body = s.query(h, params)
+		s.Equal(http.StatusBadRequest, code, params)
+		s.Contains(body, "error", params)
+	}
+}
+
+func (s *StorageV2TestSuite) TestQueryRollup() {
+	cfg := storage.NewConfig(s.baseDir).
+		WithFs(s.fs).
+		WithMaxEntriesPerFile(30).
+		WithRollupLevels(storage.RollupLevel{Resolution: time.Minute, After: time.Hour})
+	ds, err := storage.NewDataStore(cfg)
+	s.Require().NoError(err)
+	now := time.Now()
+	old := now.Add(-3*time.Hour).Unix() / 600 * 600
+	for i := 0; i < 120; i++ {
+		buf, err := proto.Marshal(newNicMetric(old+int64(i), uint64(i)))
+		s.Require().NoError(err)
+		s.Require().NoError(ds.Put(buf))
+		s.Require().NoError(ds.Put(marshalTcp(&gproto.TcpMetric{
+			Timestamp: old + int64(i),
+			Type:      gproto.MetricType_TCP,
+			Sockets: []*gproto.SocketMetric{
+				newSocket("10.0.0.1:80", "10.0.0.2:5000", 2, gproto.SocketState_TCP_ESTABLISHED)},
+		})))
+	}
+	s.Require().NoError(ds.Compact(now))
+	s.Require().NoError(ds.Close())
+
+	mon, err := server.New(server.MonitorConfig{
+		QuorumPort:      -1,
+		DataStoreConfig: *cfg,
+		EventBufferSize: 1,
+	})
+	s.Require().NoError(err)
+	defer mon.Close()
+	gin.SetMode(gin.TestMode)
+	h := gin.New()
+	server.RegisterRoutes(h, mon)
+
+	// the raw metrics are rolled up
+	r := s.queryJSON(h, "type=nic")
+	s.Require().Equal(0, r.Len)
+	r = s.queryJSON(h, "type=rollup_nic&resolution=1m&fields=rx_errors")
+	s.Require().Equal(2, r.Len)
+	s.Equal(map[string]any{"timestamp": float64(old + 60), "key": "eth0", "samples": float64(60),
+		"rx_errors_min": float64(60), "rx_errors_max": float64(119), "rx_errors_avg": 89.5,
+		"rx_errors_last": float64(119)}, r.Rows[1])
+	r = s.queryJSON(h, "type=rollup_nic&resolution=10m")
+	s.Require().Equal(0, r.Len)
+	r = s.queryJSON(h, "type=rollup_tcp&resolution=1m&fields=sockets_established")
+	s.Require().Equal(2, r.Len)
+	s.Equal(map[string]any{"timestamp": float64(old), "key": "", "local_addr": "", "peer_addr": "",
+		"state": "established", "samples": float64(60), "sockets_established_min": float64(1),
+		"sockets_established_max": float64(1), "sockets_established_avg": float64(1),
+		"sockets_established_last": float64(1)}, r.Rows[0])
+
+	code, body := s.query(h, "type=rollup_nic&resolution=1m&fields=rx_errors&limit=1&format=line")
+	s.Require().Equal(http.StatusOK, code)
+	s.True(strings.HasPrefix(body, "rollup_nic,Hostname="), body)
+
+	for _, params := range []string{"type=rollup_nic", "type=rollup_nic&resolution=1ms", "type=nic&resolution=1m",
+		"type=rollup_nic&resolution=1m&fields=rx_errors_max", "type=rollup_tcp&resolution=bad"} {
+		code, body = s.query(h, params)
+		s.Equal(http.StatusBadRequest, code, params)
+		s.Contains(body, "error", params)
+	}
+}
@@ -0,0 +1,205 @@
+package test
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"path/filepath"
+	"runtime"
+
+	"github.com/spf13/afero"
+
+	"github.com/zperf/tcpmon/tcpmon/export/influxdb"
+	"github.com/zperf/tcpmon/tcpmon/storage"
+)
+
+func newRecord(i int) []byte {
+	return []byte(fmt.Sprintf("record-%04d", i))
+}
+
+// writeRecords writes n records into a single data file and leaves it unsealed like a crashed process
+func (s *StorageV2TestSuite) writeRecords(n int) string {
+	ds, err := storage.NewDataStore(storage.NewConfig(s.baseDir).WithFs(s.fs))
+	s.Require().NoError(err)
+	for i := 0; i < n; i++ {
+		s.Require().NoError(ds.Put(newRecord(i)))
+	}
+	s.Require().NoError(ds.Close())
+
+	files, err := afero.Glob(s.fs, filepath.Join(s.baseDir, storage.DataFilePrefix+"*"))
+	s.Require().NoError(err)
+	s.Require().Len(files, 1)
+	return files[0]
+}
+
+func (s *StorageV2TestSuite) readRecords() []string {
+	r, err := storage.NewDataStoreReader(storage.NewReaderConfig(s.baseDir).WithFs(s.fs))
+	s.Require().NoError(err)
+	defer r.Close()
+
+	records := make([]string, 0)
+	err = r.Iterate(func(buf []byte) error {
+		records = append(records, string(buf))
+		return nil
+	})
+	s.Require().NoError(err)
+	return records
+}
+
+func (s *StorageV2TestSuite) reopen() {
+	ds, err := storage.NewDataStore(storage.NewConfig(s.baseDir).WithFs(s.fs))
+	s.Require().NoError(err)
+	s.Require().NoError(ds.Close())
+}
+
+func (s *StorageV2TestSuite) TestRecoverTornTail() {
+	recordSize := int64(storage.ChecksumHeaderSize + len(newRecord(0)))
+
+	for _, cut := range []int64{
+		1,                                   // torn body
+		recordSize - storage.HeaderSize - 2, // torn checksum
+		recordSize - storage.HeaderSize + 2, // torn header
+	} {
+		s.SetupTest()
+		f := s.writeRecords(5)
+
+		fh, err := s.fs.OpenFile(f, os.O_RDWR, 0644)
+		s.Require().NoError(err)
+		s.Require().NoError(fh.Truncate(5*recordSize - cut))
+		s.Require().NoError(fh.Close())
+
+		// the torn tail is truncated, and the file is sealed
+		s.reopen()
+		_, err = s.fs.Stat(f)
+		s.Require().True(os.IsNotExist(err))
+
+		r, err := storage.NewDataFileReader(f+storage.SealFileSuffix, s.fs)
+		s.Require().NoError(err)
+		for i := 0; i < 4; i++ {
+			buf, err := r.Read()
+			s.Require().NoError(err)
+			s.Require().Equal(newRecord(i), buf)
+		}
+		_, err = r.Read()
+		s.Require().ErrorIs(err, io.EOF, "cut %d", cut)
+		r.Close()
+
+		s.Require().Len(s.readRecords(), 4)
+	}
+}
+
+func (s *StorageV2TestSuite) TestChecksumMismatch() {
+	recordSize := int64(storage.ChecksumHeaderSize + len(newRecord(0)))
+	f := s.writeRecords(5)
+
+	// flip a bit in the body of the third record
+	buf, err := afero.ReadFile(s.fs, f)
+	s.Require().NoError(err)
+	buf[2*recordSize+storage.ChecksumHeaderSize+3] ^= 0x10
+	s.Require().NoError(afero.WriteFile(s.fs, f, buf, 0644))
+
+	r, err := storage.NewDataFileReader(f, s.fs)
+	s.Require().NoError(err)
+	for i := 0; i < 5; i++ {
+		buf, err := r.Read()
+		if i == 2 {
+			s.Require().ErrorIs(err, storage.ErrChecksumMismatch)
+			continue
+		}
+		s.Require().NoError(err)
+		s.Require().Equal(newRecord(i), buf)
+	}
+	r.Close()
+
+	// recovery keeps the corrupted record, readers skip it
+	s.reopen()
+	s.Require().Equal([]string{
+		string(newRecord(0)), string(newRecord(1)), string(newRecord(3)), string(newRecord(4)),
+	}, s.readRecords())
+}
+
+func (s *StorageV2TestSuite) TestRecordSizeCorrupted() {
+	recordSize := int64(storage.ChecksumHeaderSize + len(newRecord(0)))
+	f := s.writeRecords(5)
+
+	// the body size of the fourth record is 4GiB
+	buf, err := afero.ReadFile(s.fs, f)
+	s.Require().NoError(err)
+	binary.LittleEndian.PutUint32(buf[3*recordSize+2:], math.MaxUint32)
+	s.Require().NoError(afero.WriteFile(s.fs, f, buf, 0644))
+
+	var before, after runtime.MemStats
+	runtime.ReadMemStats(&before)
+	r, err := storage.NewDataFileReader(f, s.fs)
+	s.Require().NoError(err)
+	for i := 0; i < 3; i++ {
+		buf, err := r.Read()
+		s.Require().NoError(err)
+		s.Require().Equal(newRecord(i), buf)
+	}
+	_, err = r.Read()
+	s.Require().ErrorIs(err, storage.ErrTornRecord)
+	r.Close()
+	runtime.ReadMemStats(&after)
+	s.Require().Less(after.TotalAlloc-before.TotalAlloc, uint64(storage.MaxRecordSize))
+
+	// the rest is truncated as a torn tail
+	s.reopen()
+	s.Require().Len(s.readRecords(), 3)
+
+	ds, err := storage.NewDataStore(storage.NewConfig(s.baseDir).WithFs(s.fs))
+	s.Require().NoError(err)
+	s.Require().Error(ds.Put(make([]byte, storage.MaxRecordSize+1)))
+	s.Require().NoError(ds.Close())
+}
+
+func (s *StorageV2TestSuite) TestReadLegacyRecord() {
+	f := filepath.Join(s.baseDir, storage.DataFilePrefix+"1")
+
+	var buf []byte
+	for i := 0; i < 3; i++ {
+		body := newRecord(i)
+		buf = binary.LittleEndian.AppendUint16(buf, storage.Version)
+		buf = binary.LittleEndian.AppendUint32(buf, uint32(len(body)))
+		buf = append(buf, body...)
+	}
+	s.Require().NoError(afero.WriteFile(s.fs, f, buf, 0644))
+
+	s.reopen()
+	s.Require().Equal([]string{
+		string(newRecord(0)), string(newRecord(1)), string(newRecord(2)),
+	}, s.readRecords())
+}
+
+func (s *StorageV2TestSuite) TestExportTornTail() {
+	ds, err := storage.NewDataStore(storage.NewConfig(s.baseDir).WithFs(s.fs))
+	s.Require().NoError(err)
+	s.Require().NoError(ds.SetHeadRecord(newHostInfo("node-1")))
+	s.Require().NoError(ds.Put(newRecord(0)))
+	s.Require().NoError(ds.Close())
+
+	files, err := afero.Glob(s.fs, filepath.Join(s.baseDir, storage.DataFilePrefix+"*"))
+	s.Require().NoError(err)
+	s.Require().Len(files, 1)
+
+	fh, err := s.fs.OpenFile(files[0], os.O_RDWR, 0644)
+	s.Require().NoError(err)
+	stat, err := fh.Stat()
+	s.Require().NoError(err)
+	s.Require().NoError(fh.Truncate(stat.Size() - 1))
+	s.Require().NoError(fh.Close())
+
+	exporter, err := influxdb.NewFastExporter(files[0], s.fs)
+	s.Require().NoError(err)
+	defer exporter.Close()
+
+	ranges, err := exporter.Scan()
+	s.Require().NoError(err)
+	s.Require().Len(ranges, 1)
+
+	hostname, err := exporter.Hostname()
+	s.Require().NoError(err)
+	s.Require().Equal("node-1", hostname)
+}
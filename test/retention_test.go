@@ -0,0 +1,145 @@
+package test
+
+import (
+	"encoding/hex"
+	"path/filepath"
+	"time"
+
+	"github.com/spf13/afero"
+
+	"github.com/zperf/tcpmon/tcpmon/gproto"
+	"github.com/zperf/tcpmon/tcpmon/storage"
+)
+
+// newRandomTcp returns a socket table which doesn't compress well
+func newRandomTcp(ts int64) *gproto.TcpMetric {
+	return &gproto.TcpMetric{
+		Timestamp: ts,
+		Type:      gproto.MetricType_TCP,
+		Sockets:   []*gproto.SocketMetric{{LocalAddr: hex.EncodeToString(randBuf(2048))}},
+	}
+}
+
+func (s *StorageV2TestSuite) exists(f string) bool {
+	ok, err := afero.Exists(s.fs, filepath.Join(s.baseDir, f))
+	s.Require().NoError(err)
+	return ok
+}
+
+func (s *StorageV2TestSuite) TestStreams() {
+	ds, err := storage.NewDataStore(storage.NewConfig(s.baseDir).WithFs(s.fs))
+	s.Require().NoError(err)
+	s.Require().NoError(ds.Put(randBuf(64)))
+	s.Require().NoError(ds.Put(marshalNic(1700000000)))
+	s.Require().NoError(ds.Put(marshalTcp(newRandomTcp(1700000000))))
+	s.Require().NoError(ds.Close())
+
+	s.Require().True(s.exists("tcpmon-dataf-1"))
+	s.Require().True(s.exists("tcpmon-dataf-nic-2"))
+	s.Require().True(s.exists("tcpmon-dataf-tcp-3"))
+
+	s.Require().Equal(storage.StreamDefault, storage.StreamOfFile("tcpmon-dataf-1.zst"))
+	s.Require().Equal(storage.StreamNic, storage.StreamOfFile("tcpmon-dataf-nic-2"))
+	s.Require().Equal(storage.StreamTcp, storage.StreamOfFile("tcpmon-dataf-tcp-3.zst"))
+}
+
+func (s *StorageV2TestSuite) TestStreamMaxSize() {
+	cfg := storage.NewConfig(s.baseDir).
+		WithFs(s.fs).
+		WithMaxSize(1 << 40).
+		WithStreamMaxSize(storage.StreamNic, 1).
+		WithMaxEntriesPerFile(4).
+		WithKeyframeInterval(0)
+
+	ds, err := storage.NewDataStore(cfg)
+	s.Require().NoError(err)
+	for i := 0; i < 10; i++ {
+		s.Require().NoError(ds.Put(marshalNic(int64(1700000000 + i))))
+		s.Require().NoError(ds.Put(marshalTcp(newRandomTcp(int64(1700000000 + i)))))
+	}
+	s.Require().NoError(ds.Close())
+
+	// sealed NIC files are deleted, TCP files are kept
+	s.Require().False(s.exists("tcpmon-dataf-nic-2.zst"))
+	s.Require().False(s.exists("tcpmon-index-nic-2"))
+	s.Require().False(s.exists("tcpmon-dataf-nic-4.zst"))
+	s.Require().True(s.exists("tcpmon-dataf-nic-6"))
+	s.Require().True(s.exists("tcpmon-dataf-tcp-3.zst"))
+	s.Require().True(s.exists("tcpmon-dataf-tcp-5.zst"))
+}
+
+func (s *StorageV2TestSuite) TestSharedMaxSize() {
+	const maxSize = 32 << 10
+	cfg := storage.NewConfig(s.baseDir).
+		WithFs(s.fs).
+		WithMaxSize(maxSize).
+		WithStreamMaxSize(storage.StreamNic, 1<<20).
+		WithMaxEntriesPerFile(4).
+		WithKeyframeInterval(0)
+
+	ds, err := storage.NewDataStore(cfg)
+	s.Require().NoError(err)
+	for i := 0; i < 40; i++ {
+		s.Require().NoError(ds.Put(marshalNic(int64(1700000000 + i))))
+		s.Require().NoError(ds.Put(marshalTcp(newRandomTcp(int64(1700000000 + i)))))
+	}
+
+	// socket tables are evicted, the NIC history is kept
+	size, err := ds.TotalSize()
+	s.Require().NoError(err)
+	s.Require().LessOrEqual(size, int64(maxSize))
+	s.Require().False(s.exists("tcpmon-dataf-tcp-3.zst"))
+	s.Require().True(s.exists("tcpmon-dataf-nic-2.zst"))
+	s.Require().NoError(ds.Close())
+}
+
+func (s *StorageV2TestSuite) TestMaxSizeSidecars() {
+	const maxSize = 16 << 10
+	cfg := storage.NewConfig(s.baseDir).
+		WithFs(s.fs).
+		WithMaxSize(maxSize).
+		WithMaxEntriesPerFile(100)
+
+	ds, err := storage.NewDataStore(cfg)
+	s.Require().NoError(err)
+	for i := 0; i < 2000; i++ {
+		s.Require().NoError(ds.Put(marshalNic(int64(1700000000 + i))))
+	}
+
+	// indexes and columnar segments are counted with their data files
+	indexes, err := afero.Glob(s.fs, filepath.Join(s.baseDir, storage.IndexFilePrefix+"*"))
+	s.Require().NoError(err)
+	s.Require().NotEmpty(indexes)
+	size, err := ds.TotalSize()
+	s.Require().NoError(err)
+	s.Require().LessOrEqual(size, int64(maxSize))
+	s.Require().NoError(ds.Close())
+}
+
+func (s *StorageV2TestSuite) TestMaxAge() {
+	cfg := storage.NewConfig(s.baseDir).
+		WithFs(s.fs).
+		WithMaxAge(time.Hour).
+		WithMaxEntriesPerFile(4)
+
+	ds, err := storage.NewDataStore(cfg)
+	s.Require().NoError(err)
+
+	// the file is deleted once sealed
+	old := time.Now().Add(-2 * time.Hour).Unix()
+	for i := 0; i < 4; i++ {
+		s.Require().NoError(ds.Put(marshalNic(old + int64(i))))
+	}
+	s.Require().False(s.exists("tcpmon-dataf-nic-2.zst"))
+	s.Require().False(s.exists("tcpmon-index-nic-2"))
+
+	// files with recent records are kept
+	now := time.Now().Unix()
+	for i := 0; i < 4; i++ {
+		s.Require().NoError(ds.Put(marshalNic(old + int64(i))))
+		s.Require().NoError(ds.Put(marshalNic(now + int64(i))))
+	}
+	s.Require().NoError(ds.Close())
+	s.Require().True(s.exists("tcpmon-dataf-nic-3.zst"))
+	s.Require().True(s.exists("tcpmon-dataf-nic-4.zst"))
+}
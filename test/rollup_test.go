@@ -0,0 +1,236 @@
+package test
+
+import (
+	"bytes"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/zperf/tcpmon/tcpmon/export/influxdb"
+	"github.com/zperf/tcpmon/tcpmon/gproto"
+	"github.com/zperf/tcpmon/tcpmon/rollup"
+	"github.com/zperf/tcpmon/tcpmon/storage"
+)
+
+type RollupTestSuite struct {
+	suite.Suite
+}
+
+func TestRollup(t *testing.T) {
+	suite.Run(t, &RollupTestSuite{})
+}
+
+// the start of a 10m window
+const rollupBase = int64(1700000400)
+
+func newNicMetric(ts int64, rxErrors uint64) *gproto.Metric {
+	return &gproto.Metric{Body: &gproto.Metric_Nic{Nic: &gproto.NicMetric{
+		Timestamp: ts,
+		Type:      gproto.MetricType_NIC,
+		Ifaces:    []*gproto.IfaceMetric{{Name: "eth0", RxErrors: rxErrors}},
+	}}}
+}
+
+func rollupField(g *gproto.RollupGroup, name string) *gproto.RollupField {
+	for _, f := range g.GetFields() {
+		if f.GetName() == name {
+			return f
+		}
+	}
+	return nil
+}
+
+// rollupNic rolls up NIC metrics of 2 minutes, RxErrors increases by 1 every second
+func (s *RollupTestSuite) rollupNic() []*gproto.RollupMetric {
+	a := rollup.NewAggregator(time.Minute, 10)
+	rollups := make([]*gproto.RollupMetric, 0)
+	for i := 0; i < 120; i++ {
+		rollups = append(rollups, a.Add(newNicMetric(rollupBase+int64(i), uint64(i)))...)
+		if i < 60 {
+			s.Require().Empty(rollups)
+		}
+	}
+	return append(rollups, a.Flush()...)
+}
+
+func (s *RollupTestSuite) TestAggregate() {
+	rollups := s.rollupNic()
+	s.Require().Len(rollups, 2)
+
+	r := rollups[1]
+	s.Require().Equal(rollupBase+60, r.GetTimestamp())
+	s.Require().Equal(gproto.MetricType_NIC, r.GetSource())
+	s.Require().EqualValues(60, r.GetResolution())
+	s.Require().EqualValues(60, r.GetSamples())
+	s.Require().Len(r.GetGroups(), 1)
+
+	g := r.GetGroups()[0]
+	s.Require().Equal("eth0", g.GetKey())
+	f := rollupField(g, "rx_errors")
+	s.Require().NotNil(f)
+	s.Require().EqualValues(60, f.GetMin())
+	s.Require().EqualValues(119, f.GetMax())
+	s.Require().EqualValues(119, f.GetLast())
+	s.Require().InDelta(89.5, rollup.Avg(g, f), 1e-9)
+}
+
+func (s *RollupTestSuite) TestMerge() {
+	a := rollup.NewAggregator(10*time.Minute, 10)
+	for _, r := range s.rollupNic() {
+		s.Require().Empty(a.Add(&gproto.Metric{Body: &gproto.Metric_Rollup{Rollup: r}}))
+	}
+	rollups := a.Flush()
+	s.Require().Len(rollups, 1)
+
+	r := rollups[0]
+	s.Require().Equal(rollupBase, r.GetTimestamp())
+	s.Require().EqualValues(600, r.GetResolution())
+	s.Require().EqualValues(120, r.GetSamples())
+
+	g := r.GetGroups()[0]
+	f := rollupField(g, "rx_errors")
+	s.Require().EqualValues(0, f.GetMin())
+	s.Require().EqualValues(119, f.GetMax())
+	s.Require().EqualValues(119, f.GetLast())
+	s.Require().InDelta(59.5, rollup.Avg(g, f), 1e-9)
+}
+
+func (s *RollupTestSuite) TestTopSockets() {
+	a := rollup.NewAggregator(time.Minute, 1)
+	for i := 0; i < 3; i++ {
+		s.Require().Empty(a.Add(&gproto.Metric{Body: &gproto.Metric_Tcp{Tcp: &gproto.TcpMetric{
+			Timestamp: rollupBase + int64(i),
+			Type:      gproto.MetricType_TCP,
+			Sockets: []*gproto.SocketMetric{
+				{Cookie: 1, State: gproto.SocketState_TCP_ESTABLISHED, RetransTotal: uint32(i), BytesAcked: 10},
+				{Cookie: 2, State: gproto.SocketState_TCP_ESTABLISHED, RetransTotal: 5, BytesAcked: uint64(100 * i)},
+				{Cookie: 3, State: gproto.SocketState_TCP_ESTABLISHED, RetransTotal: 0, BytesAcked: uint64(10 * i)},
+				{Cookie: 4, State: gproto.SocketState_TCP_LISTEN},
+			},
+		}}}))
+	}
+	rollups := a.Flush()
+	s.Require().Len(rollups, 1)
+
+	// the summary, the top socket by retransmissions and the top socket by bytes
+	groups := rollups[0].GetGroups()
+	s.Require().Len(groups, 3)
+	s.Require().Equal("", groups[0].GetKey())
+	s.Require().Equal("sk:1", groups[1].GetKey())
+	s.Require().Equal("sk:2", groups[2].GetKey())
+
+	s.Require().EqualValues(4, rollupField(groups[0], "sockets").GetLast())
+	s.Require().EqualValues(3, rollupField(groups[0], "sockets_established").GetLast())
+	s.Require().EqualValues(1, rollupField(groups[0], "sockets_listen").GetLast())
+	s.Require().EqualValues(2, rollupField(groups[1], "retrans_total").GetMax())
+}
+
+func (s *RollupTestSuite) TestExport() {
+	var buf bytes.Buffer
+	e := influxdb.New("node-1", &buf)
+	e.ExportMetric(&gproto.Metric{Body: &gproto.Metric_Rollup{Rollup: s.rollupNic()[0]}})
+
+	s.Require().Contains(buf.String(), "rollup_nic,Hostname=node-1,Resolution=1m0s,Name=eth0 Samples=60 1700000400\n")
+	s.Require().Contains(buf.String(), "rollup_nic,Hostname=node-1,Resolution=1m0s,Name=eth0 RxErrorsMax=59 1700000400\n")
+	s.Require().Contains(buf.String(), "rollup_nic,Hostname=node-1,Resolution=1m0s,Name=eth0 RxErrorsAvg=29.5 1700000400\n")
+}
+
+func (s *RollupTestSuite) TestParseRollupLevels() {
+	levels, err := storage.ParseRollupLevels("1m:1h, 10m:24h")
+	s.Require().NoError(err)
+	s.Require().Equal([]storage.RollupLevel{
+		{Resolution: time.Minute, After: time.Hour},
+		{Resolution: 10 * time.Minute, After: 24 * time.Hour},
+	}, levels)
+
+	levels, err = storage.ParseRollupLevels("")
+	s.Require().NoError(err)
+	s.Require().Empty(levels)
+
+	for _, invalid := range []string{"1m", "1m:1h,30s:2h", "1m:1h,10m:1h", "100ms:1h"} {
+		_, err = storage.ParseRollupLevels(invalid)
+		s.Require().Error(err, invalid)
+	}
+}
+
+func (s *StorageV2TestSuite) TestCompact() {
+	cfg := storage.NewConfig(s.baseDir).
+		WithFs(s.fs).
+		WithMaxEntriesPerFile(30).
+		WithRollupLevels(
+			storage.RollupLevel{Resolution: time.Minute, After: time.Hour},
+			storage.RollupLevel{Resolution: 10 * time.Minute, After: 2 * time.Hour})
+
+	ds, err := storage.NewDataStore(cfg)
+	s.Require().NoError(err)
+	defer ds.Close()
+
+	now := time.Now()
+	old := now.Add(-3*time.Hour).Unix() / 600 * 600
+	for i := 0; i < 120; i++ {
+		buf, err := proto.Marshal(newNicMetric(old+int64(i), uint64(i)))
+		s.Require().NoError(err)
+		s.Require().NoError(ds.Put(buf))
+	}
+
+	// sealed NIC files are rolled up into the 1m stream
+	s.Require().NoError(ds.Compact(now))
+	s.Require().NoError(ds.NextFile())
+	for _, f := range []string{"tcpmon-dataf-nic-2.zst", "tcpmon-dataf-nic-5.zst", "tcpmon-index-nic-2"} {
+		s.Require().False(s.exists(f), f)
+	}
+	metrics := s.rangeMetrics(0, 0, gproto.MetricType_ROLLUP)
+	s.Require().Len(metrics, 2)
+	s.Require().Equal(old, metrics[0].GetRollup().GetTimestamp())
+	s.Require().Equal(old+60, metrics[1].GetRollup().GetTimestamp())
+	s.Require().EqualValues(60, metrics[1].GetRollup().GetSamples())
+
+	// the sealed 1m file is rolled up into the 10m stream
+	s.Require().NoError(ds.Compact(now))
+	s.Require().NoError(ds.NextFile())
+	metrics = s.rangeMetrics(0, 0, gproto.MetricType_ROLLUP)
+	s.Require().Len(metrics, 1)
+	r := metrics[0].GetRollup()
+	s.Require().EqualValues(600, r.GetResolution())
+	s.Require().EqualValues(120, r.GetSamples())
+	s.Require().EqualValues(119, rollupField(r.GetGroups()[0], "rx_errors").GetMax())
+}
+
+func (s *StorageV2TestSuite) TestCompactUnreadable() {
+	cfg := storage.NewConfig(s.baseDir).
+		WithFs(s.fs).
+		WithMaxEntriesPerFile(30).
+		WithRollupLevels(storage.RollupLevel{Resolution: time.Minute, After: time.Hour})
+
+	ds, err := storage.NewDataStore(cfg)
+	s.Require().NoError(err)
+	defer ds.Close()
+
+	now := time.Now()
+	old := now.Add(-3*time.Hour).Unix() / 600 * 600
+	for i := 0; i < 120; i++ {
+		buf, err := proto.Marshal(newNicMetric(old+int64(i), uint64(i)))
+		s.Require().NoError(err)
+		s.Require().NoError(ds.Put(buf))
+	}
+
+	// the compressed data of the first NIC file is corrupted, it's kept for fsck instead of being deleted
+	corrupted := filepath.Join(s.baseDir, "tcpmon-dataf-nic-2.zst")
+	stat, err := s.fs.Stat(corrupted)
+	s.Require().NoError(err)
+	s.corrupt(corrupted, stat.Size()/2, bytes.Repeat([]byte{0xff}, 16))
+
+	s.Require().NoError(ds.Compact(now))
+	s.Require().NoError(ds.NextFile())
+	s.Require().True(s.exists("tcpmon-dataf-nic-2.zst"))
+	s.Require().False(s.exists("tcpmon-dataf-nic-5.zst"))
+	samples := uint32(0)
+	for _, m := range s.rangeMetrics(0, 0, gproto.MetricType_ROLLUP) {
+		samples += m.GetRollup().GetSamples()
+	}
+	s.Require().Positive(samples)
+	s.Require().Less(samples, uint32(120))
+}
@@ -0,0 +1,121 @@
+package test
+
+import (
+	"bytes"
+	"path/filepath"
+
+	"github.com/klauspost/compress/zstd"
+	"github.com/spf13/afero"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/zperf/tcpmon/tcpmon/export/influxdb"
+	"github.com/zperf/tcpmon/tcpmon/gproto"
+	"github.com/zperf/tcpmon/tcpmon/storage"
+)
+
+// writeSealedNic writes n NIC metrics into a sealed file of small frames, returns the file path
+func (s *StorageV2TestSuite) writeSealedNic(n int) string {
+	cfg := storage.NewConfig(s.baseDir).
+		WithFs(s.fs).
+		WithMaxEntriesPerFile(uint32(n)).
+		WithCompressionLevel(1).
+		WithFrameSize(256)
+
+	ds, err := storage.NewDataStore(cfg)
+	s.Require().NoError(err)
+	s.Require().NoError(ds.SetHeadRecord(newHostInfo("node-1")))
+	for i := 0; i < n; i++ {
+		buf, err := proto.Marshal(newNicMetric(rollupBase+int64(i), uint64(i)))
+		s.Require().NoError(err)
+		s.Require().NoError(ds.Put(buf))
+	}
+	s.Require().NoError(ds.Close())
+	return filepath.Join(s.baseDir, storage.DataFileName(storage.StreamNic, 2)+storage.SealFileSuffix)
+}
+
+func (s *StorageV2TestSuite) TestSeekable() {
+	f := s.writeSealedNic(100)
+	compressed, err := afero.ReadFile(s.fs, f)
+	s.Require().NoError(err)
+
+	dicts, err := storage.LoadDicts(s.fs, f)
+	s.Require().NoError(err)
+
+	fh, err := s.fs.Open(f)
+	s.Require().NoError(err)
+	defer fh.Close()
+	z, err := storage.NewSeekableReader(fh, dicts...)
+	s.Require().NoError(err)
+	defer z.Close()
+
+	// plain zstd decoders skip the seek table
+	decoder, err := zstd.NewReader(nil, zstd.WithDecoderDicts(dicts...))
+	s.Require().NoError(err)
+	defer decoder.Close()
+	data, err := decoder.DecodeAll(compressed, nil)
+	s.Require().NoError(err)
+	s.Require().EqualValues(len(data), z.Size())
+
+	// records are read at their offsets across frames
+	index, err := storage.LoadIndex(s.fs, f)
+	s.Require().NoError(err)
+	s.Require().Len(index.GetOffsets(), 101)
+	for i := len(index.GetOffsets()) - 1; i >= 0; i-- {
+		offset := int64(index.GetOffsets()[i])
+		buf := make([]byte, storage.ChecksumHeaderSize)
+		_, err = z.ReadAt(buf, offset)
+		s.Require().NoError(err)
+		s.Require().Equal(data[offset:offset+storage.ChecksumHeaderSize], buf)
+	}
+
+	r, err := storage.NewDataFileReader(f, s.fs)
+	s.Require().NoError(err)
+	defer r.Close()
+	s.Require().NoError(r.SkipTo(int64(index.GetOffsets()[90])))
+	buf, err := r.Read()
+	s.Require().NoError(err)
+	var m gproto.Metric
+	s.Require().NoError(proto.Unmarshal(buf, &m))
+	s.Require().Equal(rollupBase+89, getMetricTimestamp(&m))
+}
+
+func (s *StorageV2TestSuite) TestExportSealed() {
+	f := s.writeSealedNic(10)
+
+	exporter, err := influxdb.NewFastExporter(f, s.fs)
+	s.Require().NoError(err)
+	defer exporter.Close()
+
+	ranges, err := exporter.Scan()
+	s.Require().NoError(err)
+	s.Require().Len(ranges, 11)
+
+	var out bytes.Buffer
+	s.Require().NoError(exporter.Export(&out, &influxdb.ExportOptions{}))
+	s.Require().Contains(out.String(), "nic,Name=eth0,Hostname=node-1 RxErrors=9 1700000409\n")
+}
+
+func (s *StorageV2TestSuite) TestReadLegacySealed() {
+	f := s.writeSealedNic(10)
+
+	// sealed by an old version as a single zstd stream
+	compressed, err := afero.ReadFile(s.fs, f)
+	s.Require().NoError(err)
+	decoder, err := zstd.NewReader(nil)
+	s.Require().NoError(err)
+	defer decoder.Close()
+	data, err := decoder.DecodeAll(compressed, nil)
+	s.Require().NoError(err)
+
+	var legacy bytes.Buffer
+	encoder, err := zstd.NewWriter(&legacy)
+	s.Require().NoError(err)
+	_, err = encoder.Write(data)
+	s.Require().NoError(err)
+	s.Require().NoError(encoder.Close())
+	s.Require().NoError(afero.WriteFile(s.fs, f, legacy.Bytes(), 0644))
+
+	metrics := s.rangeMetrics(rollupBase+5, 0, gproto.MetricType_NIC)
+	s.Require().Len(metrics, 5)
+	s.Require().Equal(rollupBase+5, getMetricTimestamp(metrics[0]))
+}
@@ -0,0 +1,109 @@
+package test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/zperf/tcpmon/tcpmon/export/influxdb"
+	"github.com/zperf/tcpmon/tcpmon/gproto"
+	"github.com/zperf/tcpmon/tcpmon/server"
+	"github.com/zperf/tcpmon/tcpmon/storage"
+)
+
+func (s *StorageV2TestSuite) TestSelfMetric() {
+	h, mon := s.newAuthServer(server.MonitorConfig{})
+	costs := mon.Costs()
+	costs.Record(server.CollectorSocket, 5*time.Millisecond, 3*time.Millisecond, nil)
+	costs.Record(server.CollectorSocket, 2*time.Millisecond, time.Millisecond, errors.New("ss timeout"))
+	costs.Record(server.CollectorNet, time.Millisecond, 0, nil)
+
+	now := time.Unix(queryBase, 0)
+	self := mon.SelfMetric(now)
+	s.Equal(gproto.MetricType_SELF, self.GetType())
+	s.EqualValues(queryBase, self.GetTimestamp())
+	s.Require().Len(self.GetCollectors(), 4)
+	socket := self.GetCollectors()[3]
+	s.Equal(server.CollectorSocket, socket.GetName())
+	s.EqualValues(2, socket.GetRuns())
+	s.EqualValues(1, socket.GetFailures())
+	s.EqualValues(2000, socket.GetLastDurationUs())
+	s.EqualValues(5000, socket.GetMaxDurationUs())
+	s.EqualValues(7000, socket.GetTotalDurationUs())
+	s.EqualValues(1000, socket.GetLastChildWallUs())
+	s.EqualValues(4000, socket.GetTotalChildWallUs())
+	s.Positive(self.GetUserCpuUs() + self.GetSystemCpuUs())
+	s.Positive(self.GetHeapAllocBytes())
+	s.Positive(self.GetGoroutines())
+
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/self", nil))
+	s.Require().Equal(http.StatusOK, w.Code, w.Body.String())
+	var r map[string]any
+	s.Require().NoError(json.Unmarshal(w.Body.Bytes(), &r))
+	s.Contains(r, "heapAllocBytes")
+	s.Len(r["collectors"], 4)
+	mon.Close()
+
+	// stored with the metrics
+	buf, err := proto.Marshal(&gproto.Metric{Body: &gproto.Metric_Self{Self: self}})
+	s.Require().NoError(err)
+	ds, err := storage.NewDataStore(storage.NewConfig(s.baseDir).WithFs(s.fs))
+	s.Require().NoError(err)
+	s.Require().NoError(ds.Put(buf))
+	s.Require().NoError(ds.NextFile())
+	stats := ds.WriteStats()
+	s.EqualValues(1, stats.Records)
+	s.Greater(stats.Bytes, uint64(len(buf)))
+	s.EqualValues(1, stats.Seals)
+	s.Positive(stats.TotalCompression)
+	s.LessOrEqual(stats.TotalCompression, stats.TotalSealLatency)
+	s.Require().NoError(ds.Close())
+
+	metrics := s.rangeMetrics(0, 0, gproto.MetricType_SELF)
+	s.Require().Len(metrics, 1)
+	s.True(proto.Equal(self, metrics[0].GetSelf()))
+
+	h, mon = s.newAuthServer(server.MonitorConfig{})
+	defer mon.Close()
+	q := s.queryJSON(h, "type=self_collector&fields=runs,failures")
+	s.Require().Equal(4, q.Len)
+	s.Equal(map[string]any{"timestamp": float64(queryBase), "name": "socket", "runs": float64(2),
+		"failures": float64(1)}, q.Rows[3])
+
+	var out bytes.Buffer
+	influxdb.New("node-1", &out).ExportMetric(metrics[0])
+	s.Contains(out.String(), "self_collector,Hostname=node-1,Name=socket TotalChildWallUs=4000 1700000000\n")
+	s.Contains(out.String(), "self,Hostname=node-1 RecordsWritten=0 1700000000\n")
+}
+
+func (s *StorageV2TestSuite) TestShutdown() {
+	mon, err := server.New(server.MonitorConfig{
+		QuorumPort:          -1,
+		CollectInterval:     time.Hour,
+		HttpListen:          "127.0.0.1:0",
+		DataStoreConfig:     *storage.NewConfig(s.baseDir).WithFs(s.fs).WithWriteInterval(time.Hour),
+		EventBufferSize:     1,
+		SelfMetricsInterval: 5 * time.Millisecond,
+	})
+	s.Require().NoError(err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error)
+	go func() {
+		done <- mon.Run(ctx)
+	}()
+	time.Sleep(100 * time.Millisecond)
+	cancel()
+	s.Require().NoError(<-done)
+
+	// records queued and buffered are written before the datastore is closed
+	s.Require().NotEmpty(s.rangeMetrics(0, 0, gproto.MetricType_SELF))
+	s.Require().Zero(mon.HealthReport(time.Now(), false).Storage.Errors)
+}
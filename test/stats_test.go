@@ -0,0 +1,75 @@
+package test
+
+import (
+	"time"
+
+	"github.com/zperf/tcpmon/tcpmon/storage"
+)
+
+func (s *StorageV2TestSuite) TestStats() {
+	ds, err := storage.NewDataStore(storage.NewConfig(s.baseDir).WithFs(s.fs).WithMaxEntriesPerFile(40).
+		WithMaxSize(1 << 30))
+	s.Require().NoError(err)
+	s.Require().NoError(ds.SetHeadRecord(newHostInfo("node-1")))
+	for i := 0; i < 100; i++ {
+		s.Require().NoError(ds.Put(marshalNic(rollupBase + int64(i))))
+	}
+	s.Require().NoError(ds.Flush())
+
+	stats, err := ds.Stats()
+	s.Require().NoError(err)
+	s.Require().EqualValues(1<<30, stats.MaxSize)
+	// two sealed NIC files, the current NIC file and the default stream
+	s.Require().Equal(2, stats.SealedFiles)
+	s.Require().Equal(2, stats.UnsealedFiles)
+	s.Require().Equal(4, stats.Files)
+	s.Require().Equal(3, stats.Streams[storage.StreamNic].Files)
+	s.Require().Equal(100, stats.Types["nic"].Records)
+	s.Require().Greater(stats.Types["nic"].Size, int64(0))
+	s.Require().Equal(time.Unix(rollupBase, 0), stats.Oldest)
+	// the host info at the head of each file is recorded with the current time
+	s.Require().Equal(4, stats.Types["host"].Records)
+	s.Require().False(stats.Newest.Before(time.Unix(rollupBase+99, 0)))
+	s.Require().Greater(stats.CompressionRatio, 1.0)
+	s.Require().Greater(stats.RawSize, stats.Size)
+	s.Require().NotEmpty(stats.Reclaims)
+	s.Require().EqualValues(100, stats.Write.Records)
+	s.Require().Zero(stats.Write.Errors)
+
+	size := int64(0)
+	for _, f := range stats.FileList {
+		size += f.Size
+	}
+	s.Require().Equal(stats.Size, size)
+
+	// files are scanned while records are written
+	done := make(chan error)
+	go func() {
+		for i := 0; i < 10; i++ {
+			_, err := ds.Stats()
+			if err != nil {
+				done <- err
+				return
+			}
+		}
+		done <- nil
+	}()
+	for i := 100; i < 200; i++ {
+		s.Require().NoError(ds.Put(marshalNic(rollupBase + int64(i))))
+	}
+	s.Require().NoError(<-done)
+	s.Require().NoError(ds.Flush())
+	stats, err = ds.Stats()
+	s.Require().NoError(err)
+	s.Require().Equal(200, stats.Types["nic"].Records)
+	s.Require().NoError(ds.Close())
+
+	// offline, without the retention and the history
+	offline, err := storage.DirStats(s.fs, s.baseDir)
+	s.Require().NoError(err)
+	s.Require().Zero(offline.MaxSize)
+	s.Require().Nil(offline.Write)
+	s.Require().Equal(200, offline.Types["nic"].Records)
+	s.Require().Equal(stats.Oldest, offline.Oldest)
+	s.Require().Equal(stats.Newest, offline.Newest)
+}
@@ -0,0 +1,141 @@
+package test
+
+import (
+	"bufio"
+	"context"
+	"encoding/base64"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/zperf/tcpmon/tcpmon/gproto"
+	"github.com/zperf/tcpmon/tcpmon/server"
+	"github.com/zperf/tcpmon/tcpmon/storage"
+)
+
+func (s *StorageV2TestSuite) TestBroadcaster() {
+	b := server.NewBroadcaster(2, 1)
+	sub := b.Subscribe()
+	s.Require().NotNil(sub)
+	s.Nil(b.Subscribe())
+
+	// the third metric is dropped instead of blocking
+	for i := 0; i < 3; i++ {
+		b.Publish(newNicMetric(int64(i), 0))
+	}
+	s.EqualValues(1, sub.Dropped())
+	s.Zero(sub.Dropped())
+	s.EqualValues(0, (<-sub.C()).GetNic().GetTimestamp())
+	s.EqualValues(1, (<-sub.C()).GetNic().GetTimestamp())
+
+	b.Unsubscribe(sub)
+	s.Zero(b.Len())
+	b.Publish(newNicMetric(3, 0))
+	s.Empty(sub.C())
+	s.NotNil(b.Subscribe())
+}
+
+// readEvent reads a server-sent event, returns its name and data
+func (s *StorageV2TestSuite) readEvent(r *bufio.Reader) (string, string) {
+	var name, data string
+	for {
+		line, err := r.ReadString('\n')
+		s.Require().NoError(err)
+		line = strings.TrimSuffix(line, "\n")
+		switch {
+		case line == "":
+			return name, data
+		case strings.HasPrefix(line, "event: "):
+			name = strings.TrimPrefix(line, "event: ")
+		case strings.HasPrefix(line, "data: "):
+			data = strings.TrimPrefix(line, "data: ")
+		}
+	}
+}
+
+func (s *StorageV2TestSuite) TestStream() {
+	mon, err := server.New(server.MonitorConfig{
+		QuorumPort:       -1,
+		DataStoreConfig:  *storage.NewConfig(s.baseDir).WithFs(s.fs),
+		EventBufferSize:  1,
+		StreamBufferSize: 16,
+		StreamMaxClients: 2,
+	})
+	s.Require().NoError(err)
+	defer mon.Close()
+
+	gin.SetMode(gin.TestMode)
+	engine := gin.New()
+	server.RegisterRoutes(engine, mon)
+	srv := httptest.NewServer(engine)
+	defer srv.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet,
+		srv.URL+"/stream?type=tcp,event&state=established&peer=:5000&format=protobuf", nil)
+	s.Require().NoError(err)
+	resp, err := http.DefaultClient.Do(req)
+	s.Require().NoError(err)
+	defer resp.Body.Close()
+	s.Require().Equal(http.StatusOK, resp.StatusCode)
+	s.Equal("text/event-stream", resp.Header.Get("Content-Type"))
+	s.Require().Eventually(func() bool { return mon.Broadcaster().Len() == 1 }, time.Second, 10*time.Millisecond)
+
+	established := newSocket("10.0.0.1:80", "10.0.0.2:5000", 2, gproto.SocketState_TCP_ESTABLISHED)
+	timeWait := newSocket("10.0.0.1:80", "10.0.0.3:5000", 3, gproto.SocketState_TCP_TIME_WAIT)
+	b := mon.Broadcaster()
+	// filtered out by the type and by the state
+	b.Publish(newNicMetric(1, 0))
+	b.Publish(&gproto.Metric{Body: &gproto.Metric_Tcp{Tcp: &gproto.TcpMetric{Timestamp: 1,
+		Sockets: []*gproto.SocketMetric{timeWait}}}})
+	table := &gproto.TcpMetric{Timestamp: 2, Sockets: []*gproto.SocketMetric{established, timeWait}}
+	b.Publish(&gproto.Metric{Body: &gproto.Metric_Tcp{Tcp: table}})
+	b.Publish(&gproto.Metric{Body: &gproto.Metric_Event{Event: &gproto.EventMetric{Timestamp: 3,
+		Events: []*gproto.ConnectionEvent{{Type: gproto.ConnectionEventType_STATE_CHANGED, LocalAddr: "10.0.0.1:80",
+			PeerAddr: "10.0.0.2:5000", State: gproto.SocketState_TCP_ESTABLISHED}}}}})
+
+	r := bufio.NewReader(resp.Body)
+	name, data := s.readEvent(r)
+	s.Require().Equal("tcp", name)
+	buf, err := base64.StdEncoding.DecodeString(data)
+	s.Require().NoError(err)
+	var m gproto.Metric
+	s.Require().NoError(proto.Unmarshal(buf, &m))
+	s.EqualValues(2, m.GetTcp().GetTimestamp())
+	s.Require().Len(m.GetTcp().GetSockets(), 1)
+	s.Equal("10.0.0.2:5000", m.GetTcp().GetSockets()[0].GetPeerAddr())
+
+	name, data = s.readEvent(r)
+	s.Require().Equal("event", name)
+	buf, err = base64.StdEncoding.DecodeString(data)
+	s.Require().NoError(err)
+	s.Require().NoError(proto.Unmarshal(buf, &m))
+	s.EqualValues(3, m.GetEvent().GetTimestamp())
+
+	// the published metric is shared, filters don't modify it
+	s.Len(table.GetSockets(), 2)
+
+	for params, code := range map[string]int{
+		"type=host":        http.StatusBadRequest,
+		"state=unknown":    http.StatusBadRequest,
+		"format=xml":       http.StatusBadRequest,
+		"type=tcp&peer=:1": http.StatusServiceUnavailable,
+	} {
+		if code == http.StatusServiceUnavailable {
+			sub := b.Subscribe()
+			s.Require().NotNil(sub)
+			defer b.Unsubscribe(sub)
+		}
+		w := httptest.NewRecorder()
+		engine.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/stream?"+params, nil))
+		s.Equal(code, w.Code, params)
+	}
+
+	cancel()
+	s.Eventually(func() bool { return b.Len() == 1 }, time.Second, 10*time.Millisecond)
+}